@@ -0,0 +1,30 @@
+package beacon
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// TargetAggregatorsPerCommittee is the number of aggregators that is expected in a committee
+const TargetAggregatorsPerCommittee = 16
+
+// IsAggregator returns true if the given selection proof (slot signature) elects the validator as an aggregator of its committee.
+// Spec pseudocode definition:
+//
+//	def is_aggregator(state: BeaconState, slot: Slot, index: CommitteeIndex, slot_signature: BLSSignature) -> bool:
+//	    committee = get_beacon_committee(state, slot, index)
+//	    modulo = max(1, len(committee) // TARGET_AGGREGATORS_PER_COMMITTEE)
+//	    return bytes_to_uint64(hash(slot_signature)[0:8]) % modulo == 0
+func IsAggregator(committeeLength uint64, slotSig []byte) (bool, error) {
+	if len(slotSig) == 0 {
+		return false, errors.New("empty selection proof")
+	}
+	modulo := committeeLength / TargetAggregatorsPerCommittee
+	if modulo == 0 {
+		modulo = 1
+	}
+	h := sha256.Sum256(slotSig)
+	return binary.LittleEndian.Uint64(h[:8])%modulo == 0, nil
+}
@@ -0,0 +1,33 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAggregator(t *testing.T) {
+	sig := make([]byte, 96)
+
+	t.Run("small committee", func(t *testing.T) {
+		isAggregator, err := IsAggregator(TargetAggregatorsPerCommittee-1, sig)
+		require.NoError(t, err)
+		require.True(t, isAggregator)
+	})
+
+	t.Run("large committee", func(t *testing.T) {
+		// the first 8 bytes of sha256(sig) are divisible by 3 but not by 4
+		isAggregator, err := IsAggregator(TargetAggregatorsPerCommittee*3, sig)
+		require.NoError(t, err)
+		require.True(t, isAggregator)
+
+		isAggregator, err = IsAggregator(TargetAggregatorsPerCommittee*4, sig)
+		require.NoError(t, err)
+		require.False(t, isAggregator)
+	})
+
+	t.Run("empty selection proof", func(t *testing.T) {
+		_, err := IsAggregator(TargetAggregatorsPerCommittee, nil)
+		require.EqualError(t, err, "empty selection proof")
+	})
+}
@@ -2,12 +2,14 @@ package beacon
 
 import (
 	"context"
+	"github.com/bloxapp/eth2-key-manager/core"
 	"github.com/bloxapp/ssv/ibft/proto"
 	"github.com/bloxapp/ssv/storage/basedb"
 	"github.com/herumi/bls-eth-go-binary/bls"
 	"go.uber.org/zap"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -16,7 +18,7 @@ type Options struct {
 	Context        context.Context
 	Logger         *zap.Logger
 	Network        string `yaml:"Network" env:"NETWORK" env-default:"prater"`
-	BeaconNodeAddr string `yaml:"BeaconNodeAddr" env:"BEACON_NODE_ADDR" env-required:"true"`
+	BeaconNodeAddr string `yaml:"BeaconNodeAddr" env:"BEACON_NODE_ADDR" env-required:"true" env-description:"Beacon node address, or a comma separated list of addresses to fail over between"`
 	Graffiti       []byte
 	DB             basedb.IDb
 }
@@ -41,6 +43,18 @@ type Beacon interface {
 	// SubmitAttestation submit the attestation to the node
 	SubmitAttestation(attestation *spec.Attestation) error
 
+	// GetBeaconBlock returns a beacon block proposal for the given slot, built with the given randao reveal
+	GetBeaconBlock(slot spec.Slot, randaoReveal spec.BLSSignature) (*eth2spec.VersionedBeaconBlock, error)
+
+	// SubmitBeaconBlock submit the signed beacon block to the node
+	SubmitBeaconBlock(block *eth2spec.VersionedSignedBeaconBlock) error
+
+	// GetAggregateAttestation returns the aggregated attestation of the given slot and committee index
+	GetAggregateAttestation(slot spec.Slot, committeeIndex spec.CommitteeIndex) (*spec.Attestation, error)
+
+	// SubmitSignedAggregateSelectionProof submit the signed aggregate and proof to the node
+	SubmitSignedAggregateSelectionProof(msg *spec.SignedAggregateAndProof) error
+
 	// SubscribeToCommitteeSubnet subscribe committee to subnet (p2p topic)
 	SubscribeToCommitteeSubnet(subscription []*api.BeaconCommitteeSubscription) error
 }
@@ -50,6 +64,10 @@ type KeyManager interface {
 	Signer
 	// AddShare saves a share key
 	AddShare(shareKey *bls.SecretKey) error
+	// RemoveShare removes a share key, slashing protection data of the share is kept
+	RemoveShare(pubKey string) error
+	// SlashingProtector returns the slashing protector that is backed by the slashing store of the key manager
+	SlashingProtector() core.SlashingProtector
 }
 
 // Signer is an interface responsible for all signing operations
@@ -58,10 +76,19 @@ type Signer interface {
 	SignIBFTMessage(message *proto.Message, pk []byte) ([]byte, error)
 	// SignAttestation signs the given attestation
 	SignAttestation(data *spec.AttestationData, duty *Duty, pk []byte) (*spec.Attestation, []byte, error)
+	// SignRandaoReveal signs the randao reveal of the given epoch, returns the signature and the signing root
+	SignRandaoReveal(epoch spec.Epoch, pk []byte) ([]byte, []byte, error)
+	// SignBeaconBlock signs the given beacon block
+	SignBeaconBlock(block *eth2spec.VersionedBeaconBlock, duty *Duty, pk []byte) (*eth2spec.VersionedSignedBeaconBlock, []byte, error)
+	// SignSlot signs the given slot (selection proof), returns the signature and the signing root
+	SignSlot(slot spec.Slot, pk []byte) ([]byte, []byte, error)
+	// SignAggregateAndProof signs the given aggregate and proof
+	SignAggregateAndProof(msg *spec.AggregateAndProof, duty *Duty, pk []byte) (*spec.SignedAggregateAndProof, []byte, error)
 }
 
 // SigningUtil is an interface for beacon node signing specific methods
 type SigningUtil interface {
 	GetDomain(data *spec.AttestationData) ([]byte, error)
+	GetDomainForEpoch(domainType DomainType, epoch spec.Epoch) ([]byte, error)
 	ComputeSigningRoot(object interface{}, domain []byte) ([32]byte, error)
 }
@@ -0,0 +1,143 @@
+package beacon
+
+import (
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// MarshalBeaconBlock encodes a versioned beacon block,
+// the first byte holds the block version and the rest is the ssz encoding of the block
+func MarshalBeaconBlock(block *eth2spec.VersionedBeaconBlock) ([]byte, error) {
+	if block == nil || block.IsEmpty() {
+		return nil, errors.New("empty beacon block")
+	}
+	var byts []byte
+	var err error
+	switch block.Version {
+	case eth2spec.DataVersionPhase0:
+		byts, err = block.Phase0.MarshalSSZ()
+	case eth2spec.DataVersionAltair:
+		byts, err = block.Altair.MarshalSSZ()
+	default:
+		return nil, errors.Errorf("unknown block version %d", block.Version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal beacon block")
+	}
+	return append([]byte{byte(block.Version)}, byts...), nil
+}
+
+// UnmarshalBeaconBlock decodes a versioned beacon block that was encoded with MarshalBeaconBlock
+func UnmarshalBeaconBlock(data []byte) (*eth2spec.VersionedBeaconBlock, error) {
+	if len(data) < 2 {
+		return nil, errors.New("beacon block data is too short")
+	}
+	ret := &eth2spec.VersionedBeaconBlock{Version: eth2spec.DataVersion(data[0])}
+	switch ret.Version {
+	case eth2spec.DataVersionPhase0:
+		ret.Phase0 = &spec.BeaconBlock{}
+		if err := ret.Phase0.UnmarshalSSZ(data[1:]); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal phase0 beacon block")
+		}
+	case eth2spec.DataVersionAltair:
+		ret.Altair = &altair.BeaconBlock{}
+		if err := ret.Altair.UnmarshalSSZ(data[1:]); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal altair beacon block")
+		}
+	default:
+		return nil, errors.Errorf("unknown block version %d", ret.Version)
+	}
+	return ret, nil
+}
+
+// SignBlock wraps the given block and signature in a versioned signed beacon block
+func SignBlock(block *eth2spec.VersionedBeaconBlock, sig spec.BLSSignature) (*eth2spec.VersionedSignedBeaconBlock, error) {
+	if block == nil || block.IsEmpty() {
+		return nil, errors.New("empty beacon block")
+	}
+	ret := &eth2spec.VersionedSignedBeaconBlock{Version: block.Version}
+	switch block.Version {
+	case eth2spec.DataVersionPhase0:
+		ret.Phase0 = &spec.SignedBeaconBlock{Message: block.Phase0, Signature: sig}
+	case eth2spec.DataVersionAltair:
+		ret.Altair = &altair.SignedBeaconBlock{Message: block.Altair, Signature: sig}
+	default:
+		return nil, errors.Errorf("unknown block version %d", block.Version)
+	}
+	return ret, nil
+}
+
+// BlockProposerIndex returns the proposer index of the given block
+func BlockProposerIndex(block *eth2spec.VersionedBeaconBlock) (spec.ValidatorIndex, error) {
+	switch block.Version {
+	case eth2spec.DataVersionPhase0:
+		if block.Phase0 == nil {
+			return 0, errors.New("no phase0 block")
+		}
+		return block.Phase0.ProposerIndex, nil
+	case eth2spec.DataVersionAltair:
+		if block.Altair == nil {
+			return 0, errors.New("no altair block")
+		}
+		return block.Altair.ProposerIndex, nil
+	default:
+		return 0, errors.New("unsupported version")
+	}
+}
+
+// BlockRandaoReveal returns the randao reveal of the given block
+func BlockRandaoReveal(block *eth2spec.VersionedBeaconBlock) (spec.BLSSignature, error) {
+	switch block.Version {
+	case eth2spec.DataVersionPhase0:
+		if block.Phase0 == nil || block.Phase0.Body == nil {
+			return spec.BLSSignature{}, errors.New("no phase0 block body")
+		}
+		return block.Phase0.Body.RANDAOReveal, nil
+	case eth2spec.DataVersionAltair:
+		if block.Altair == nil || block.Altair.Body == nil {
+			return spec.BLSSignature{}, errors.New("no altair block body")
+		}
+		return block.Altair.Body.RANDAOReveal, nil
+	default:
+		return spec.BLSSignature{}, errors.New("unsupported version")
+	}
+}
+
+// SignedBlockSignature returns the signature of the given signed block
+func SignedBlockSignature(block *eth2spec.VersionedSignedBeaconBlock) (spec.BLSSignature, error) {
+	switch block.Version {
+	case eth2spec.DataVersionPhase0:
+		if block.Phase0 == nil {
+			return spec.BLSSignature{}, errors.New("no phase0 block")
+		}
+		return block.Phase0.Signature, nil
+	case eth2spec.DataVersionAltair:
+		if block.Altair == nil {
+			return spec.BLSSignature{}, errors.New("no altair block")
+		}
+		return block.Altair.Signature, nil
+	default:
+		return spec.BLSSignature{}, errors.New("unsupported version")
+	}
+}
+
+// SetSignedBlockSignature overrides the signature of the given signed block
+func SetSignedBlockSignature(block *eth2spec.VersionedSignedBeaconBlock, sig spec.BLSSignature) error {
+	switch block.Version {
+	case eth2spec.DataVersionPhase0:
+		if block.Phase0 == nil {
+			return errors.New("no phase0 block")
+		}
+		block.Phase0.Signature = sig
+	case eth2spec.DataVersionAltair:
+		if block.Altair == nil {
+			return errors.New("no altair block")
+		}
+		block.Altair.Signature = sig
+	default:
+		return errors.New("unsupported version")
+	}
+	return nil
+}
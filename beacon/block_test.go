@@ -0,0 +1,62 @@
+package beacon
+
+import (
+	"testing"
+
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalBeaconBlock(t *testing.T) {
+	block := &eth2spec.VersionedBeaconBlock{
+		Version: eth2spec.DataVersionPhase0,
+		Phase0: &spec.BeaconBlock{
+			Slot:          12,
+			ProposerIndex: 3,
+			Body: &spec.BeaconBlockBody{
+				RANDAOReveal: spec.BLSSignature{1, 2, 3},
+				ETH1Data: &spec.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				Graffiti: make([]byte, 32),
+			},
+		},
+	}
+
+	byts, err := MarshalBeaconBlock(block)
+	require.NoError(t, err)
+	require.EqualValues(t, eth2spec.DataVersionPhase0, byts[0])
+
+	decoded, err := UnmarshalBeaconBlock(byts)
+	require.NoError(t, err)
+	slot, err := decoded.Slot()
+	require.NoError(t, err)
+	require.EqualValues(t, 12, slot)
+	proposerIndex, err := BlockProposerIndex(decoded)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, proposerIndex)
+	randao, err := BlockRandaoReveal(decoded)
+	require.NoError(t, err)
+	require.EqualValues(t, spec.BLSSignature{1, 2, 3}, randao)
+
+	_, err = UnmarshalBeaconBlock([]byte{9, 1, 2})
+	require.EqualError(t, err, "unknown block version 9")
+	_, err = MarshalBeaconBlock(&eth2spec.VersionedBeaconBlock{})
+	require.EqualError(t, err, "empty beacon block")
+}
+
+func TestSignBlock(t *testing.T) {
+	block := &eth2spec.VersionedBeaconBlock{
+		Version: eth2spec.DataVersionPhase0,
+		Phase0:  &spec.BeaconBlock{Slot: 12},
+	}
+	signed, err := SignBlock(block, spec.BLSSignature{1})
+	require.NoError(t, err)
+	sig, err := SignedBlockSignature(signed)
+	require.NoError(t, err)
+	require.EqualValues(t, spec.BLSSignature{1}, sig)
+
+	require.NoError(t, SetSignedBlockSignature(signed, spec.BLSSignature{2}))
+	require.EqualValues(t, spec.BLSSignature{2}, signed.Phase0.Signature)
+}
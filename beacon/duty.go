@@ -1,6 +1,9 @@
 package beacon
 
-import spec "github.com/attestantio/go-eth2-client/spec/phase0"
+import (
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
 
 // Duty represent data regarding the duty type with the duty data
 type Duty struct {
@@ -21,3 +24,33 @@ type Duty struct {
 	// ValidatorCommitteeIndex is the index of the validator in the list of validators in the committee.
 	ValidatorCommitteeIndex uint64
 }
+
+// DecidedValueSlot returns the slot of the duty of the given decided value, which is the input value of the role:
+// attestation data (attester), aggregate and proof (aggregator) or a versioned beacon block (proposer)
+func DecidedValueSlot(role RoleType, value []byte) (spec.Slot, error) {
+	switch role {
+	case RoleTypeAttester:
+		data := &spec.AttestationData{}
+		if err := data.UnmarshalSSZ(value); err != nil {
+			return 0, errors.Wrap(err, "could not unmarshal attestation data")
+		}
+		return data.Slot, nil
+	case RoleTypeAggregator:
+		aggregateAndProof := &spec.AggregateAndProof{}
+		if err := aggregateAndProof.UnmarshalSSZ(value); err != nil {
+			return 0, errors.Wrap(err, "could not unmarshal aggregate and proof")
+		}
+		if aggregateAndProof.Aggregate == nil || aggregateAndProof.Aggregate.Data == nil {
+			return 0, errors.New("aggregate has no data")
+		}
+		return aggregateAndProof.Aggregate.Data.Slot, nil
+	case RoleTypeProposer:
+		block, err := UnmarshalBeaconBlock(value)
+		if err != nil {
+			return 0, err
+		}
+		return block.Slot()
+	default:
+		return 0, errors.Errorf("unknown role: %s", role.String())
+	}
+}
@@ -1,6 +1,7 @@
 package beacon
 
 import (
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
@@ -13,8 +14,8 @@ type DutyData struct {
 	Data IsInputValueData `protobuf_oneof:"data"`
 	// Types that are valid to be assigned to SignedData:
 	//	*InputValueAttestation
-	//	*InputValue_Aggregation
-	//	*InputValue_Block
+	//	*InputValueAggregation
+	//	*InputValueBlock
 	SignedData IsInputValueSignedData `protobuf_oneof:"signed_data"`
 }
 
@@ -75,3 +76,35 @@ func (m *DutyData) GetAttestation() *phase0.Attestation {
 	}
 	return nil
 }
+
+// InputValueBlock implementing IsInputValueSignedData
+type InputValueBlock struct {
+	Block *eth2spec.VersionedSignedBeaconBlock
+}
+
+// isInputValueSignedData implementation
+func (*InputValueBlock) isInputValueSignedData() {}
+
+// GetBlock return cast signed block input data
+func (m *DutyData) GetBlock() *eth2spec.VersionedSignedBeaconBlock {
+	if x, ok := m.GetSignedData().(*InputValueBlock); ok {
+		return x.Block
+	}
+	return nil
+}
+
+// InputValueAggregation implementing IsInputValueSignedData
+type InputValueAggregation struct {
+	Aggregation *phase0.SignedAggregateAndProof
+}
+
+// isInputValueSignedData implementation
+func (*InputValueAggregation) isInputValueSignedData() {}
+
+// GetAggregation return cast signed aggregate and proof input data
+func (m *DutyData) GetAggregation() *phase0.SignedAggregateAndProof {
+	if x, ok := m.GetSignedData().(*InputValueAggregation); ok {
+		return x.Aggregation
+	}
+	return nil
+}
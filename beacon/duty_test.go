@@ -0,0 +1,46 @@
+package beacon
+
+import (
+	"testing"
+
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecidedValueSlot(t *testing.T) {
+	attData := &spec.AttestationData{Slot: 100, Source: &spec.Checkpoint{}, Target: &spec.Checkpoint{}}
+	value, err := attData.MarshalSSZ()
+	require.NoError(t, err)
+	slot, err := DecidedValueSlot(RoleTypeAttester, value)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, slot)
+
+	aggregateAndProof := &spec.AggregateAndProof{Aggregate: &spec.Attestation{
+		AggregationBits: []byte{0x01},
+		Data:            &spec.AttestationData{Slot: 101, Source: &spec.Checkpoint{}, Target: &spec.Checkpoint{}},
+	}}
+	value, err = aggregateAndProof.MarshalSSZ()
+	require.NoError(t, err)
+	slot, err = DecidedValueSlot(RoleTypeAggregator, value)
+	require.NoError(t, err)
+	require.EqualValues(t, 101, slot)
+
+	value, err = MarshalBeaconBlock(&eth2spec.VersionedBeaconBlock{
+		Version: eth2spec.DataVersionPhase0,
+		Phase0: &spec.BeaconBlock{
+			Slot: 102,
+			Body: &spec.BeaconBlockBody{
+				ETH1Data: &spec.ETH1Data{BlockHash: make([]byte, 32)},
+				Graffiti: make([]byte, 32),
+			},
+		},
+	})
+	require.NoError(t, err)
+	slot, err = DecidedValueSlot(RoleTypeProposer, value)
+	require.NoError(t, err)
+	require.EqualValues(t, 102, slot)
+
+	_, err = DecidedValueSlot(RoleTypeAttester, []byte{1, 2, 3})
+	require.Error(t, err)
+}
@@ -0,0 +1,65 @@
+package goclient
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	eth2client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/pkg/errors"
+)
+
+// GetAggregateAttestation returns the aggregated attestation of the given slot and committee index
+func (gc *goClient) GetAggregateAttestation(slot spec.Slot, committeeIndex spec.CommitteeIndex) (*spec.Attestation, error) {
+	gc.waitToSlotTwoThirds(uint64(slot))
+
+	// the attestation data is needed to fetch the aggregation, it doesn't wait again as a third of the slot has passed
+	attestationData, err := gc.GetAttestationData(slot, committeeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get attestation data")
+	}
+	root, err := attestationData.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get attestation data root")
+	}
+	var aggregateAttestation *spec.Attestation
+	err = gc.call("aggregate_attestation", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.AggregateAttestationProvider)
+		if !isProvider {
+			return errors.New("client does not support AggregateAttestationProvider")
+		}
+		var err error
+		aggregateAttestation, err = provider.AggregateAttestation(ctx, slot, root)
+		if err != nil {
+			return err
+		}
+		if aggregateAttestation == nil {
+			return errors.New("received an empty aggregate attestation")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return aggregateAttestation, nil
+}
+
+// SubmitSignedAggregateSelectionProof submit the signed aggregate and proof to all the beacon nodes
+func (gc *goClient) SubmitSignedAggregateSelectionProof(msg *spec.SignedAggregateAndProof) error {
+	return gc.broadcast("submit_aggregate_attestation", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.AggregateAttestationsSubmitter)
+		if !isProvider {
+			return errors.New("client does not support AggregateAttestationsSubmitter")
+		}
+		return provider.SubmitAggregateAttestations(ctx, []*spec.SignedAggregateAndProof{msg})
+	})
+}
+
+func (gc *goClient) SignSlot(slot spec.Slot, pk []byte) ([]byte, []byte, error) {
+	return gc.keyManager.SignSlot(slot, pk)
+}
+
+func (gc *goClient) SignAggregateAndProof(msg *spec.AggregateAndProof, duty *beacon.Duty, pk []byte) (*spec.SignedAggregateAndProof, []byte, error) {
+	return gc.keyManager.SignAggregateAndProof(msg, duty, pk)
+}
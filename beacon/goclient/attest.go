@@ -1,6 +1,9 @@
 package goclient
 
 import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
 	eth2client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/bloxapp/ssv/beacon"
@@ -8,34 +11,43 @@ import (
 )
 
 func (gc *goClient) GetAttestationData(slot spec.Slot, committeeIndex spec.CommitteeIndex) (*spec.AttestationData, error) {
-	if provider, isProvider := gc.client.(eth2client.AttestationDataProvider); isProvider {
-		gc.waitOneThirdOrValidBlock(uint64(slot))
-		attestationData, err := provider.AttestationData(gc.ctx, slot, committeeIndex)
-		if err != nil {
-			return nil, err
+	gc.waitOneThirdOrValidBlock(uint64(slot))
+	var attestationData *spec.AttestationData
+	err := gc.call("attestation_data", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.AttestationDataProvider)
+		if !isProvider {
+			return errors.New("client does not support AttestationDataProvider")
 		}
-		return attestationData, nil
+		var err error
+		attestationData, err = provider.AttestationData(ctx, slot, committeeIndex)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil, errors.New("client does not support AttestationDataProvider")
+	return attestationData, nil
 }
 
 func (gc *goClient) SignAttestation(data *spec.AttestationData, duty *beacon.Duty, pk []byte) (*spec.Attestation, []byte, error) {
 	return gc.keyManager.SignAttestation(data, duty, pk)
 }
 
-// SubmitAttestation implements Beacon interface
+// SubmitAttestation implements Beacon interface, the attestation is submitted to all the beacon nodes
 func (gc *goClient) SubmitAttestation(attestation *spec.Attestation) error {
-	if provider, isProvider := gc.client.(eth2client.AttestationsSubmitter); isProvider {
-		signingRoot, err := gc.getSigningRoot(attestation.Data)
-		if err != nil {
-			return errors.Wrap(err, "failed to get signing root")
-		}
-
-		if err := gc.slashableAttestationCheck(gc.ctx, signingRoot); err != nil {
-			return errors.Wrap(err, "failed attestation slashing protection check")
-		}
+	signingRoot, err := gc.getSigningRoot(attestation.Data)
+	if err != nil {
+		return errors.Wrap(err, "failed to get signing root")
+	}
 
-		return provider.SubmitAttestations(gc.ctx, []*spec.Attestation{attestation})
+	if err := gc.slashableAttestationCheck(gc.ctx, signingRoot); err != nil {
+		return errors.Wrap(err, "failed attestation slashing protection check")
 	}
-	return nil
+
+	return gc.broadcast("submit_attestation", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.AttestationsSubmitter)
+		if !isProvider {
+			return errors.New("client does not support AttestationsSubmitter")
+		}
+		return provider.SubmitAttestations(ctx, []*spec.Attestation{attestation})
+	})
 }
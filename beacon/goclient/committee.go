@@ -1,15 +1,22 @@
 package goclient
 
 import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
 	eth2client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 )
 
-// SubscribeToCommitteeSubnet is implementation for subscribing committee to subnet (p2p topic)
+// SubscribeToCommitteeSubnet is implementation for subscribing committee to subnet (p2p topic),
+// all the beacon nodes are subscribed so any of them can serve the attestation data
 func (gc *goClient) SubscribeToCommitteeSubnet(subscription []*api.BeaconCommitteeSubscription) error {
-	if provider, isProvider := gc.client.(eth2client.BeaconCommitteeSubscriptionsSubmitter); isProvider {
-		return provider.SubmitBeaconCommitteeSubscriptions(gc.ctx, subscription)
-	}
-	return errors.New("client does not support BeaconCommitteeSubscriptionsSubmitter")
+	return gc.broadcast("committee_subscriptions", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.BeaconCommitteeSubscriptionsSubmitter)
+		if !isProvider {
+			return errors.New("client does not support BeaconCommitteeSubscriptionsSubmitter")
+		}
+		return provider.SubmitBeaconCommitteeSubscriptions(ctx, subscription)
+	})
 }
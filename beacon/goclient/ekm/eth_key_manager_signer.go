@@ -20,11 +20,12 @@ import (
 )
 
 type ethKeyManagerSigner struct {
-	wallet       core.Wallet
-	walletLock   *sync.RWMutex
-	signer       signer.ValidatorSigner
-	storage      *signerStorage
-	signingUtils beacon.SigningUtil
+	wallet             core.Wallet
+	walletLock         *sync.RWMutex
+	signer             signer.ValidatorSigner
+	storage            *signerStorage
+	slashingProtection core.SlashingProtector
+	signingUtils       beacon.SigningUtil
 }
 
 // NewETHKeyManagerSigner returns a new instance of ethKeyManagerSigner
@@ -49,22 +50,23 @@ func NewETHKeyManagerSigner(db basedb.IDb, signingUtils beacon.SigningUtil, netw
 		}
 	}
 
-	beaconSigner, err := newBeaconSigner(wallet, signerStore, network)
+	slashingProtection := slashingprotection.NewNormalProtection(signerStore)
+	beaconSigner, err := newBeaconSigner(wallet, slashingProtection, network)
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to create signer")
 	}
 
 	return &ethKeyManagerSigner{
-		wallet:       wallet,
-		walletLock:   &sync.RWMutex{},
-		signer:       beaconSigner,
-		storage:      signerStore,
-		signingUtils: signingUtils,
+		wallet:             wallet,
+		walletLock:         &sync.RWMutex{},
+		signer:             beaconSigner,
+		storage:            signerStore,
+		slashingProtection: slashingProtection,
+		signingUtils:       signingUtils,
 	}, nil
 }
 
-func newBeaconSigner(wallet core.Wallet, store core.SlashingStore, network core.Network) (signer.ValidatorSigner, error) {
-	slashingProtection := slashingprotection.NewNormalProtection(store)
+func newBeaconSigner(wallet core.Wallet, slashingProtection core.SlashingProtector, network core.Network) (signer.ValidatorSigner, error) {
 	return signer.NewSimpleSigner(wallet, slashingProtection, network), nil
 }
 
@@ -77,16 +79,49 @@ func (km *ethKeyManagerSigner) AddShare(shareKey *bls.SecretKey) error {
 		return errors.Wrap(err, "could not check share existence")
 	}
 	if acc == nil {
-		if err := km.storage.SaveHighestAttestation(shareKey.GetPublicKey().Serialize(), zeroSlotAttestation); err != nil {
-			return errors.Wrap(err, "could not save zero highest attestation")
+		// a share that was removed and added again keeps its slashing protection data
+		if km.storage.RetrieveHighestAttestation(shareKey.GetPublicKey().Serialize()) == nil {
+			if err := km.storage.SaveHighestAttestation(shareKey.GetPublicKey().Serialize(), zeroSlotAttestation); err != nil {
+				return errors.Wrap(err, "could not save zero highest attestation")
+			}
 		}
 		if err := km.saveShare(shareKey); err != nil {
 			return errors.Wrap(err, "could not save share")
 		}
 	}
+	// shares that were added before proposals were supported have no highest proposal
+	if km.storage.RetrieveHighestProposal(shareKey.GetPublicKey().Serialize()) == nil {
+		if err := km.storage.SaveHighestProposal(shareKey.GetPublicKey().Serialize(), zeroSlotProposal); err != nil {
+			return errors.Wrap(err, "could not save zero highest proposal")
+		}
+	}
+	return nil
+}
+
+// RemoveShare removes the share of the given public key from the wallet,
+// slashing protection data is kept so the share could not sign slashable messages if it is added again
+func (km *ethKeyManagerSigner) RemoveShare(pubKey string) error {
+	km.walletLock.Lock()
+	defer km.walletLock.Unlock()
+
+	acc, err := km.wallet.AccountByPublicKey(pubKey)
+	if err != nil && err.Error() != "account not found" {
+		return errors.Wrap(err, "could not check share existence")
+	}
+	if acc == nil {
+		return nil
+	}
+	if err := km.wallet.DeleteAccountByPublicKey(pubKey); err != nil {
+		return errors.Wrap(err, "could not delete share")
+	}
 	return nil
 }
 
+// SlashingProtector returns the slashing protector which is used when signing eth2 duties
+func (km *ethKeyManagerSigner) SlashingProtector() core.SlashingProtector {
+	return km.slashingProtection
+}
+
 func (km *ethKeyManagerSigner) SignIBFTMessage(message *proto.Message, pk []byte) ([]byte, error) {
 	km.walletLock.RLock()
 	defer km.walletLock.RUnlock()
@@ -149,6 +184,22 @@ func (km *ethKeyManagerSigner) saveShare(shareKey *bls.SecretKey) error {
 	return nil
 }
 
+// zeroSlotProposal is a place holder block representing all zero values
+var zeroSlotProposal = &eth.BeaconBlock{
+	Slot:          0,
+	ProposerIndex: 0,
+	ParentRoot:    make([]byte, 32),
+	StateRoot:     make([]byte, 32),
+	Body: &eth.BeaconBlockBody{
+		RandaoReveal: make([]byte, 96),
+		Eth1Data: &eth.Eth1Data{
+			DepositRoot: make([]byte, 32),
+			BlockHash:   make([]byte, 32),
+		},
+		Graffiti: make([]byte, 32),
+	},
+}
+
 // zeroSlotAttestation is a place holder attestation data representing all zero values
 var zeroSlotAttestation = &eth.AttestationData{
 	Slot:            0,
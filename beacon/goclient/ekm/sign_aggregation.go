@@ -0,0 +1,73 @@
+package ekm
+
+import (
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+func (km *ethKeyManagerSigner) SignSlot(slot spec.Slot, pk []byte) ([]byte, []byte, error) {
+	epoch := spec.Epoch(uint64(slot) / km.storage.network.SlotsPerEpoch())
+	domain, err := km.signingUtils.GetDomainForEpoch(beacon.DomainSelectionProof, epoch)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get domain for signing")
+	}
+	root, err := km.signingUtils.ComputeSigningRoot(uint64(slot), domain)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get root for signing")
+	}
+	sig, err := km.signer.SignSlot(types.Slot(slot), domain, pk)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to sign slot")
+	}
+	return sig, root[:], nil
+}
+
+func (km *ethKeyManagerSigner) SignAggregateAndProof(msg *spec.AggregateAndProof, duty *beacon.Duty, pk []byte) (*spec.SignedAggregateAndProof, []byte, error) {
+	if msg.Aggregate == nil || msg.Aggregate.Data == nil {
+		return nil, nil, errors.New("aggregate and proof is missing aggregate data")
+	}
+	if msg.Aggregate.Data.Slot != duty.Slot {
+		return nil, nil, errors.Errorf("aggregate slot %d does not match duty slot %d", msg.Aggregate.Data.Slot, duty.Slot)
+	}
+	epoch := spec.Epoch(uint64(duty.Slot) / km.storage.network.SlotsPerEpoch())
+	domain, err := km.signingUtils.GetDomainForEpoch(beacon.DomainAggregateAndProof, epoch)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get domain for signing")
+	}
+	root, err := km.signingUtils.ComputeSigningRoot(msg, domain)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get root for signing")
+	}
+	prysmMsg, err := specAggregateAndProofToPrysm(msg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not convert aggregate and proof")
+	}
+	sig, err := km.signer.SignAggregateAndProof(prysmMsg, domain, pk)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to sign aggregate and proof")
+	}
+	blsSig := spec.BLSSignature{}
+	copy(blsSig[:], sig)
+	return &spec.SignedAggregateAndProof{
+		Message:   msg,
+		Signature: blsSig,
+	}, root[:], nil
+}
+
+// specAggregateAndProofToPrysm converts between aggregate and proof types,
+// both types share the same ssz encoding, therefore it is used for the conversion
+func specAggregateAndProofToPrysm(msg *spec.AggregateAndProof) (*eth.AggregateAttestationAndProof, error) {
+	// TODO - adopt github.com/attestantio/go-eth2-client in eth2-key-manager
+	byts, err := msg.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	ret := &eth.AggregateAttestationAndProof{}
+	if err := ret.UnmarshalSSZ(byts); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
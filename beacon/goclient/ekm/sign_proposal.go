@@ -0,0 +1,106 @@
+package ekm
+
+import (
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+)
+
+func (km *ethKeyManagerSigner) SignRandaoReveal(epoch spec.Epoch, pk []byte) ([]byte, []byte, error) {
+	domain, err := km.signingUtils.GetDomainForEpoch(beacon.DomainRandao, epoch)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get domain for signing")
+	}
+	root, err := km.signingUtils.ComputeSigningRoot(uint64(epoch), domain)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get root for signing")
+	}
+	sig, err := km.signer.SignEpoch(types.Epoch(epoch), domain, pk)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to sign randao reveal")
+	}
+	return sig, root[:], nil
+}
+
+func (km *ethKeyManagerSigner) SignBeaconBlock(b *eth2spec.VersionedBeaconBlock, duty *beacon.Duty, pk []byte) (*eth2spec.VersionedSignedBeaconBlock, []byte, error) {
+	slot, err := b.Slot()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get block slot")
+	}
+	if slot != duty.Slot {
+		return nil, nil, errors.Errorf("block slot %d does not match duty slot %d", slot, duty.Slot)
+	}
+	epoch := spec.Epoch(uint64(slot) / km.storage.network.SlotsPerEpoch())
+	domain, err := km.signingUtils.GetDomainForEpoch(beacon.DomainBeaconProposer, epoch)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get domain for signing")
+	}
+
+	var root [32]byte
+	var prysmBlock block.BeaconBlock
+	switch b.Version {
+	case eth2spec.DataVersionPhase0:
+		root, err = km.signingUtils.ComputeSigningRoot(b.Phase0, domain)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to get root for signing")
+		}
+		prysmBlock, err = specPhase0BlockToPrysmBlock(b.Phase0)
+	case eth2spec.DataVersionAltair:
+		root, err = km.signingUtils.ComputeSigningRoot(b.Altair, domain)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to get root for signing")
+		}
+		prysmBlock, err = specAltairBlockToPrysmBlock(b)
+	default:
+		return nil, nil, errors.Errorf("unknown block version %d", b.Version)
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not convert block")
+	}
+
+	sig, err := km.signer.SignBeaconBlock(prysmBlock, domain, pk)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to sign beacon block")
+	}
+	blsSig := spec.BLSSignature{}
+	copy(blsSig[:], sig)
+	signedBlock, err := beacon.SignBlock(b, blsSig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return signedBlock, root[:], nil
+}
+
+// specPhase0BlockToPrysmBlock converts between block types,
+// both types share the same ssz encoding, therefore it is used for the conversion
+func specPhase0BlockToPrysmBlock(b *spec.BeaconBlock) (block.BeaconBlock, error) {
+	// TODO - adopt github.com/attestantio/go-eth2-client in eth2-key-manager
+	byts, err := b.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	ret := &eth.BeaconBlock{}
+	if err := ret.UnmarshalSSZ(byts); err != nil {
+		return nil, err
+	}
+	return wrapper.WrappedPhase0BeaconBlock(ret), nil
+}
+
+// specAltairBlockToPrysmBlock converts between block types,
+// both types share the same ssz encoding, therefore it is used for the conversion
+func specAltairBlockToPrysmBlock(b *eth2spec.VersionedBeaconBlock) (block.BeaconBlock, error) {
+	byts, err := b.Altair.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	ret := &eth.BeaconBlockAltair{}
+	if err := ret.UnmarshalSSZ(byts); err != nil {
+		return nil, err
+	}
+	return wrapper.WrappedAltairBeaconBlock(ret)
+}
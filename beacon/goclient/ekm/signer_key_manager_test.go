@@ -1,6 +1,7 @@
 package ekm
 
 import (
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/bloxapp/eth2-key-manager/core"
 	"github.com/bloxapp/ssv/beacon"
@@ -10,6 +11,7 @@ import (
 	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -28,6 +30,10 @@ func (s *signingUtils) GetDomain(data *spec.AttestationData) ([]byte, error) {
 	return make([]byte, 32), nil
 }
 
+func (s *signingUtils) GetDomainForEpoch(domainType beacon.DomainType, epoch spec.Epoch) ([]byte, error) {
+	return make([]byte, 32), nil
+}
+
 func (s *signingUtils) ComputeSigningRoot(object interface{}, domain []byte) ([32]byte, error) {
 	if object == nil {
 		return [32]byte{}, errors.New("cannot compute signing root of nil")
@@ -75,6 +81,32 @@ func testKeyManager(t *testing.T) beacon.KeyManager {
 	return km
 }
 
+func TestRemoveShare(t *testing.T) {
+	km := testKeyManager(t)
+	storage := km.(*ethKeyManagerSigner).storage
+	pk := _byteArray(pk1Str)
+
+	highest := &eth.AttestationData{
+		Slot:            100,
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          &eth.Checkpoint{Epoch: 2, Root: make([]byte, 32)},
+		Target:          &eth.Checkpoint{Epoch: 3, Root: make([]byte, 32)},
+	}
+	require.NoError(t, storage.SaveHighestAttestation(pk, highest))
+
+	require.NoError(t, km.RemoveShare(pk1Str))
+	_, err := km.SignIBFTMessage(&proto.Message{Lambda: []byte("lambda1")}, pk)
+	require.EqualError(t, err, "could not get signing account: account not found")
+	// removing a missing share is a no-op
+	require.NoError(t, km.RemoveShare(pk1Str))
+
+	// slashing protection data is kept when the share is added again
+	sk1 := &bls.SecretKey{}
+	require.NoError(t, sk1.SetHexString(sk1Str))
+	require.NoError(t, km.AddShare(sk1))
+	require.EqualValues(t, 100, storage.RetrieveHighestAttestation(pk).Slot)
+}
+
 func TestSignAttestation(t *testing.T) {
 	km := testKeyManager(t)
 
@@ -176,3 +208,70 @@ func TestSignIBFTMessage(t *testing.T) {
 		require.True(t, res)
 	})
 }
+
+func TestSignBeaconBlock(t *testing.T) {
+	km := testKeyManager(t)
+
+	sk1 := &bls.SecretKey{}
+	require.NoError(t, sk1.SetHexString(sk1Str))
+
+	duty := &beacon.Duty{
+		Type:           beacon.RoleTypeProposer,
+		Slot:           30,
+		ValidatorIndex: 1,
+	}
+	block := &eth2spec.VersionedBeaconBlock{
+		Version: eth2spec.DataVersionPhase0,
+		Phase0: &spec.BeaconBlock{
+			Slot:          30,
+			ProposerIndex: 1,
+			Body: &spec.BeaconBlockBody{
+				RANDAOReveal: spec.BLSSignature{1, 2, 3},
+				ETH1Data: &spec.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				Graffiti: make([]byte, 32),
+			},
+		},
+	}
+
+	t.Run("sign once", func(t *testing.T) {
+		signed, root, err := km.SignBeaconBlock(block, duty, sk1.GetPublicKey().Serialize())
+		require.NoError(t, err)
+		require.NotNil(t, root)
+
+		sigByts := signed.Phase0.Signature
+		sig := &bls.Sign{}
+		require.NoError(t, sig.Deserialize(sigByts[:]))
+		require.True(t, sig.VerifyByte(sk1.GetPublicKey(), root))
+	})
+	t.Run("slashable sign, fail", func(t *testing.T) {
+		_, _, err := km.SignBeaconBlock(block, duty, sk1.GetPublicKey().Serialize())
+		require.EqualError(t, err, "failed to sign beacon block: slashable proposal (HighestProposalVote), not signing")
+	})
+	t.Run("signed slot is slashable for the slashing protector", func(t *testing.T) {
+		status, err := km.SlashingProtector().IsSlashableProposal(sk1.GetPublicKey().Serialize(), &eth.BeaconBlock{Slot: 30})
+		require.NoError(t, err)
+		require.Equal(t, core.HighestProposalVote, status.Status)
+	})
+	t.Run("wrong slot, fail", func(t *testing.T) {
+		wrongDuty := *duty
+		wrongDuty.Slot = 31
+		_, _, err := km.SignBeaconBlock(block, &wrongDuty, sk1.GetPublicKey().Serialize())
+		require.EqualError(t, err, "block slot 30 does not match duty slot 31")
+	})
+}
+
+func TestSignRandaoReveal(t *testing.T) {
+	km := testKeyManager(t)
+
+	sk1 := &bls.SecretKey{}
+	require.NoError(t, sk1.SetHexString(sk1Str))
+
+	sigByts, root, err := km.SignRandaoReveal(3, sk1.GetPublicKey().Serialize())
+	require.NoError(t, err)
+
+	sig := &bls.Sign{}
+	require.NoError(t, sig.Deserialize(sigByts))
+	require.True(t, sig.VerifyByte(sk1.GetPublicKey(), root))
+}
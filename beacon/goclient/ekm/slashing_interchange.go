@@ -0,0 +1,294 @@
+package ekm
+
+import (
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bloxapp/eth2-key-manager/core"
+	slashingprotection "github.com/bloxapp/eth2-key-manager/slashing_protection"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// InterchangeFormatVersion is the supported version of the EIP-3076 slashing protection interchange format
+const InterchangeFormatVersion = "5"
+
+// SlashingInterchange is the EIP-3076 slashing protection interchange format,
+// see https://eips.ethereum.org/EIPS/eip-3076
+type SlashingInterchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []*InterchangeData  `json:"data"`
+}
+
+// InterchangeMetadata is the metadata of the interchange
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+}
+
+// InterchangeData holds the signing history of a single public key
+type InterchangeData struct {
+	Pubkey             string                    `json:"pubkey"`
+	SignedBlocks       []*InterchangeBlock       `json:"signed_blocks"`
+	SignedAttestations []*InterchangeAttestation `json:"signed_attestations"`
+}
+
+// InterchangeBlock represents a signed block
+type InterchangeBlock struct {
+	Slot        string `json:"slot"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// InterchangeAttestation represents a signed attestation
+type InterchangeAttestation struct {
+	SourceEpoch string `json:"source_epoch"`
+	TargetEpoch string `json:"target_epoch"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// ValidatorShares maps the public key of a validator (hex, without 0x) to the public key of the operator's share.
+// the interchange format is keyed by validator public keys, while the slashing protection data is kept per share
+type ValidatorShares map[string][]byte
+
+// genesisValidatorsRoot returns the genesis validators root of the given network
+func genesisValidatorsRoot(network core.Network) (string, error) {
+	switch network {
+	case core.MainNetwork:
+		return "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95", nil
+	case core.PraterNetwork:
+		return "0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb", nil
+	default:
+		return "", errors.Errorf("unknown genesis validators root for network %s", network)
+	}
+}
+
+// ExportSlashingInterchange exports the slashing protection data of the given validators' shares, keyed by the validator
+// public keys. the exported data is the signing history of this operator's share, rather than of the validator,
+// and as only the highest signed block and attestation are kept by the signer storage, only those are exported.
+// validators with no share key in the db are skipped
+func ExportSlashingInterchange(db basedb.IDb, network core.Network, shares ValidatorShares) (*SlashingInterchange, error) {
+	root, err := genesisValidatorsRoot(network)
+	if err != nil {
+		return nil, err
+	}
+	store := newSignerStorage(db, network)
+	accounts, err := listAccountKeys(store)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &SlashingInterchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    root,
+		},
+		Data: make([]*InterchangeData, 0, len(shares)),
+	}
+	// validators are sorted for a deterministic export
+	validatorPks := make([]string, 0, len(shares))
+	for validatorPk := range shares {
+		validatorPks = append(validatorPks, validatorPk)
+	}
+	sort.Strings(validatorPks)
+	for _, validatorPk := range validatorPks {
+		pk := shares[validatorPk]
+		if !accounts[hex.EncodeToString(pk)] {
+			continue
+		}
+		data := &InterchangeData{
+			Pubkey:             "0x" + validatorPk,
+			SignedBlocks:       []*InterchangeBlock{},
+			SignedAttestations: []*InterchangeAttestation{},
+		}
+		// zero values are place holders that were saved when the share was added
+		if proposal := store.RetrieveHighestProposal(pk); proposal != nil && proposal.Slot > 0 {
+			data.SignedBlocks = append(data.SignedBlocks, &InterchangeBlock{
+				Slot: strconv.FormatUint(uint64(proposal.Slot), 10),
+			})
+		}
+		if att := store.RetrieveHighestAttestation(pk); att != nil && att.Target != nil && att.Target.Epoch > 0 {
+			data.SignedAttestations = append(data.SignedAttestations, &InterchangeAttestation{
+				SourceEpoch: strconv.FormatUint(uint64(att.Source.Epoch), 10),
+				TargetEpoch: strconv.FormatUint(uint64(att.Target.Epoch), 10),
+			})
+		}
+		ret.Data = append(ret.Data, data)
+	}
+	return ret, nil
+}
+
+// ImportSlashingInterchange imports the given interchange into the slashing protection data of the shares in the given db,
+// the validator public keys of the interchange are mapped to the operator's shares by the given validator shares.
+// returns the number of imported public keys and the public keys that don't match any share, which are skipped.
+// highest values are only raised, i.e. an import can't make the slashing protection less strict
+func ImportSlashingInterchange(db basedb.IDb, network core.Network, shares ValidatorShares, interchange *SlashingInterchange) (int, []string, error) {
+	if err := validateInterchangeMetadata(network, interchange); err != nil {
+		return 0, nil, err
+	}
+	store := newSignerStorage(db, network)
+	accounts, err := listAccountKeys(store)
+	if err != nil {
+		return 0, nil, err
+	}
+	protector := slashingprotection.NewNormalProtection(store)
+
+	imported := 0
+	var unmatched []string
+	for _, data := range interchange.Data {
+		if data == nil {
+			continue
+		}
+		pkHex := strings.ToLower(strings.TrimPrefix(data.Pubkey, "0x"))
+		if _, err := hex.DecodeString(pkHex); err != nil {
+			return imported, unmatched, errors.Wrapf(err, "invalid pubkey %s", data.Pubkey)
+		}
+		sharePk, ok := shares[pkHex]
+		if !ok || !accounts[hex.EncodeToString(sharePk)] {
+			unmatched = append(unmatched, data.Pubkey)
+			continue
+		}
+		if err := importInterchangeData(protector, network, sharePk, data); err != nil {
+			return imported, unmatched, errors.Wrapf(err, "could not import data of %s", data.Pubkey)
+		}
+		imported++
+	}
+	return imported, unmatched, nil
+}
+
+// RaiseSlashingProtection raises the slashing protection data of all the shares in the given db to the current slot and epoch,
+// so shares of a db that was restored from an old backup can't sign messages that conflict with messages that were
+// signed after the backup was taken. shares won't propose in the current slot or attest until a newer epoch is justified.
+// returns the number of protected shares
+func RaiseSlashingProtection(db basedb.IDb, network core.Network) (int, error) {
+	store := newSignerStorage(db, network)
+	accounts, err := store.ListAccounts()
+	if err != nil {
+		return 0, errors.Wrap(err, "could not list accounts")
+	}
+	protector := slashingprotection.NewNormalProtection(store)
+	slot := network.EstimatedCurrentSlot()
+	epoch := network.EstimatedCurrentEpoch()
+	for _, acc := range accounts {
+		pk := acc.ValidatorPublicKey()
+		if err := protector.UpdateHighestProposal(pk, &eth.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			Body:       zeroSlotProposal.Body,
+		}); err != nil {
+			return 0, errors.Wrap(err, "could not update highest proposal")
+		}
+		if err := protector.UpdateHighestAttestation(pk, &eth.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &eth.Checkpoint{Epoch: epoch, Root: make([]byte, 32)},
+			Target:          &eth.Checkpoint{Epoch: epoch, Root: make([]byte, 32)},
+		}); err != nil {
+			return 0, errors.Wrap(err, "could not update highest attestation")
+		}
+	}
+	return len(accounts), nil
+}
+
+// listAccountKeys returns the (hex) public keys of the shares in the signer storage
+func listAccountKeys(store *signerStorage) (map[string]bool, error) {
+	accounts, err := store.ListAccounts()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list accounts")
+	}
+	ret := make(map[string]bool, len(accounts))
+	for _, acc := range accounts {
+		ret[hex.EncodeToString(acc.ValidatorPublicKey())] = true
+	}
+	return ret, nil
+}
+
+func validateInterchangeMetadata(network core.Network, interchange *SlashingInterchange) error {
+	if interchange == nil {
+		return errors.New("interchange is nil")
+	}
+	if interchange.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+		return errors.Errorf("unsupported interchange format version %s", interchange.Metadata.InterchangeFormatVersion)
+	}
+	root, err := genesisValidatorsRoot(network)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(interchange.Metadata.GenesisValidatorsRoot, root) {
+		return errors.Errorf("genesis validators root %s doesn't match network %s", interchange.Metadata.GenesisValidatorsRoot, network)
+	}
+	return nil
+}
+
+func importInterchangeData(protector core.SlashingProtector, network core.Network, pk []byte, data *InterchangeData) error {
+	var highestSlot uint64
+	for _, b := range data.SignedBlocks {
+		slot, err := parseInterchangeUint(b.Slot, "slot")
+		if err != nil {
+			return err
+		}
+		if slot > highestSlot {
+			highestSlot = slot
+		}
+	}
+	if highestSlot > uint64(network.EstimatedCurrentSlot()) {
+		return errors.Errorf("signed block slot %d is in the future", highestSlot)
+	}
+
+	var highestSource, highestTarget uint64
+	for _, a := range data.SignedAttestations {
+		source, err := parseInterchangeUint(a.SourceEpoch, "source epoch")
+		if err != nil {
+			return err
+		}
+		target, err := parseInterchangeUint(a.TargetEpoch, "target epoch")
+		if err != nil {
+			return err
+		}
+		if source > target {
+			return errors.Errorf("source epoch %d is higher than target epoch %d", source, target)
+		}
+		if source > highestSource {
+			highestSource = source
+		}
+		if target > highestTarget {
+			highestTarget = target
+		}
+	}
+	if highestTarget > uint64(network.EstimatedCurrentEpoch()) {
+		return errors.Errorf("signed attestation target epoch %d is in the future", highestTarget)
+	}
+
+	if len(data.SignedBlocks) > 0 {
+		proposal := &eth.BeaconBlock{
+			Slot:       types.Slot(highestSlot),
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			Body:       zeroSlotProposal.Body,
+		}
+		if err := protector.UpdateHighestProposal(pk, proposal); err != nil {
+			return errors.Wrap(err, "could not update highest proposal")
+		}
+	}
+	if len(data.SignedAttestations) > 0 {
+		if err := protector.UpdateHighestAttestation(pk, &eth.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &eth.Checkpoint{Epoch: types.Epoch(highestSource), Root: make([]byte, 32)},
+			Target:          &eth.Checkpoint{Epoch: types.Epoch(highestTarget), Root: make([]byte, 32)},
+		}); err != nil {
+			return errors.Wrap(err, "could not update highest attestation")
+		}
+	}
+	return nil
+}
+
+func parseInterchangeUint(val string, name string) (uint64, error) {
+	ret, err := strconv.ParseUint(val, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s", name)
+	}
+	return ret, nil
+}
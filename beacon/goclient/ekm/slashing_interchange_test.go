@@ -0,0 +1,194 @@
+package ekm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bloxapp/eth2-key-manager/core"
+	"github.com/bloxapp/ssv/utils/threshold"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+// testValidatorPk is the validator public key of the share sk1
+const testValidatorPk = "8e80066551a81b318258709edaf7dd1f63cd686a0e4db8b29bbb7acfe65608677af5a527d9448ee47835485e02b50bc0"
+
+const testInterchange = `{
+  "metadata": {
+    "interchange_format_version": "5",
+    "genesis_validators_root": "0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb"
+  },
+  "data": [
+    {
+      "pubkey": "0x8E80066551A81B318258709EDAF7DD1F63CD686A0E4DB8B29BBB7ACFE65608677AF5A527D9448EE47835485E02B50BC0",
+      "signed_blocks": [
+        {"slot": "81952", "signing_root": "0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b"},
+        {"slot": "81951"}
+      ],
+      "signed_attestations": [
+        {"source_epoch": "2290", "target_epoch": "3007"},
+        {"source_epoch": "2289", "target_epoch": "3006"}
+      ]
+    },
+    {
+      "pubkey": "0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed",
+      "signed_blocks": [{"slot": "100"}],
+      "signed_attestations": []
+    }
+  ]
+}`
+
+func TestImportSlashingInterchange(t *testing.T) {
+	threshold.Init()
+	db := getStorage(t)
+	km, err := NewETHKeyManagerSigner(db, nil, core.PraterNetwork)
+	require.NoError(t, err)
+	sk1 := &bls.SecretKey{}
+	require.NoError(t, sk1.SetHexString(sk1Str))
+	require.NoError(t, km.AddShare(sk1))
+	shares := ValidatorShares{testValidatorPk: sk1.GetPublicKey().Serialize()}
+
+	t.Run("imports shares data", func(t *testing.T) {
+		interchange := &SlashingInterchange{}
+		require.NoError(t, json.Unmarshal([]byte(testInterchange), interchange))
+		imported, unmatched, err := ImportSlashingInterchange(db, core.PraterNetwork, shares, interchange)
+		require.NoError(t, err)
+		require.Equal(t, 1, imported)
+		require.Equal(t, []string{"0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed"}, unmatched)
+
+		pk := sk1.GetPublicKey().Serialize()
+		status, err := km.SlashingProtector().IsSlashableProposal(pk, &eth.BeaconBlock{Slot: 81952})
+		require.NoError(t, err)
+		require.Equal(t, core.HighestProposalVote, status.Status)
+		status, err = km.SlashingProtector().IsSlashableProposal(pk, &eth.BeaconBlock{Slot: 81953})
+		require.NoError(t, err)
+		require.Equal(t, core.ValidProposal, status.Status)
+
+		highest, err := km.SlashingProtector().RetrieveHighestAttestation(pk)
+		require.NoError(t, err)
+		require.EqualValues(t, 2290, highest.Source.Epoch)
+		require.EqualValues(t, 3007, highest.Target.Epoch)
+	})
+
+	t.Run("export", func(t *testing.T) {
+		interchange, err := ExportSlashingInterchange(db, core.PraterNetwork, shares)
+		require.NoError(t, err)
+		require.Equal(t, InterchangeFormatVersion, interchange.Metadata.InterchangeFormatVersion)
+		require.Len(t, interchange.Data, 1)
+		require.Equal(t, "0x"+testValidatorPk, interchange.Data[0].Pubkey)
+		require.Len(t, interchange.Data[0].SignedBlocks, 1)
+		require.Equal(t, "81952", interchange.Data[0].SignedBlocks[0].Slot)
+		require.Len(t, interchange.Data[0].SignedAttestations, 1)
+		require.Equal(t, "2290", interchange.Data[0].SignedAttestations[0].SourceEpoch)
+		require.Equal(t, "3007", interchange.Data[0].SignedAttestations[0].TargetEpoch)
+	})
+
+	t.Run("lower values don't override", func(t *testing.T) {
+		interchange := &SlashingInterchange{
+			Metadata: InterchangeMetadata{
+				InterchangeFormatVersion: InterchangeFormatVersion,
+				GenesisValidatorsRoot:    "0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb",
+			},
+			Data: []*InterchangeData{{
+				Pubkey:             "0x" + testValidatorPk,
+				SignedBlocks:       []*InterchangeBlock{{Slot: "10"}},
+				SignedAttestations: []*InterchangeAttestation{{SourceEpoch: "1", TargetEpoch: "2"}},
+			}},
+		}
+		_, _, err := ImportSlashingInterchange(db, core.PraterNetwork, shares, interchange)
+		require.NoError(t, err)
+
+		exported, err := ExportSlashingInterchange(db, core.PraterNetwork, shares)
+		require.NoError(t, err)
+		require.Equal(t, "81952", exported.Data[0].SignedBlocks[0].Slot)
+		require.Equal(t, "3007", exported.Data[0].SignedAttestations[0].TargetEpoch)
+	})
+
+	t.Run("share public keys are not matched", func(t *testing.T) {
+		interchange := &SlashingInterchange{
+			Metadata: InterchangeMetadata{
+				InterchangeFormatVersion: InterchangeFormatVersion,
+				GenesisValidatorsRoot:    "0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb",
+			},
+			Data: []*InterchangeData{{Pubkey: "0x" + pk1Str, SignedBlocks: []*InterchangeBlock{{Slot: "10"}}}},
+		}
+		imported, unmatched, err := ImportSlashingInterchange(db, core.PraterNetwork, shares, interchange)
+		require.NoError(t, err)
+		require.Equal(t, 0, imported)
+		require.Equal(t, []string{"0x" + pk1Str}, unmatched)
+	})
+
+	t.Run("wrong genesis validators root", func(t *testing.T) {
+		interchange := &SlashingInterchange{}
+		require.NoError(t, json.Unmarshal([]byte(testInterchange), interchange))
+		_, _, err := ImportSlashingInterchange(db, core.MainNetwork, shares, interchange)
+		require.EqualError(t, err, "genesis validators root 0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb doesn't match network mainnet")
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, _, err := ImportSlashingInterchange(db, core.PraterNetwork, shares, &SlashingInterchange{
+			Metadata: InterchangeMetadata{InterchangeFormatVersion: "4"},
+		})
+		require.EqualError(t, err, "unsupported interchange format version 4")
+	})
+
+	t.Run("invalid attestation", func(t *testing.T) {
+		interchange := &SlashingInterchange{
+			Metadata: InterchangeMetadata{
+				InterchangeFormatVersion: InterchangeFormatVersion,
+				GenesisValidatorsRoot:    "0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb",
+			},
+			Data: []*InterchangeData{{
+				Pubkey:             "0x" + testValidatorPk,
+				SignedAttestations: []*InterchangeAttestation{{SourceEpoch: "5", TargetEpoch: "4"}},
+			}},
+		}
+		_, _, err := ImportSlashingInterchange(db, core.PraterNetwork, shares, interchange)
+		require.EqualError(t, err, "could not import data of 0x"+testValidatorPk+": source epoch 5 is higher than target epoch 4")
+	})
+
+	t.Run("future slot", func(t *testing.T) {
+		interchange := &SlashingInterchange{
+			Metadata: InterchangeMetadata{
+				InterchangeFormatVersion: InterchangeFormatVersion,
+				GenesisValidatorsRoot:    "0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb",
+			},
+			Data: []*InterchangeData{{
+				Pubkey:       "0x" + testValidatorPk,
+				SignedBlocks: []*InterchangeBlock{{Slot: "1000000000"}},
+			}},
+		}
+		_, _, err := ImportSlashingInterchange(db, core.PraterNetwork, shares, interchange)
+		require.EqualError(t, err, "could not import data of 0x"+testValidatorPk+": signed block slot 1000000000 is in the future")
+	})
+}
+
+func TestRaiseSlashingProtection(t *testing.T) {
+	threshold.Init()
+	db := getStorage(t)
+	km, err := NewETHKeyManagerSigner(db, nil, core.PraterNetwork)
+	require.NoError(t, err)
+	sk1 := &bls.SecretKey{}
+	require.NoError(t, sk1.SetHexString(sk1Str))
+	require.NoError(t, km.AddShare(sk1))
+	pk := sk1.GetPublicKey().Serialize()
+
+	protected, err := RaiseSlashingProtection(db, core.PraterNetwork)
+	require.NoError(t, err)
+	require.Equal(t, 1, protected)
+
+	slot := core.PraterNetwork.EstimatedCurrentSlot()
+	status, err := km.SlashingProtector().IsSlashableProposal(pk, &eth.BeaconBlock{Slot: slot})
+	require.NoError(t, err)
+	require.Equal(t, core.HighestProposalVote, status.Status)
+	status, err = km.SlashingProtector().IsSlashableProposal(pk, &eth.BeaconBlock{Slot: slot + 1})
+	require.NoError(t, err)
+	require.Equal(t, core.ValidProposal, status.Status)
+
+	highest, err := km.SlashingProtector().RetrieveHighestAttestation(pk)
+	require.NoError(t, err)
+	epoch := core.PraterNetwork.EstimatedCurrentEpoch()
+	require.Equal(t, epoch, highest.Source.Epoch)
+	require.Equal(t, epoch, highest.Target.Epoch)
+}
@@ -6,7 +6,6 @@ import (
 	client "github.com/attestantio/go-eth2-client"
 	eth2client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
-	"github.com/attestantio/go-eth2-client/http"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/bloxapp/eth2-key-manager/core"
 	"github.com/bloxapp/ssv/beacon"
@@ -17,7 +16,6 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/prysmaticlabs/prysm/time/slots"
-	"github.com/rs/zerolog"
 	"go.uber.org/zap"
 	"log"
 	"sync"
@@ -51,7 +49,7 @@ type goClient struct {
 	ctx            context.Context
 	logger         *zap.Logger
 	network        core.Network
-	client         client.Service
+	nodes          []*beaconNode
 	indicesMapLock sync.Mutex
 	graffiti       []byte
 	keyManager     beacon.KeyManager
@@ -60,106 +58,187 @@ type goClient struct {
 // verifies that the client implements HealthCheckAgent
 var _ metrics.HealthCheckAgent = &goClient{}
 
-// New init new client and go-client instance
+// New init new client and go-client instance.
+// BeaconNodeAddr may hold a comma separated list of beacon nodes, calls fail over between them
 func New(opt beacon.Options) (beacon.Beacon, error) {
 	logger := opt.Logger.With(zap.String("component", "goClient"), zap.String("network", opt.Network))
 	logger.Info("connecting to beacon client...")
 
-	httpClient, err := http.New(opt.Context,
-		// WithAddress supplies the address of the beacon node, in host:port format.
-		http.WithAddress(opt.BeaconNodeAddr),
-		// LogLevel supplies the level of logging to carry out.
-		http.WithLogLevel(zerolog.DebugLevel),
-		http.WithTimeout(time.Second*5),
-	)
-	if err != nil {
-		return nil, errors.WithMessage(err, "failed to create http client")
+	addrs := parseBeaconNodeAddrs(opt.BeaconNodeAddr)
+	if len(addrs) == 0 {
+		return nil, errors.New("no beacon node address was provided")
 	}
 
-	logger = logger.With(zap.String("name", httpClient.Name()), zap.String("address", httpClient.Address()))
-	logger.Info("successfully connected to beacon client")
-
 	_client := &goClient{
 		ctx:            opt.Context,
 		logger:         logger,
 		network:        core.NetworkFromString(opt.Network),
-		client:         httpClient,
 		indicesMapLock: sync.Mutex{},
 		graffiti:       opt.Graffiti,
 	}
+	connected := 0
+	for _, addr := range addrs {
+		node := newBeaconNode(addr)
+		_client.nodes = append(_client.nodes, node)
+		if err := node.checkHealth(opt.Context); err != nil {
+			logger.Warn("beacon node is not available", zap.String("address", addr), zap.Error(err))
+		}
+		if c := node.connectedClient(); c != nil {
+			connected++
+			logger.Info("successfully connected to beacon client",
+				zap.String("name", c.Name()), zap.String("address", c.Address()))
+		}
+	}
+	if connected == 0 {
+		return nil, errors.New("failed to connect to any beacon node")
+	}
+	_client.updateStatusMetric()
 
+	var err error
 	_client.keyManager, err = ekm.NewETHKeyManagerSigner(opt.DB, _client, core.PraterNetwork) // TODO need to set dynemic network
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create new eth-key-manager signer")
 	}
 
+	go _client.monitorHealth(_client.network.SlotDurationSec())
+
 	return _client, nil
 }
 
-// HealthCheck provides health status of beacon node
+// HealthCheck provides health status of the beacon nodes, the client is healthy as long as one of the nodes is healthy
 func (gc *goClient) HealthCheck() []string {
-	if gc.client == nil {
-		return []string{"not connected to beacon node"}
-	}
-	if provider, isProvider := gc.client.(eth2client.NodeSyncingProvider); isProvider {
-		ctx, cancel := context.WithTimeout(gc.ctx, healthCheckTimeout)
-		defer cancel()
-		syncState, err := provider.NodeSyncing(ctx)
-		if err != nil {
-			metricsBeaconNodeStatus.Set(float64(statusUnknown))
-			return []string{"could not get beacon node sync state"}
+	var errs []string
+	for _, node := range gc.nodes {
+		if err := node.checkHealth(gc.ctx); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", node.address, err))
+		}
+	}
+	if status := gc.updateStatusMetric(); status == statusOK {
+		if len(errs) > 0 {
+			gc.logger.Debug("some beacon nodes are unhealthy", zap.Strings("errors", errs))
 		}
-		if syncState != nil && syncState.IsSyncing {
-			metricsBeaconNodeStatus.Set(float64(statusSyncing))
-			return []string{fmt.Sprintf("beacon node is currently syncing: head=%d, distance=%d",
-				syncState.HeadSlot, syncState.SyncDistance)}
+		return []string{}
+	}
+	return errs
+}
+
+// updateStatusMetric sets the node status metric to the best status of the nodes
+func (gc *goClient) updateStatusMetric() beaconNodeStatus {
+	best := statusUnknown
+	for _, node := range gc.nodes {
+		if status := node.getStatus(); status > best {
+			best = status
 		}
 	}
-	metricsBeaconNodeStatus.Set(float64(statusOK))
-	return []string{}
+	metricsBeaconNodeStatus.Set(float64(best))
+	return best
 }
 
 func (gc *goClient) ExtendIndexMap(index spec.ValidatorIndex, pubKey spec.BLSPubKey) {
 	gc.indicesMapLock.Lock()
 	defer gc.indicesMapLock.Unlock()
 
-	gc.client.ExtendIndexMap(map[spec.ValidatorIndex]spec.BLSPubKey{index: pubKey})
+	for _, node := range gc.nodes {
+		if c := node.connectedClient(); c != nil {
+			c.ExtendIndexMap(map[spec.ValidatorIndex]spec.BLSPubKey{index: pubKey})
+		}
+	}
 }
 
 func (gc *goClient) GetDuties(epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*beacon.Duty, error) {
-	if provider, isProvider := gc.client.(eth2client.AttesterDutiesProvider); isProvider {
-		attesterDuties, err := provider.AttesterDuties(gc.ctx, epoch, validatorIndices)
-		if err != nil {
-			return nil, err
+	attesterDuties, err := gc.getAttesterDuties(epoch, validatorIndices)
+	if err != nil {
+		return nil, err
+	}
+	// every attester might be elected as an aggregator, which is decided by the selection proof
+	aggregatorDuties := make([]*beacon.Duty, 0, len(attesterDuties))
+	for _, attesterDuty := range attesterDuties {
+		aggregatorDuty := *attesterDuty
+		aggregatorDuty.Type = beacon.RoleTypeAggregator
+		aggregatorDuties = append(aggregatorDuties, &aggregatorDuty)
+	}
+	// duties are fetched once per epoch, therefore partial duties are not returned so they will be fetched again
+	proposerDuties, err := gc.getProposerDuties(epoch, validatorIndices)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer duties")
+	}
+	return append(append(attesterDuties, aggregatorDuties...), proposerDuties...), nil
+}
+
+// getAttesterDuties returns attester duties for the passed validators indices
+func (gc *goClient) getAttesterDuties(epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*beacon.Duty, error) {
+	var attesterDuties []*api.AttesterDuty
+	err := gc.call("attester_duties", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.AttesterDutiesProvider)
+		if !isProvider {
+			return errors.New("client does not support AttesterDutiesProvider")
 		}
-		var duties []*beacon.Duty
-		for _, attesterDuty := range attesterDuties {
-			duties = append(duties, &beacon.Duty{
-				Type:                    beacon.RoleTypeAttester,
-				PubKey:                  attesterDuty.PubKey,
-				Slot:                    attesterDuty.Slot,
-				ValidatorIndex:          attesterDuty.ValidatorIndex,
-				CommitteeIndex:          attesterDuty.CommitteeIndex,
-				CommitteeLength:         attesterDuty.CommitteeLength,
-				CommitteesAtSlot:        attesterDuty.CommitteesAtSlot,
-				ValidatorCommitteeIndex: attesterDuty.ValidatorCommitteeIndex,
-			})
+		var err error
+		attesterDuties, err = provider.AttesterDuties(ctx, epoch, validatorIndices)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	var duties []*beacon.Duty
+	for _, attesterDuty := range attesterDuties {
+		duties = append(duties, &beacon.Duty{
+			Type:                    beacon.RoleTypeAttester,
+			PubKey:                  attesterDuty.PubKey,
+			Slot:                    attesterDuty.Slot,
+			ValidatorIndex:          attesterDuty.ValidatorIndex,
+			CommitteeIndex:          attesterDuty.CommitteeIndex,
+			CommitteeLength:         attesterDuty.CommitteeLength,
+			CommitteesAtSlot:        attesterDuty.CommitteesAtSlot,
+			ValidatorCommitteeIndex: attesterDuty.ValidatorCommitteeIndex,
+		})
+	}
+	return duties, nil
+}
+
+// getProposerDuties returns proposer duties for the passed validators indices
+func (gc *goClient) getProposerDuties(epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*beacon.Duty, error) {
+	var proposerDuties []*api.ProposerDuty
+	err := gc.call("proposer_duties", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.ProposerDutiesProvider)
+		if !isProvider {
+			return errors.New("client does not support ProposerDutiesProvider")
 		}
-		return duties, nil
+		var err error
+		proposerDuties, err = provider.ProposerDuties(ctx, epoch, validatorIndices)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil, errors.New("client does not support AttesterDutiesProvider")
+	var duties []*beacon.Duty
+	for _, proposerDuty := range proposerDuties {
+		duties = append(duties, &beacon.Duty{
+			Type:           beacon.RoleTypeProposer,
+			PubKey:         proposerDuty.PubKey,
+			Slot:           proposerDuty.Slot,
+			ValidatorIndex: proposerDuty.ValidatorIndex,
+		})
+	}
+	return duties, nil
 }
 
 // GetValidatorData returns metadata (balance, index, status, more) for each pubkey from the node
 func (gc *goClient) GetValidatorData(validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]*api.Validator, error) {
-	if provider, isProvider := gc.client.(eth2client.ValidatorsProvider); isProvider {
-		validatorsMap, err := provider.ValidatorsByPubKey(gc.ctx, "head", validatorPubKeys) // TODO maybe need to get the chainId (head) as var
-		if err != nil {
-			return nil, err
+	var validatorsMap map[spec.ValidatorIndex]*api.Validator
+	err := gc.call("validators", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.ValidatorsProvider)
+		if !isProvider {
+			return errors.New("client does not support ValidatorsProvider")
 		}
-		return validatorsMap, nil
+		var err error
+		validatorsMap, err = provider.ValidatorsByPubKey(ctx, "head", validatorPubKeys) // TODO maybe need to get the chainId (head) as var
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil, errors.New("client does not support ValidatorsProvider")
+	return validatorsMap, nil
 }
 
 // waitOneThirdOrValidBlock waits until one-third of the slot has transpired (SECONDS_PER_SLOT / 3 seconds after the start of slot)
@@ -179,6 +258,23 @@ func (gc *goClient) waitOneThirdOrValidBlock(slot uint64) {
 	}
 }
 
+// waitToSlotTwoThirds waits until two-third of the slot has transpired (SECONDS_PER_SLOT * 2 / 3 seconds after the start of slot)
+func (gc *goClient) waitToSlotTwoThirds(slot uint64) {
+	delay := slots.DivideSlotBy(3 /* a third of the slot duration */)
+	startTime := gc.slotStartTime(slot)
+	finalTime := startTime.Add(2 * delay)
+	wait := prysmTime.Until(finalTime)
+	if wait <= 0 {
+		return
+	}
+
+	t := time.NewTimer(wait)
+	defer t.Stop()
+	for range t.C {
+		return
+	}
+}
+
 // SlotStartTime returns the start time in terms of its unix epoch
 // value.
 func (gc *goClient) slotStartTime(slot uint64) time.Time {
@@ -0,0 +1,167 @@
+package goclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/storage"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testAttestationData = `{"data":{"slot":"1","index":"2","beacon_block_root":"0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b",
+"source":{"epoch":"0","root":"0x0000000000000000000000000000000000000000000000000000000000000000"},
+"target":{"epoch":"0","root":"0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b"}}}`
+
+// testBeaconNode is a local stand-in for a beacon node, serving the endpoints that are needed by the client
+type testBeaconNode struct {
+	*httptest.Server
+	failAttestationData  bool
+	attestationDataCalls int32
+	submittedAttestation int32
+}
+
+func newTestBeaconNode(t *testing.T) *testBeaconNode {
+	node := &testBeaconNode{}
+	mux := http.NewServeMux()
+	static := map[string]string{
+		"/eth/v1/beacon/genesis":          `{"data":{"genesis_time":"1616508000","genesis_validators_root":"0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb","genesis_fork_version":"0x00001020"}}`,
+		"/eth/v1/config/spec":             `{"data":{"DOMAIN_BEACON_ATTESTER":"0x01000000"}}`,
+		"/eth/v1/config/deposit_contract": `{"data":{"chain_id":"5","address":"0xff50ed3d0ec03ac01d4c79aad74928bff48a7b2b"}}`,
+		"/eth/v1/config/fork_schedule":    `{"data":[{"previous_version":"0x00001020","current_version":"0x00001020","epoch":"0"}]}`,
+		"/eth/v1/node/version":            `{"data":{"version":"test/v0.0.1"}}`,
+		"/eth/v1/node/syncing":            `{"data":{"head_slot":"1","sync_distance":"0","is_syncing":false}}`,
+	}
+	for path, body := range static {
+		body := body
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(body))
+		})
+	}
+	mux.HandleFunc("/eth/v1/validator/attestation_data", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&node.attestationDataCalls, 1)
+		if node.failAttestationData {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testAttestationData))
+	})
+	mux.HandleFunc("/eth/v1/beacon/pool/attestations", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&node.submittedAttestation, 1)
+	})
+	node.Server = httptest.NewServer(mux)
+	t.Cleanup(node.Close)
+	return node
+}
+
+func newTestClient(t *testing.T, addrs ...string) *goClient {
+	db, err := storage.GetStorageFactory(basedb.Options{
+		Type:   "badger-memory",
+		Logger: zap.L(),
+	})
+	require.NoError(t, err)
+	// the context is not canceled as go-eth2-client reads its package level logger when a client is closed
+	bc, err := New(beacon.Options{
+		Context:        context.Background(),
+		Logger:         zap.L(),
+		Network:        "prater",
+		BeaconNodeAddr: strings.Join(addrs, ","),
+		DB:             db,
+	})
+	require.NoError(t, err)
+	return bc.(*goClient)
+}
+
+func TestGoClient_Failover(t *testing.T) {
+	t.Run("fails over to the next node", func(t *testing.T) {
+		node1, node2 := newTestBeaconNode(t), newTestBeaconNode(t)
+		node1.failAttestationData = true
+		gc := newTestClient(t, node1.URL, node2.URL)
+
+		data, err := gc.GetAttestationData(1, 2)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, data.Index)
+		require.EqualValues(t, 1, atomic.LoadInt32(&node1.attestationDataCalls))
+		require.EqualValues(t, 1, atomic.LoadInt32(&node2.attestationDataCalls))
+
+		// the failed node is marked and therefore the healthy node is called first
+		_, err = gc.GetAttestationData(1, 2)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, atomic.LoadInt32(&node1.attestationDataCalls))
+		require.EqualValues(t, 2, atomic.LoadInt32(&node2.attestationDataCalls))
+	})
+
+	t.Run("all nodes failed", func(t *testing.T) {
+		node := newTestBeaconNode(t)
+		node.failAttestationData = true
+		gc := newTestClient(t, node.URL)
+
+		_, err := gc.GetAttestationData(1, 2)
+		require.Error(t, err)
+		require.True(t, strings.HasPrefix(err.Error(), fmt.Sprintf("all beacon nodes failed: %s:", node.URL)))
+	})
+
+	t.Run("unreachable node on startup", func(t *testing.T) {
+		down := newTestBeaconNode(t)
+		down.Close()
+		node := newTestBeaconNode(t)
+		gc := newTestClient(t, down.URL, node.URL)
+		require.Len(t, gc.nodes, 2)
+		require.Nil(t, gc.nodes[0].connectedClient())
+		require.Len(t, gc.HealthCheck(), 0)
+
+		_, err := gc.GetAttestationData(1, 2)
+		require.NoError(t, err)
+	})
+
+	t.Run("no reachable node", func(t *testing.T) {
+		down := newTestBeaconNode(t)
+		down.Close()
+		_, err := New(beacon.Options{
+			Context:        context.Background(),
+			Logger:         zap.L(),
+			Network:        "prater",
+			BeaconNodeAddr: down.URL,
+		})
+		require.EqualError(t, err, "failed to connect to any beacon node")
+	})
+}
+
+func TestGoClient_SubmitAttestation(t *testing.T) {
+	node1, node2 := newTestBeaconNode(t), newTestBeaconNode(t)
+	gc := newTestClient(t, node1.URL, node2.URL)
+
+	data, err := gc.GetAttestationData(1, 2)
+	require.NoError(t, err)
+	require.NoError(t, gc.SubmitAttestation(&spec.Attestation{
+		AggregationBits: []byte{0x01, 0x01},
+		Data:            data,
+	}))
+	require.EqualValues(t, 1, atomic.LoadInt32(&node1.submittedAttestation))
+	require.EqualValues(t, 1, atomic.LoadInt32(&node2.submittedAttestation))
+
+	// submission succeeds as long as one of the nodes accepted the attestation
+	node2.Close()
+	require.NoError(t, gc.SubmitAttestation(&spec.Attestation{
+		AggregationBits: []byte{0x01, 0x01},
+		Data:            data,
+	}))
+	require.EqualValues(t, 2, atomic.LoadInt32(&node1.submittedAttestation))
+}
+
+func TestParseBeaconNodeAddrs(t *testing.T) {
+	require.Equal(t, []string{"localhost:5052"}, parseBeaconNodeAddrs("localhost:5052"))
+	require.Equal(t, []string{"localhost:5052", "http://10.0.0.1:5052"},
+		parseBeaconNodeAddrs(" localhost:5052, http://10.0.0.1:5052,"))
+	require.Len(t, parseBeaconNodeAddrs(""), 0)
+}
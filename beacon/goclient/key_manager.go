@@ -1,6 +1,7 @@
 package goclient
 
 import (
+	"github.com/bloxapp/eth2-key-manager/core"
 	"github.com/bloxapp/ssv/ibft/proto"
 	"github.com/herumi/bls-eth-go-binary/bls"
 )
@@ -9,6 +10,14 @@ func (gc *goClient) AddShare(shareKey *bls.SecretKey) error {
 	return gc.keyManager.AddShare(shareKey)
 }
 
+func (gc *goClient) RemoveShare(pubKey string) error {
+	return gc.keyManager.RemoveShare(pubKey)
+}
+
+func (gc *goClient) SlashingProtector() core.SlashingProtector {
+	return gc.keyManager.SlashingProtector()
+}
+
 func (gc *goClient) SignIBFTMessage(message *proto.Message, pk []byte) ([]byte, error) {
 	return gc.keyManager.SignIBFTMessage(message, pk)
 }
@@ -0,0 +1,241 @@
+package goclient
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog"
+	"go.uber.org/zap"
+)
+
+const (
+	requestTimeout = 5 * time.Second
+)
+
+var (
+	metricsBeaconEndpointStatus = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ssv:beacon:endpoint_status",
+		Help: "Status of each of the configured beacon node endpoints",
+	}, []string{"address"})
+	metricsBeaconEndpointRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:beacon:endpoint_requests",
+		Help: "Count of requests to each of the configured beacon node endpoints",
+	}, []string{"address", "method", "result"})
+)
+
+// connectLock serializes the creation of http clients, as http.New sets the package level logger of go-eth2-client
+var connectLock sync.Mutex
+
+func init() {
+	if err := prometheus.Register(metricsBeaconEndpointStatus); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsBeaconEndpointRequests); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+}
+
+// parseBeaconNodeAddrs splits a comma separated list of beacon node addresses
+func parseBeaconNodeAddrs(addrs string) []string {
+	var ret []string
+	for _, addr := range strings.Split(addrs, ",") {
+		if addr = strings.TrimSpace(addr); len(addr) > 0 {
+			ret = append(ret, addr)
+		}
+	}
+	return ret
+}
+
+// beaconNode is a single beacon node endpoint, the underlying client is created lazily
+// so a node that is down on startup can join once it is reachable
+type beaconNode struct {
+	address string
+
+	// connectLock serializes connection attempts without blocking status reads
+	connectLock sync.Mutex
+	lock        sync.RWMutex
+	client      client.Service
+	status      beaconNodeStatus
+}
+
+func newBeaconNode(address string) *beaconNode {
+	n := &beaconNode{address: address, status: statusUnknown}
+	metricsBeaconEndpointStatus.WithLabelValues(address).Set(float64(statusUnknown))
+	return n
+}
+
+// getClient returns the client of the node, connecting to the node if needed
+func (n *beaconNode) getClient(ctx context.Context) (client.Service, error) {
+	if c := n.connectedClient(); c != nil {
+		return c, nil
+	}
+
+	n.connectLock.Lock()
+	defer n.connectLock.Unlock()
+	if c := n.connectedClient(); c != nil {
+		return c, nil
+	}
+	connectLock.Lock()
+	defer connectLock.Unlock()
+	c, err := http.New(ctx,
+		// WithAddress supplies the address of the beacon node, in host:port format.
+		http.WithAddress(n.address),
+		// LogLevel supplies the level of logging to carry out.
+		http.WithLogLevel(zerolog.DebugLevel),
+		http.WithTimeout(requestTimeout),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create http client")
+	}
+	n.lock.Lock()
+	n.client = c
+	n.lock.Unlock()
+	return c, nil
+}
+
+// connectedClient returns the client of the node or nil if not connected yet
+func (n *beaconNode) connectedClient() client.Service {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return n.client
+}
+
+func (n *beaconNode) getStatus() beaconNodeStatus {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return n.status
+}
+
+func (n *beaconNode) setStatus(status beaconNodeStatus) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.status = status
+	metricsBeaconEndpointStatus.WithLabelValues(n.address).Set(float64(status))
+}
+
+// checkHealth updates the status of the node according to its sync state
+func (n *beaconNode) checkHealth(ctx context.Context) error {
+	c, err := n.getClient(ctx)
+	if err != nil {
+		n.setStatus(statusUnknown)
+		return errors.Wrap(err, "not connected to beacon node")
+	}
+	if provider, isProvider := c.(eth2client.NodeSyncingProvider); isProvider {
+		ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		defer cancel()
+		syncState, err := provider.NodeSyncing(ctx)
+		if err != nil {
+			n.setStatus(statusUnknown)
+			return errors.New("could not get beacon node sync state")
+		}
+		if syncState != nil && syncState.IsSyncing {
+			n.setStatus(statusSyncing)
+			return errors.Errorf("beacon node is currently syncing: head=%d, distance=%d",
+				syncState.HeadSlot, syncState.SyncDistance)
+		}
+	}
+	n.setStatus(statusOK)
+	return nil
+}
+
+// sortedNodes returns the nodes ordered by their health, keeping the configured order for nodes with the same status
+func (gc *goClient) sortedNodes() []*beaconNode {
+	nodes := make([]*beaconNode, len(gc.nodes))
+	statuses := make(map[*beaconNode]beaconNodeStatus, len(gc.nodes))
+	for i, n := range gc.nodes {
+		nodes[i] = n
+		statuses[n] = n.getStatus()
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return statuses[nodes[i]] > statuses[nodes[j]]
+	})
+	return nodes
+}
+
+// call runs the given function on the beacon nodes until one of them succeeds.
+// healthy nodes are tried first, a node that fails is marked as unknown until the next health check
+func (gc *goClient) call(method string, fn func(ctx context.Context, c client.Service) error) error {
+	var errs []string
+	for _, node := range gc.sortedNodes() {
+		err := gc.callNode(node, method, fn)
+		if err == nil {
+			return nil
+		}
+		gc.logger.Debug("beacon node call failed", zap.String("method", method),
+			zap.String("address", node.address), zap.Error(err))
+		errs = append(errs, fmt.Sprintf("%s: %s", node.address, err))
+	}
+	return errors.Errorf("all beacon nodes failed: %s", strings.Join(errs, "; "))
+}
+
+// broadcast runs the given function on all the beacon nodes concurrently, succeeds if at least one of the nodes succeeded
+func (gc *goClient) broadcast(method string, fn func(ctx context.Context, c client.Service) error) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(gc.nodes))
+	for i, node := range gc.nodes {
+		wg.Add(1)
+		go func(i int, node *beaconNode) {
+			defer wg.Done()
+			errs[i] = gc.callNode(node, method, fn)
+		}(i, node)
+	}
+	wg.Wait()
+
+	var failures []string
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		gc.logger.Debug("beacon node call failed", zap.String("method", method),
+			zap.String("address", gc.nodes[i].address), zap.Error(err))
+		failures = append(failures, fmt.Sprintf("%s: %s", gc.nodes[i].address, err))
+	}
+	if len(failures) == len(gc.nodes) {
+		return errors.Errorf("all beacon nodes failed: %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// callNode runs the given function on a single node within the request timeout
+func (gc *goClient) callNode(node *beaconNode, method string, fn func(ctx context.Context, c client.Service) error) error {
+	c, err := node.getClient(gc.ctx)
+	if err != nil {
+		node.setStatus(statusUnknown)
+		metricsBeaconEndpointRequests.WithLabelValues(node.address, method, "failure").Inc()
+		return err
+	}
+	ctx, cancel := context.WithTimeout(gc.ctx, requestTimeout)
+	defer cancel()
+	if err := fn(ctx, c); err != nil {
+		node.setStatus(statusUnknown)
+		metricsBeaconEndpointRequests.WithLabelValues(node.address, method, "failure").Inc()
+		return err
+	}
+	metricsBeaconEndpointRequests.WithLabelValues(node.address, method, "success").Inc()
+	return nil
+}
+
+// monitorHealth checks the health of the nodes every slot, so calls are routed to healthy nodes
+func (gc *goClient) monitorHealth(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-gc.ctx.Done():
+			return
+		case <-ticker.C:
+			gc.HealthCheck()
+		}
+	}
+}
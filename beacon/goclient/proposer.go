@@ -0,0 +1,57 @@
+package goclient
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	eth2client "github.com/attestantio/go-eth2-client"
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/pkg/errors"
+)
+
+// GetBeaconBlock returns a beacon block proposal for the given slot, built with the given randao reveal
+func (gc *goClient) GetBeaconBlock(slot spec.Slot, randaoReveal spec.BLSSignature) (*eth2spec.VersionedBeaconBlock, error) {
+	graffiti := make([]byte, 32)
+	copy(graffiti, gc.graffiti)
+	var block *eth2spec.VersionedBeaconBlock
+	err := gc.call("beacon_block_proposal", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.BeaconBlockProposalProvider)
+		if !isProvider {
+			return errors.New("client does not support BeaconBlockProposalProvider")
+		}
+		var err error
+		block, err = provider.BeaconBlockProposal(ctx, slot, randaoReveal, graffiti)
+		if err != nil {
+			return err
+		}
+		if block == nil || block.IsEmpty() {
+			return errors.New("received an empty beacon block proposal")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// SubmitBeaconBlock submit the signed beacon block to all the beacon nodes
+func (gc *goClient) SubmitBeaconBlock(block *eth2spec.VersionedSignedBeaconBlock) error {
+	return gc.broadcast("submit_beacon_block", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.BeaconBlockSubmitter)
+		if !isProvider {
+			return errors.New("client does not support BeaconBlockSubmitter")
+		}
+		return provider.SubmitBeaconBlock(ctx, block)
+	})
+}
+
+func (gc *goClient) SignRandaoReveal(epoch spec.Epoch, pk []byte) ([]byte, []byte, error) {
+	return gc.keyManager.SignRandaoReveal(epoch, pk)
+}
+
+func (gc *goClient) SignBeaconBlock(block *eth2spec.VersionedBeaconBlock, duty *beacon.Duty, pk []byte) (*eth2spec.VersionedSignedBeaconBlock, []byte, error) {
+	return gc.keyManager.SignBeaconBlock(block, duty, pk)
+}
@@ -1,6 +1,9 @@
 package goclient
 
 import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
 	eth2client "github.com/attestantio/go-eth2-client"
 	phase0spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/bloxapp/ssv/beacon"
@@ -37,45 +40,75 @@ func (gc *goClient) GetDomain(data *phase0spec.AttestationData) ([]byte, error)
 	return domain[:], nil
 }
 
+// GetDomainForEpoch returns the signing domain of the given domain type in the given epoch
+func (gc *goClient) GetDomainForEpoch(domainType beacon.DomainType, epoch phase0spec.Epoch) ([]byte, error) {
+	dt, err := gc.getDomainTypeByName(domainType)
+	if err != nil {
+		return nil, err
+	}
+	domain, err := gc.getDomainData(dt, epoch)
+	if err != nil {
+		return nil, err
+	}
+	return domain[:], nil
+}
+
 // getDomainType returns domain type by role type
 func (gc *goClient) getDomainType(roleType beacon.RoleType) (*phase0spec.DomainType, error) {
-	if provider, isProvider := gc.client.(eth2client.SpecProvider); isProvider {
-		spec, err := provider.Spec(gc.ctx)
-		if err != nil {
-			return nil, err
-		}
-		var val interface{}
-		var exists bool
-		switch roleType {
-		case beacon.RoleTypeAttester:
-			val, exists = spec["DOMAIN_BEACON_ATTESTER"]
-		case beacon.RoleTypeAggregator:
-			val, exists = spec["DOMAIN_AGGREGATE_AND_PROOF"]
-		case beacon.RoleTypeProposer:
-			val, exists = spec["DOMAIN_BEACON_PROPOSER"]
-		default:
-			return nil, errors.New("role type domain is not implemented")
-		}
+	switch roleType {
+	case beacon.RoleTypeAttester:
+		return gc.getDomainTypeByName(beacon.DomainBeaconAttester)
+	case beacon.RoleTypeAggregator:
+		return gc.getDomainTypeByName(beacon.DomainAggregateAndProof)
+	case beacon.RoleTypeProposer:
+		return gc.getDomainTypeByName(beacon.DomainBeaconProposer)
+	default:
+		return nil, errors.New("role type domain is not implemented")
+	}
+}
 
-		if !exists {
-			return nil, errors.New("spec type is missing")
+// getDomainTypeByName returns the domain type from the spec of the beacon node
+func (gc *goClient) getDomainTypeByName(name beacon.DomainType) (*phase0spec.DomainType, error) {
+	var spec map[string]interface{}
+	err := gc.call("spec", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.SpecProvider)
+		if !isProvider {
+			return errors.New("client does not support SpecProvider")
 		}
-		res := val.(phase0spec.DomainType)
-		return &res, nil
+		var err error
+		spec, err = provider.Spec(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	val, exists := spec[string(name)]
+	if !exists {
+		return nil, errors.New("spec type is missing")
+	}
+	res, ok := val.(phase0spec.DomainType)
+	if !ok {
+		return nil, errors.Errorf("spec type %s is not a domain type", name)
 	}
-	return nil, errors.New("client does not support BeaconAttesterDomainProvider")
+	return &res, nil
 }
 
 // getDomainData return domain data by domain type
 func (gc *goClient) getDomainData(domainType *phase0spec.DomainType, epoch phase0spec.Epoch) (*phase0spec.Domain, error) { // TODO need to add cache (?)
-	if provider, isProvider := gc.client.(eth2client.DomainProvider); isProvider {
-		attestationData, err := provider.Domain(gc.ctx, *domainType, epoch)
-		if err != nil {
-			return nil, err
+	var domain phase0spec.Domain
+	err := gc.call("domain", func(ctx context.Context, c client.Service) error {
+		provider, isProvider := c.(eth2client.DomainProvider)
+		if !isProvider {
+			return errors.New("client does not support DomainProvider")
 		}
-		return &attestationData, nil
+		var err error
+		domain, err = provider.Domain(ctx, *domainType, epoch)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil, errors.New("client does not support DomainProvider")
+	return &domain, nil
 }
 
 // ComputeSigningRoot computes the root of the object by calculating the hash tree root of the signing data with the given domain.
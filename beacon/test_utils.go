@@ -2,7 +2,9 @@ package beacon
 
 import (
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/eth2-key-manager/core"
 	"github.com/bloxapp/ssv/ibft/proto"
 	"github.com/herumi/bls-eth-go-binary/bls"
 	"sync"
@@ -62,6 +64,38 @@ func (m *mockBeacon) SubmitAttestation(attestation *spec.Attestation) error {
 	return nil
 }
 
+func (m *mockBeacon) GetBeaconBlock(slot spec.Slot, randaoReveal spec.BLSSignature) (*eth2spec.VersionedBeaconBlock, error) {
+	return nil, nil
+}
+
+func (m *mockBeacon) SubmitBeaconBlock(block *eth2spec.VersionedSignedBeaconBlock) error {
+	return nil
+}
+
+func (m *mockBeacon) SignRandaoReveal(epoch spec.Epoch, pk []byte) ([]byte, []byte, error) {
+	return nil, nil, nil
+}
+
+func (m *mockBeacon) SignBeaconBlock(block *eth2spec.VersionedBeaconBlock, duty *Duty, pk []byte) (*eth2spec.VersionedSignedBeaconBlock, []byte, error) {
+	return nil, nil, nil
+}
+
+func (m *mockBeacon) GetAggregateAttestation(slot spec.Slot, committeeIndex spec.CommitteeIndex) (*spec.Attestation, error) {
+	return nil, nil
+}
+
+func (m *mockBeacon) SubmitSignedAggregateSelectionProof(msg *spec.SignedAggregateAndProof) error {
+	return nil
+}
+
+func (m *mockBeacon) SignSlot(slot spec.Slot, pk []byte) ([]byte, []byte, error) {
+	return nil, nil, nil
+}
+
+func (m *mockBeacon) SignAggregateAndProof(msg *spec.AggregateAndProof, duty *Duty, pk []byte) (*spec.SignedAggregateAndProof, []byte, error) {
+	return nil, nil, nil
+}
+
 func (m *mockBeacon) SubscribeToCommitteeSubnet(subscription []*v1.BeaconCommitteeSubscription) error {
 	return nil
 }
@@ -70,6 +104,14 @@ func (m *mockBeacon) AddShare(shareKey *bls.SecretKey) error {
 	return nil
 }
 
+func (m *mockBeacon) RemoveShare(pubKey string) error {
+	return nil
+}
+
+func (m *mockBeacon) SlashingProtector() core.SlashingProtector {
+	return nil
+}
+
 func (m *mockBeacon) SignIBFTMessage(message *proto.Message, pk []byte) ([]byte, error) {
 	return nil, nil
 }
@@ -77,6 +119,9 @@ func (m *mockBeacon) SignIBFTMessage(message *proto.Message, pk []byte) ([]byte,
 func (m *mockBeacon) GetDomain(data *spec.AttestationData) ([]byte, error) {
 	panic("implement")
 }
+func (m *mockBeacon) GetDomainForEpoch(domainType DomainType, epoch spec.Epoch) ([]byte, error) {
+	panic("implement")
+}
 func (m *mockBeacon) ComputeSigningRoot(object interface{}, domain []byte) ([32]byte, error) {
 	panic("implement")
 }
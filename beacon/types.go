@@ -26,3 +26,15 @@ const (
 	RoleTypeAggregator
 	RoleTypeProposer
 )
+
+// DomainType is the name of a signature domain, as it appears in the beacon spec
+type DomainType string
+
+// List of domain types
+const (
+	DomainBeaconProposer    DomainType = "DOMAIN_BEACON_PROPOSER"
+	DomainBeaconAttester    DomainType = "DOMAIN_BEACON_ATTESTER"
+	DomainRandao            DomainType = "DOMAIN_RANDAO"
+	DomainSelectionProof    DomainType = "DOMAIN_SELECTION_PROOF"
+	DomainAggregateAndProof DomainType = "DOMAIN_AGGREGATE_AND_PROOF"
+)
@@ -1,27 +1,101 @@
 package valcheck
 
 import (
-	"encoding/json"
-	"github.com/bloxapp/ssv/ibft/proto"
+	"bytes"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/eth2-key-manager/core"
+	"github.com/bloxapp/ssv/beacon"
 	"github.com/pkg/errors"
 )
 
 // AggregatorValueCheck checks for an Aggregator type value
 type AggregatorValueCheck struct {
+	duty      *beacon.Duty
+	sharePk   []byte
+	protector core.SlashingProtector
+	// attestationData is the attestation data of the duty's slot and committee, as provided by the beacon node
+	attestationData *spec.AttestationData
 }
 
 // Check returns error if value is invalid
 func (v *AggregatorValueCheck) Check(value []byte) error {
-	// try and parse to attestation data
-	inputValue := &proto.InputValue_Aggregation{}
-	if err := json.Unmarshal(value, &inputValue); err != nil {
-		return errors.Wrap(err, "could not parse input value storing attestation data")
+	// try and parse to aggregate and proof
+	inputValue := &spec.AggregateAndProof{}
+	if err := inputValue.UnmarshalSSZ(value); err != nil {
+		return errors.Wrap(err, "could not parse input value storing aggregate and proof")
+	}
+
+	if inputValue.Aggregate == nil || inputValue.Aggregate.Data == nil {
+		return errors.New("aggregate data is missing")
+	}
+	if inputValue.Aggregate.Data.Slot != v.duty.Slot {
+		return errors.Errorf("aggregate slot %d is different than duty slot %d", inputValue.Aggregate.Data.Slot, v.duty.Slot)
+	}
+	if inputValue.Aggregate.Data.Index != v.duty.CommitteeIndex {
+		return errors.Errorf("aggregate committee index %d is different than duty committee index %d",
+			inputValue.Aggregate.Data.Index, v.duty.CommitteeIndex)
+	}
+	if inputValue.AggregatorIndex != v.duty.ValidatorIndex {
+		return errors.Errorf("aggregator index %d is different than validator index %d", inputValue.AggregatorIndex, v.duty.ValidatorIndex)
+	}
+	isAggregator, err := beacon.IsAggregator(v.duty.CommitteeLength, inputValue.SelectionProof[:])
+	if err != nil {
+		return errors.Wrap(err, "could not check if selection proof elects an aggregator")
+	}
+	if !isAggregator {
+		return errors.New("selection proof doesn't elect an aggregator")
 	}
 
-	//if inputValue.Aggregation.Message.Aggregate.Data.Slot == 0 {
-	//	return errors.New("this is an example test error")
-	//}
+	// the aggregate must be of the same attestation data that the beacon node provides for the duty
+	if err := checkAttestationData(inputValue.Aggregate.Data, v.attestationData); err != nil {
+		return errors.Wrap(err, "aggregate attestation data is invalid")
+	}
 
-	// TODO - test for slashing protection
+	// the aggregate must match the attestation data that was signed by this operator,
+	// i.e. its checkpoints must not be newer than the highest signed attestation
+	highest, err := v.protector.RetrieveHighestAttestation(v.sharePk)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve highest attestation")
+	}
+	if highest == nil || highest.Source == nil || highest.Target == nil {
+		return errors.New("highest attestation data is nil, can't determine if aggregate was signed")
+	}
+	data := inputValue.Aggregate.Data
+	if uint64(data.Target.Epoch) > uint64(highest.Target.Epoch) {
+		return errors.Errorf("aggregate target epoch %d is higher than signed target epoch %d", data.Target.Epoch, highest.Target.Epoch)
+	}
+	if uint64(data.Target.Epoch) == uint64(highest.Target.Epoch) && uint64(data.Source.Epoch) != uint64(highest.Source.Epoch) {
+		return errors.Errorf("aggregate source epoch %d is different than signed source epoch %d", data.Source.Epoch, highest.Source.Epoch)
+	}
+	return nil
+}
+
+// checkAttestationData returns an error if the given attestation data is different than the expected one
+func checkAttestationData(data, expected *spec.AttestationData) error {
+	if expected == nil || expected.Source == nil || expected.Target == nil {
+		return errors.New("expected attestation data is missing")
+	}
+	if data.Source == nil || data.Target == nil {
+		return errors.New("checkpoints are missing")
+	}
+	if data.Slot != expected.Slot {
+		return errors.Errorf("slot %d is different than expected slot %d", data.Slot, expected.Slot)
+	}
+	if data.Index != expected.Index {
+		return errors.Errorf("committee index %d is different than expected committee index %d", data.Index, expected.Index)
+	}
+	if !bytes.Equal(data.BeaconBlockRoot[:], expected.BeaconBlockRoot[:]) {
+		return errors.Errorf("beacon block root %x is different than expected beacon block root %x",
+			data.BeaconBlockRoot, expected.BeaconBlockRoot)
+	}
+	if data.Source.Epoch != expected.Source.Epoch || !bytes.Equal(data.Source.Root[:], expected.Source.Root[:]) {
+		return errors.Errorf("source checkpoint %d (%x) is different than expected source checkpoint %d (%x)",
+			data.Source.Epoch, data.Source.Root, expected.Source.Epoch, expected.Source.Root)
+	}
+	if data.Target.Epoch != expected.Target.Epoch || !bytes.Equal(data.Target.Root[:], expected.Target.Root[:]) {
+		return errors.Errorf("target checkpoint %d (%x) is different than expected target checkpoint %d (%x)",
+			data.Target.Epoch, data.Target.Root, expected.Target.Epoch, expected.Target.Root)
+	}
 	return nil
 }
@@ -1,27 +1,61 @@
 package valcheck
 
 import (
-	"encoding/json"
-	"github.com/bloxapp/ssv/ibft/proto"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/eth2-key-manager/core"
+	"github.com/bloxapp/ssv/beacon"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 )
 
-// ProposerValueCheck checks for an Aggregator type value
+// ProposerValueCheck checks for a Proposer type value
 type ProposerValueCheck struct {
+	duty      *beacon.Duty
+	sharePk   []byte
+	protector core.SlashingProtector
 }
 
 // Check returns error if value is invalid
 func (v *ProposerValueCheck) Check(value []byte) error {
-	// try and parse to attestation data
-	inputValue := &proto.InputValue_Block{}
-	if err := json.Unmarshal(value, &inputValue); err != nil {
-		return errors.Wrap(err, "could not parse input value storing attestation data")
+	// try and parse to beacon block
+	block, err := beacon.UnmarshalBeaconBlock(value)
+	if err != nil {
+		return errors.Wrap(err, "could not parse input value storing beacon block")
 	}
 
-	//if inputValue.Block.Block.Slot == 0 {
-	//	return errors.New("this is an example test error")
-	//}
+	slot, err := block.Slot()
+	if err != nil {
+		return errors.Wrap(err, "could not get block slot")
+	}
+	if slot != v.duty.Slot {
+		return errors.Errorf("block slot %d is different than duty slot %d", slot, v.duty.Slot)
+	}
+	proposerIndex, err := beacon.BlockProposerIndex(block)
+	if err != nil {
+		return errors.Wrap(err, "could not get block proposer index")
+	}
+	if proposerIndex != v.duty.ValidatorIndex {
+		return errors.Errorf("block proposer index %d is different than validator index %d", proposerIndex, v.duty.ValidatorIndex)
+	}
+	randao, err := beacon.BlockRandaoReveal(block)
+	if err != nil {
+		return errors.Wrap(err, "could not get block randao reveal")
+	}
+	if randao == (spec.BLSSignature{}) {
+		return errors.New("block randao reveal is empty")
+	}
 
-	// TODO - test for slashing protection
+	// refuse to vote for a block at a slot that was already signed
+	status, err := v.protector.IsSlashableProposal(v.sharePk, &eth.BeaconBlock{
+		Slot:          types.Slot(slot),
+		ProposerIndex: types.ValidatorIndex(proposerIndex),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not check proposal slashing")
+	}
+	if status.Status != core.ValidProposal {
+		return errors.Errorf("slashable proposal (%s), not voting", status.Status)
+	}
 	return nil
 }
@@ -1,12 +1,19 @@
 package valcheck
 
+import (
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/eth2-key-manager/core"
+	"github.com/bloxapp/ssv/beacon"
+)
+
 // SlashingProtection is a controller for different types of ethereum value and slashing protection instances
 type SlashingProtection struct {
+	protector core.SlashingProtector
 }
 
-// New returns a new instance of slashing protection
-func New() *SlashingProtection {
-	return &SlashingProtection{}
+// New returns a new instance of slashing protection, backed by the given slashing protector
+func New(protector core.SlashingProtector) *SlashingProtection {
+	return &SlashingProtection{protector: protector}
 }
 
 // AttestationSlashingProtector returns an attestation slashing protection value check
@@ -14,12 +21,13 @@ func (sp *SlashingProtection) AttestationSlashingProtector() *AttestationValueCh
 	return &AttestationValueCheck{}
 }
 
-// ProposalSlashingProtector returns a proposal slashing protection value check
-func (sp *SlashingProtection) ProposalSlashingProtector() *ProposerValueCheck {
-	return &ProposerValueCheck{}
+// ProposalSlashingProtector returns a proposal slashing protection value check for the given share pk
+func (sp *SlashingProtection) ProposalSlashingProtector(duty *beacon.Duty, sharePk []byte) *ProposerValueCheck {
+	return &ProposerValueCheck{duty: duty, sharePk: sharePk, protector: sp.protector}
 }
 
-// AggregationValidation returns an aggregation value check
-func (sp *SlashingProtection) AggregationValidation() *AggregatorValueCheck {
-	return &AggregatorValueCheck{}
+// AggregationValidation returns an aggregation value check for the given share pk,
+// the aggregate is expected to be of the given attestation data
+func (sp *SlashingProtection) AggregationValidation(duty *beacon.Duty, sharePk []byte, attestationData *spec.AttestationData) *AggregatorValueCheck {
+	return &AggregatorValueCheck{duty: duty, sharePk: sharePk, protector: sp.protector, attestationData: attestationData}
 }
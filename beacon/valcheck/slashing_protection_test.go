@@ -0,0 +1,147 @@
+package valcheck
+
+import (
+	"testing"
+
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/eth2-key-manager/core"
+	slashingprotection "github.com/bloxapp/eth2-key-manager/slashing_protection"
+	"github.com/bloxapp/eth2-key-manager/stores/inmemory"
+	"github.com/bloxapp/ssv/beacon"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+var testSharePk = []byte{1, 2, 3, 4}
+
+// testingSlashingProtection returns a slashing protection with the given highest proposal slot and highest attestation epochs
+func testingSlashingProtection(t *testing.T, highestProposalSlot types.Slot, sourceEpoch, targetEpoch types.Epoch) *SlashingProtection {
+	protector := slashingprotection.NewNormalProtection(inmemory.NewInMemStore(core.PraterNetwork))
+	require.NoError(t, protector.UpdateHighestProposal(testSharePk, &eth.BeaconBlock{Slot: highestProposalSlot}))
+	require.NoError(t, protector.UpdateHighestAttestation(testSharePk, &eth.AttestationData{
+		Source: &eth.Checkpoint{Epoch: sourceEpoch, Root: make([]byte, 32)},
+		Target: &eth.Checkpoint{Epoch: targetEpoch, Root: make([]byte, 32)},
+	}))
+	return New(protector)
+}
+
+func testingBlockBytes(t *testing.T, slot spec.Slot) []byte {
+	byts, err := beacon.MarshalBeaconBlock(&eth2spec.VersionedBeaconBlock{
+		Version: eth2spec.DataVersionPhase0,
+		Phase0: &spec.BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: 1,
+			Body: &spec.BeaconBlockBody{
+				RANDAOReveal: spec.BLSSignature{1, 2, 3},
+				ETH1Data: &spec.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				Graffiti: make([]byte, 32),
+			},
+		},
+	})
+	require.NoError(t, err)
+	return byts
+}
+
+func testingAttestationData(slot spec.Slot, sourceEpoch, targetEpoch spec.Epoch) *spec.AttestationData {
+	return &spec.AttestationData{
+		Slot:            slot,
+		BeaconBlockRoot: spec.Root{1, 2, 3},
+		Source:          &spec.Checkpoint{Epoch: sourceEpoch},
+		Target:          &spec.Checkpoint{Epoch: targetEpoch},
+	}
+}
+
+func testingAggregateBytes(t *testing.T, data *spec.AttestationData) []byte {
+	byts, err := (&spec.AggregateAndProof{
+		AggregatorIndex: 1,
+		Aggregate: &spec.Attestation{
+			AggregationBits: bitfield.NewBitlist(1),
+			Data:            data,
+		},
+	}).MarshalSSZ()
+	require.NoError(t, err)
+	return byts
+}
+
+func TestProposerValueCheck(t *testing.T) {
+	duty := &beacon.Duty{Type: beacon.RoleTypeProposer, Slot: 320, ValidatorIndex: 1}
+
+	t.Run("valid proposal", func(t *testing.T) {
+		sp := testingSlashingProtection(t, 319, 0, 0)
+		require.NoError(t, sp.ProposalSlashingProtector(duty, testSharePk).Check(testingBlockBytes(t, 320)))
+	})
+
+	t.Run("slot was already signed", func(t *testing.T) {
+		sp := testingSlashingProtection(t, 320, 0, 0)
+		require.EqualError(t, sp.ProposalSlashingProtector(duty, testSharePk).Check(testingBlockBytes(t, 320)),
+			"slashable proposal (HighestProposalVote), not voting")
+	})
+
+	t.Run("unknown share", func(t *testing.T) {
+		sp := testingSlashingProtection(t, 0, 0, 0)
+		require.EqualError(t, sp.ProposalSlashingProtector(duty, []byte{5, 6}).Check(testingBlockBytes(t, 320)),
+			"could not check proposal slashing: highest proposal data is nil, can't determine if proposal is slashable")
+	})
+}
+
+func TestAggregatorValueCheck(t *testing.T) {
+	duty := &beacon.Duty{Type: beacon.RoleTypeAggregator, Slot: 320, ValidatorIndex: 1, CommitteeLength: 1}
+
+	t.Run("matches signed attestation", func(t *testing.T) {
+		sp := testingSlashingProtection(t, 0, 9, 10)
+		data := testingAttestationData(320, 9, 10)
+		require.NoError(t, sp.AggregationValidation(duty, testSharePk, data).Check(testingAggregateBytes(t, data)))
+	})
+
+	t.Run("target was not signed", func(t *testing.T) {
+		sp := testingSlashingProtection(t, 0, 8, 9)
+		data := testingAttestationData(320, 9, 10)
+		require.EqualError(t, sp.AggregationValidation(duty, testSharePk, data).Check(testingAggregateBytes(t, data)),
+			"aggregate target epoch 10 is higher than signed target epoch 9")
+	})
+
+	t.Run("different source", func(t *testing.T) {
+		sp := testingSlashingProtection(t, 0, 9, 10)
+		data := testingAttestationData(320, 8, 10)
+		require.EqualError(t, sp.AggregationValidation(duty, testSharePk, data).Check(testingAggregateBytes(t, data)),
+			"aggregate source epoch 8 is different than signed source epoch 9")
+	})
+
+	t.Run("different beacon block root", func(t *testing.T) {
+		sp := testingSlashingProtection(t, 0, 9, 10)
+		data := testingAttestationData(320, 9, 10)
+		expected := testingAttestationData(320, 9, 10)
+		expected.BeaconBlockRoot = spec.Root{4, 5, 6}
+		err := sp.AggregationValidation(duty, testSharePk, expected).Check(testingAggregateBytes(t, data))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "aggregate attestation data is invalid: beacon block root")
+	})
+
+	t.Run("different target checkpoint", func(t *testing.T) {
+		sp := testingSlashingProtection(t, 0, 9, 10)
+		data := testingAttestationData(320, 9, 10)
+		expected := testingAttestationData(320, 9, 10)
+		expected.Target.Root = spec.Root{4, 5, 6}
+		err := sp.AggregationValidation(duty, testSharePk, expected).Check(testingAggregateBytes(t, data))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "aggregate attestation data is invalid: target checkpoint")
+	})
+
+	t.Run("no expected attestation data", func(t *testing.T) {
+		sp := testingSlashingProtection(t, 0, 9, 10)
+		require.EqualError(t, sp.AggregationValidation(duty, testSharePk, nil).Check(testingAggregateBytes(t, testingAttestationData(320, 9, 10))),
+			"aggregate attestation data is invalid: expected attestation data is missing")
+	})
+
+	t.Run("no signed attestation", func(t *testing.T) {
+		sp := testingSlashingProtection(t, 0, 9, 10)
+		data := testingAttestationData(320, 9, 10)
+		require.EqualError(t, sp.AggregationValidation(duty, []byte{5, 6}, data).Check(testingAggregateBytes(t, data)),
+			"highest attestation data is nil, can't determine if aggregate was signed")
+	})
+}
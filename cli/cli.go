@@ -35,4 +35,6 @@ func init() {
 	RootCmd.AddCommand(bootnode.StartBootNodeCmd)
 	RootCmd.AddCommand(exporter.StartExporterNodeCmd)
 	RootCmd.AddCommand(operator.StartNodeCmd)
+	RootCmd.AddCommand(operator.SlashingProtectionCmd)
+	RootCmd.AddCommand(operator.DBCmd)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rsa"
 	"fmt"
+	"github.com/bloxapp/eth2-key-manager/core"
 	"github.com/bloxapp/ssv/beacon"
 	"github.com/bloxapp/ssv/beacon/goclient"
 	global_config "github.com/bloxapp/ssv/cli/config"
@@ -11,9 +12,12 @@ import (
 	"github.com/bloxapp/ssv/eth1/goeth"
 	"github.com/bloxapp/ssv/exporter"
 	"github.com/bloxapp/ssv/exporter/api"
+	exporterstorage "github.com/bloxapp/ssv/exporter/storage"
 	"github.com/bloxapp/ssv/migrations"
 	"github.com/bloxapp/ssv/monitoring/metrics"
 	networkForkV0 "github.com/bloxapp/ssv/network/forks/v0"
+	networkForkV1 "github.com/bloxapp/ssv/network/forks/v1"
+	networkForkV2 "github.com/bloxapp/ssv/network/forks/v2"
 	"github.com/bloxapp/ssv/network/p2p"
 	"github.com/bloxapp/ssv/storage"
 	"github.com/bloxapp/ssv/storage/basedb"
@@ -41,11 +45,15 @@ type config struct {
 	EnableProfile                   bool          `yaml:"EnableProfile" env:"ENABLE_PROFILE" env-description:"flag that indicates whether go profiling tools are enabled"`
 	IbftSyncEnabled                 bool          `yaml:"IbftSyncEnabled" env:"IBFT_SYNC_ENABLED" env-default:"true" env-description:"enable ibft sync for all topics"`
 	ValidatorMetaDataUpdateInterval time.Duration `yaml:"ValidatorMetaDataUpdateInterval" env:"VALIDATOR_METADATA_UPDATE_INTERVAL" env-default:"12m" env-description:"set the interval at which validator metadata gets updated"`
+	DecidedGapsScanInterval         time.Duration `yaml:"DecidedGapsScanInterval" env:"DECIDED_GAPS_SCAN_INTERVAL" env-default:"30m" env-description:"set the interval at which stored decided messages are scanned for gaps"`
 	NetworkPrivateKey               string        `yaml:"NetworkPrivateKey" env:"NETWORK_PRIVATE_KEY" env-description:"private key for network identity"`
 
 	// TODO: change this after network refactoring
 	NumOfInstances int `yaml:"NumOfInstances" env:"NUM_OF_INSTANCES" env-default:"1" env-description:"number of existing exporter instances"`
 	InstanceID     int `yaml:"InstanceID" env:"INSTANCE_ID" env-default:"0" env-description:"current instance ID"`
+
+	NetworkForkSlot        uint64 `yaml:"NetworkForkSlot" env:"NETWORK_FORK_SLOT" env-description:"slot on which network messages are encoded as protobuf + snappy (fork v1), disabled if not set"`
+	NetworkSubnetsForkSlot uint64 `yaml:"NetworkSubnetsForkSlot" env:"NETWORK_SUBNETS_FORK_SLOT" env-description:"slot on which validators are mapped into subnets instead of a topic per validator (fork v2), requires NetworkForkSlot, disabled if not set"`
 }
 
 var cfg config
@@ -102,7 +110,20 @@ var StartExporterNodeCmd = &cobra.Command{
 		}
 		cfg.P2pNetworkConfig.ReportLastMsg = true
 		// TODO add fork interface for exporter or use the same forks as in operator
-		cfg.P2pNetworkConfig.Fork = networkForkV0.New()
+		if cfg.NetworkSubnetsForkSlot > 0 && cfg.NetworkForkSlot == 0 {
+			// fork v2 is built on top of the encoding of fork v1, which would be used from genesis
+			Logger.Fatal("network subnets fork slot requires the network fork slot to be set")
+		}
+		if cfg.NetworkSubnetsForkSlot > 0 {
+			Logger.Info("using network fork v2", zap.Uint64("forkSlot", cfg.NetworkForkSlot),
+				zap.Uint64("subnetsForkSlot", cfg.NetworkSubnetsForkSlot))
+			cfg.P2pNetworkConfig.Fork = networkForkV2.New(cfg.NetworkForkSlot, cfg.NetworkSubnetsForkSlot)
+		} else if cfg.NetworkForkSlot > 0 {
+			Logger.Info("using network fork v1", zap.Uint64("forkSlot", cfg.NetworkForkSlot))
+			cfg.P2pNetworkConfig.Fork = networkForkV1.New(cfg.NetworkForkSlot)
+		} else {
+			cfg.P2pNetworkConfig.Fork = networkForkV0.New()
+		}
 		cfg.P2pNetworkConfig.NodeType = p2p.Exporter
 		network, err := p2p.New(cmd.Context(), Logger, &cfg.P2pNetworkConfig)
 		if err != nil {
@@ -123,7 +144,9 @@ var StartExporterNodeCmd = &cobra.Command{
 			NodeAddr:             cfg.ETH1Options.ETH1Addr,
 			ContractABI:          eth1.ContractABI(cfg.ETH1Options.AbiVersion),
 			ConnectionTimeout:    cfg.ETH1Options.ETH1ConnectionTimeout,
+			HeadTimeout:          cfg.ETH1Options.ETH1HeadTimeout,
 			RegistryContractAddr: cfg.ETH1Options.RegistryContractAddr,
+			SyncOffsetStorage:    exporterstorage.NewExporterStorage(db, Logger),
 			// using an empty private key provider
 			// because the exporter doesn't run in the context of an operator
 			ShareEncryptionKeyProvider: func() (*rsa.PrivateKey, bool, error) {
@@ -150,6 +173,9 @@ var StartExporterNodeCmd = &cobra.Command{
 		exporterOptions.Beacon = beaconClient
 		exporterOptions.Logger = Logger
 		exporterOptions.Network = network
+		exporterOptions.NetworkFork = cfg.P2pNetworkConfig.Fork
+		eth2Network := core.NetworkFromString(cfg.ETH2Options.Network)
+		exporterOptions.ETHNetwork = &eth2Network
 		exporterOptions.DB = db
 		exporterOptions.Ctx = cmd.Context()
 		exporterOptions.WS = api.NewWsServer(cmd.Context(), Logger, nil, http.NewServeMux(), cfg.WithPing)
@@ -157,6 +183,7 @@ var StartExporterNodeCmd = &cobra.Command{
 		exporterOptions.IbftSyncEnabled = cfg.IbftSyncEnabled
 		exporterOptions.CleanRegistryData = cfg.ETH1Options.CleanRegistryData
 		exporterOptions.ValidatorMetaDataUpdateInterval = cfg.ValidatorMetaDataUpdateInterval
+		exporterOptions.DecidedGapsScanInterval = cfg.DecidedGapsScanInterval
 		exporterOptions.UseMainTopic = cfg.P2pNetworkConfig.UseMainTopic
 		exporterOptions.NumOfInstances = cfg.NumOfInstances
 		exporterOptions.InstanceID = cfg.InstanceID
@@ -0,0 +1,22 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/bloxapp/ssv/utils/cliflag"
+)
+
+// Flag names.
+const (
+	backupFileFlag = "file"
+)
+
+// AddBackupFileFlag adds the backup file flag to the command
+func AddBackupFileFlag(c *cobra.Command) {
+	cliflag.AddPersistentStringFlag(c, backupFileFlag, "", "Path to db backup file", true)
+}
+
+// GetBackupFileFlagValue gets the backup file flag from the command
+func GetBackupFileFlagValue(c *cobra.Command) (string, error) {
+	return c.Flags().GetString(backupFileFlag)
+}
@@ -0,0 +1,198 @@
+package operator
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/bloxapp/eth2-key-manager/core"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/beacon/goclient/ekm"
+	global_config "github.com/bloxapp/ssv/cli/config"
+	"github.com/bloxapp/ssv/cli/flags"
+	"github.com/bloxapp/ssv/migrations"
+	"github.com/bloxapp/ssv/storage"
+	"github.com/bloxapp/ssv/storage/backup"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/utils/commons"
+	"github.com/bloxapp/ssv/utils/logex"
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+type dbConfig struct {
+	global_config.GlobalConfig `yaml:"global"`
+	DBOptions                  basedb.Options `yaml:"db"`
+	ETH2Options                beacon.Options `yaml:"eth2"`
+
+	DBBackupSocket string `yaml:"DBBackupSocket" env:"DB_BACKUP_SOCKET" env-description:"Path of a unix socket on which the node serves online db backups, disabled if not set"`
+}
+
+var dbCfg dbConfig
+
+var dbArgs global_config.Args
+
+// DBCmd is the command for managing the node's db
+var DBCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Backups or restores the node's db",
+}
+
+// backupDBCmd is the command to backup the node's db into a file
+var backupDBCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Backups the node's db into a file, the backup is taken from the running node if it serves backups (DBBackupSocket)",
+	Run: func(cmd *cobra.Command, args []string) {
+		logger := setupDBCmd()
+
+		filePath, err := flags.GetBackupFileFlagValue(cmd)
+		if err != nil {
+			logger.Fatal("failed to get backup file flag value", zap.Error(err))
+		}
+		// the backup is written into a temp file, which is renamed only once it is complete
+		tmpPath := filePath + ".tmp"
+		f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			logger.Fatal("failed to create backup file", zap.Error(err))
+		}
+		if dbCfg.DBBackupSocket != "" && backup.Serving(dbCfg.DBBackupSocket) {
+			logger.Info("taking backup from the running node", zap.String("socket", dbCfg.DBBackupSocket))
+			err = backup.Fetch(cmd.Context(), dbCfg.DBBackupSocket, f)
+		} else {
+			err = backupDB(cmd, logger, f)
+		}
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(tmpPath)
+			logger.Fatal("failed to backup db", zap.Error(err))
+		}
+		if err := os.Rename(tmpPath, filePath); err != nil {
+			logger.Fatal("failed to rename backup file", zap.Error(err))
+		}
+		logger.Info("db backup was created", zap.String("file", filePath))
+	},
+}
+
+// restoreDBCmd is the command to restore the node's db from a backup file
+var restoreDBCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restores the node's db from a backup file, the node must be stopped and the db path must be empty",
+	Run: func(cmd *cobra.Command, args []string) {
+		logger := setupDBCmd()
+		network := core.NetworkFromString(dbCfg.ETH2Options.Network)
+		if network == "" {
+			logger.Fatal("unknown eth2 network", zap.String("network", dbCfg.ETH2Options.Network))
+		}
+
+		filePath, err := flags.GetBackupFileFlagValue(cmd)
+		if err != nil {
+			logger.Fatal("failed to get backup file flag value", zap.Error(err))
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			logger.Fatal("failed to open backup file", zap.Error(err))
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		header, r, err := backup.Read(f)
+		if err != nil {
+			logger.Fatal("failed to read backup file", zap.Error(err))
+		}
+		if err := migrations.CheckVersion(header.MigrationsVersion); err != nil {
+			logger.Fatal("backup was created by a newer version of the node", zap.Error(err))
+		}
+
+		db, backuper, err := openBackupDB(cmd, logger)
+		if err != nil {
+			logger.Fatal("failed to open db", zap.Error(err))
+		}
+		defer db.Close()
+		if !isEmptyDB(db) {
+			logger.Fatal("db is not empty, restore requires an empty db path", zap.String("path", dbCfg.DBOptions.Path))
+		}
+		if err := backuper.Restore(r); err != nil {
+			logger.Fatal("failed to restore db", zap.Error(err))
+		}
+		// the backup might be older than messages that were signed since it was taken
+		protected, err := ekm.RaiseSlashingProtection(db, network)
+		if err != nil {
+			logger.Fatal("failed to raise slashing protection of the restored db", zap.Error(err))
+		}
+		logger.Warn("slashing protection was raised to the current slot and epoch, the node won't propose "+
+			"in the current slot and won't attest until a newer epoch is justified. "+
+			"import a current slashing protection interchange if there is one (see slashing-protection import)",
+			zap.Int("shares", protected), zap.Uint64("slot", uint64(network.EstimatedCurrentSlot())),
+			zap.Uint64("epoch", uint64(network.EstimatedCurrentEpoch())))
+		version, err := migrations.Version(db)
+		if err != nil {
+			logger.Fatal("failed to read migrations version of the restored db", zap.Error(err))
+		}
+		logger.Info("db was restored", zap.String("file", filePath),
+			zap.Time("createdAt", header.CreatedAt), zap.Int("migrationsVersion", version))
+	},
+}
+
+// backupDB takes a backup by opening the db, which is possible only if the node is stopped
+func backupDB(cmd *cobra.Command, logger *zap.Logger, f *os.File) error {
+	db, backuper, err := openBackupDB(cmd, logger)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	version, err := migrations.Version(db)
+	if err != nil {
+		return err
+	}
+	return backup.Write(f, backuper, version)
+}
+
+// setupDBCmd reads the config and builds the logger
+func setupDBCmd() *zap.Logger {
+	if err := cleanenv.ReadConfig(dbArgs.ConfigPath, &dbCfg); err != nil {
+		log.Fatal(err)
+	}
+	loggerLevel, errLogLevel := logex.GetLoggerLevelValue(dbCfg.LogLevel)
+	logger := logex.Build(commons.GetBuildData(), loggerLevel, &logex.EncodingConfig{
+		Format:       dbCfg.GlobalConfig.LogFormat,
+		LevelEncoder: logex.LevelEncoder([]byte(dbCfg.LogLevelFormat)),
+	})
+	if errLogLevel != nil {
+		logger.Warn(fmt.Sprintf("Default log level set to %s", loggerLevel), zap.Error(errLogLevel))
+	}
+	return logger
+}
+
+// openBackupDB opens the node's db, it fails if the db is in use or if it doesn't support backups
+func openBackupDB(cmd *cobra.Command, logger *zap.Logger) (basedb.IDb, basedb.Backuper, error) {
+	dbCfg.DBOptions.Logger = logger
+	dbCfg.DBOptions.Ctx = cmd.Context()
+	db, err := storage.GetStorageFactory(dbCfg.DBOptions)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not open db, make sure the node is stopped or serves backups")
+	}
+	backuper, ok := db.(basedb.Backuper)
+	if !ok {
+		db.Close()
+		return nil, nil, errors.Errorf("db type %q doesn't support backups", dbCfg.DBOptions.Type)
+	}
+	return db, backuper, nil
+}
+
+func isEmptyDB(db basedb.IDb) bool {
+	it := db.NewIterator(nil, basedb.IteratorOptions{})
+	defer it.Close()
+	return !it.Valid()
+}
+
+func init() {
+	global_config.ProcessArgs(&dbCfg, &dbArgs, DBCmd)
+	flags.AddBackupFileFlag(DBCmd)
+
+	DBCmd.AddCommand(backupDBCmd)
+	DBCmd.AddCommand(restoreDBCmd)
+}
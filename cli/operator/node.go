@@ -15,11 +15,16 @@ import (
 	"github.com/bloxapp/ssv/eth1/goeth"
 	"github.com/bloxapp/ssv/migrations"
 	"github.com/bloxapp/ssv/monitoring/metrics"
+	networkForkV1 "github.com/bloxapp/ssv/network/forks/v1"
+	networkForkV2 "github.com/bloxapp/ssv/network/forks/v2"
 	"github.com/bloxapp/ssv/network/p2p"
 	"github.com/bloxapp/ssv/operator"
 	"github.com/bloxapp/ssv/operator/duties"
+	"github.com/bloxapp/ssv/operator/forks"
 	v0 "github.com/bloxapp/ssv/operator/forks/v0"
+	v1 "github.com/bloxapp/ssv/operator/forks/v1"
 	"github.com/bloxapp/ssv/storage"
+	"github.com/bloxapp/ssv/storage/backup"
 	"github.com/bloxapp/ssv/storage/basedb"
 	"github.com/bloxapp/ssv/utils/commons"
 	"github.com/bloxapp/ssv/utils/logex"
@@ -45,6 +50,11 @@ type config struct {
 	NetworkPrivateKey          string `yaml:"NetworkPrivateKey" env:"NETWORK_PRIVATE_KEY" env-description:"private key for network identity"`
 
 	ReadOnlyMode bool `yaml:"ReadOnlyMode" env:"READ_ONLY_MODE" env-description:"a flag to turn on read only operator"`
+
+	NetworkForkSlot        uint64 `yaml:"NetworkForkSlot" env:"NETWORK_FORK_SLOT" env-description:"slot on which network messages are encoded as protobuf + snappy (fork v1), disabled if not set"`
+	NetworkSubnetsForkSlot uint64 `yaml:"NetworkSubnetsForkSlot" env:"NETWORK_SUBNETS_FORK_SLOT" env-description:"slot on which validators are mapped into subnets instead of a topic per validator (fork v2), requires NetworkForkSlot, disabled if not set"`
+
+	DBBackupSocket string `yaml:"DBBackupSocket" env:"DB_BACKUP_SOCKET" env-description:"Path of a unix socket on which the node serves online db backups, disabled if not set"`
 }
 
 var cfg config
@@ -77,8 +87,21 @@ var StartNodeCmd = &cobra.Command{
 			Logger.Warn(fmt.Sprintf("Default log level set to %s", loggerLevel), zap.Error(errLogLevel))
 		}
 
-		// TODO - change via command line?
-		fork := v0.New()
+		var fork forks.Fork
+		if cfg.NetworkSubnetsForkSlot > 0 && cfg.NetworkForkSlot == 0 {
+			// fork v2 is built on top of the encoding of fork v1, which would be used from genesis
+			Logger.Fatal("network subnets fork slot requires the network fork slot to be set")
+		}
+		if cfg.NetworkSubnetsForkSlot > 0 {
+			Logger.Info("using network fork v2", zap.Uint64("forkSlot", cfg.NetworkForkSlot),
+				zap.Uint64("subnetsForkSlot", cfg.NetworkSubnetsForkSlot))
+			fork = v1.New(networkForkV2.New(cfg.NetworkForkSlot, cfg.NetworkSubnetsForkSlot))
+		} else if cfg.NetworkForkSlot > 0 {
+			Logger.Info("using network fork v1", zap.Uint64("forkSlot", cfg.NetworkForkSlot))
+			fork = v1.New(networkForkV1.New(cfg.NetworkForkSlot))
+		} else {
+			fork = v0.New()
+		}
 
 		cfg.DBOptions.Logger = Logger
 		cfg.DBOptions.Ctx = cmd.Context()
@@ -176,16 +199,19 @@ var StartNodeCmd = &cobra.Command{
 			Logger:                     Logger,
 			NodeAddr:                   cfg.ETH1Options.ETH1Addr,
 			ConnectionTimeout:          cfg.ETH1Options.ETH1ConnectionTimeout,
+			HeadTimeout:                cfg.ETH1Options.ETH1HeadTimeout,
 			ContractABI:                eth1.ContractABI(cfg.ETH1Options.AbiVersion),
 			RegistryContractAddr:       cfg.ETH1Options.RegistryContractAddr,
 			ShareEncryptionKeyProvider: nodeStorage.GetPrivateKey,
 			OperatorPubKey:             operatorPubKey,
+			SyncOffsetStorage:          nodeStorage,
 			AbiVersion:                 cfg.ETH1Options.AbiVersion,
 		})
 		if err != nil {
 			Logger.Fatal("failed to create eth1 client", zap.Error(err))
 		}
 
+		cfg.SSVOptions.ValidatorOptions.Eth1Client = cfg.SSVOptions.Eth1Client
 		validatorCtrl := validator.NewController(cfg.SSVOptions.ValidatorOptions)
 		cfg.SSVOptions.ValidatorController = validatorCtrl
 		if cfg.ReadOnlyMode {
@@ -193,6 +219,10 @@ var StartNodeCmd = &cobra.Command{
 		}
 		operatorNode = operator.New(cfg.SSVOptions)
 
+		if cfg.DBBackupSocket != "" {
+			go startBackupServer(cmd.Context(), Logger, db, cfg.DBBackupSocket)
+		}
+
 		if cfg.MetricsAPIPort > 0 {
 			go startMetricsHandler(cmd.Context(), Logger, cfg.MetricsAPIPort, cfg.EnableProfile)
 		}
@@ -213,6 +243,25 @@ func init() {
 	global_config.ProcessArgs(&cfg, &globalArgs, StartNodeCmd)
 }
 
+func startBackupServer(ctx context.Context, logger *zap.Logger, db basedb.IDb, socketPath string) {
+	backuper, ok := db.(basedb.Backuper)
+	if !ok {
+		logger.Error("db type doesn't support backups, backup server is disabled")
+		return
+	}
+	srv := backup.NewServer(backup.ServerOptions{
+		Logger:     logger,
+		DB:         backuper,
+		SocketPath: socketPath,
+		MigrationsVersion: func() (int, error) {
+			return migrations.Version(db)
+		},
+	})
+	if err := srv.Start(ctx); err != nil {
+		logger.Error("failed to start backup server", zap.Error(err))
+	}
+}
+
 func startMetricsHandler(ctx context.Context, logger *zap.Logger, port int, enableProf bool) {
 	// init and start HTTP handler
 	metricsHandler := metrics.NewMetricsHandler(ctx, logger, enableProf, operatorNode.(metrics.HealthCheckAgent))
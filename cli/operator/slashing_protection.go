@@ -0,0 +1,162 @@
+package operator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/bloxapp/eth2-key-manager/core"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/beacon/goclient/ekm"
+	global_config "github.com/bloxapp/ssv/cli/config"
+	"github.com/bloxapp/ssv/cli/flags"
+	"github.com/bloxapp/ssv/storage"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/utils/commons"
+	"github.com/bloxapp/ssv/utils/logex"
+	validatorstorage "github.com/bloxapp/ssv/validator/storage"
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+type slashingProtectionConfig struct {
+	global_config.GlobalConfig `yaml:"global"`
+	DBOptions                  basedb.Options `yaml:"db"`
+	ETH2Options                beacon.Options `yaml:"eth2"`
+}
+
+var slashingProtectionCfg slashingProtectionConfig
+
+var slashingProtectionArgs global_config.Args
+
+// SlashingProtectionCmd is the command for managing the slashing protection data of the node's shares
+var SlashingProtectionCmd = &cobra.Command{
+	Use:   "slashing-protection",
+	Short: "Imports or exports the slashing protection data of the node's shares (EIP-3076 interchange format)",
+}
+
+// exportSlashingProtectionCmd is the command to export the slashing protection data into an interchange file
+var exportSlashingProtectionCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Exports the slashing protection data of all the node's shares into an EIP-3076 interchange file",
+	Run: func(cmd *cobra.Command, args []string) {
+		logger, db, network := setupSlashingProtectionCmd(cmd)
+		defer db.Close()
+		shares, err := validatorShares(db, logger)
+		if err != nil {
+			logger.Fatal("failed to get validator shares", zap.Error(err))
+		}
+
+		filePath, err := flags.GetInterchangeFileFlagValue(cmd)
+		if err != nil {
+			logger.Fatal("failed to get interchange file flag value", zap.Error(err))
+		}
+		interchange, err := ekm.ExportSlashingInterchange(db, network, shares)
+		if err != nil {
+			logger.Fatal("failed to export slashing protection data", zap.Error(err))
+		}
+		data, err := json.MarshalIndent(interchange, "", "  ")
+		if err != nil {
+			logger.Fatal("failed to marshal interchange", zap.Error(err))
+		}
+		if err := ioutil.WriteFile(filePath, data, 0600); err != nil {
+			logger.Fatal("failed to write interchange file", zap.Error(err))
+		}
+		logger.Info("exported slashing protection data", zap.Int("validators", len(interchange.Data)),
+			zap.String("file", filePath))
+	},
+}
+
+// importSlashingProtectionCmd is the command to import the slashing protection data from an interchange file
+var importSlashingProtectionCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Imports the slashing protection data of the node's shares from an EIP-3076 interchange file",
+	Run: func(cmd *cobra.Command, args []string) {
+		logger, db, network := setupSlashingProtectionCmd(cmd)
+		defer db.Close()
+		shares, err := validatorShares(db, logger)
+		if err != nil {
+			logger.Fatal("failed to get validator shares", zap.Error(err))
+		}
+
+		filePath, err := flags.GetInterchangeFileFlagValue(cmd)
+		if err != nil {
+			logger.Fatal("failed to get interchange file flag value", zap.Error(err))
+		}
+		data, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			logger.Fatal("failed to read interchange file", zap.Error(err))
+		}
+		interchange := &ekm.SlashingInterchange{}
+		if err := json.Unmarshal(data, interchange); err != nil {
+			logger.Fatal("failed to unmarshal interchange", zap.Error(err))
+		}
+		imported, unmatched, err := ekm.ImportSlashingInterchange(db, network, shares, interchange)
+		if err != nil {
+			logger.Fatal("failed to import slashing protection data", zap.Error(err))
+		}
+		if len(unmatched) > 0 {
+			logger.Warn("skipped public keys that don't match any validator of the node", zap.Strings("pubkeys", unmatched))
+		}
+		logger.Info("imported slashing protection data", zap.Int("validators", imported),
+			zap.Int("skipped", len(unmatched)))
+	},
+}
+
+// validatorShares maps the node's validators to the public keys of its shares
+func validatorShares(db basedb.IDb, logger *zap.Logger) (ekm.ValidatorShares, error) {
+	collection := validatorstorage.NewCollection(validatorstorage.CollectionOptions{DB: db, Logger: logger})
+	shares, err := collection.GetAllValidatorShares()
+	if err != nil {
+		return nil, err
+	}
+	ret := make(ekm.ValidatorShares, len(shares))
+	for _, share := range shares {
+		pk, err := share.OperatorPubKey()
+		if err != nil {
+			logger.Warn("could not get share public key", zap.String("pubKey", share.PublicKey.SerializeToHexStr()),
+				zap.Error(err))
+			continue
+		}
+		ret[share.PublicKey.SerializeToHexStr()] = pk.Serialize()
+	}
+	return ret, nil
+}
+
+// setupSlashingProtectionCmd reads the config and opens the node's db
+func setupSlashingProtectionCmd(cmd *cobra.Command) (*zap.Logger, basedb.IDb, core.Network) {
+	if err := cleanenv.ReadConfig(slashingProtectionArgs.ConfigPath, &slashingProtectionCfg); err != nil {
+		log.Fatal(err)
+	}
+	loggerLevel, errLogLevel := logex.GetLoggerLevelValue(slashingProtectionCfg.LogLevel)
+	logger := logex.Build(commons.GetBuildData(), loggerLevel, &logex.EncodingConfig{
+		Format:       slashingProtectionCfg.GlobalConfig.LogFormat,
+		LevelEncoder: logex.LevelEncoder([]byte(slashingProtectionCfg.LogLevelFormat)),
+	})
+	if errLogLevel != nil {
+		logger.Warn(fmt.Sprintf("Default log level set to %s", loggerLevel), zap.Error(errLogLevel))
+	}
+
+	network := core.NetworkFromString(slashingProtectionCfg.ETH2Options.Network)
+	if network == "" {
+		logger.Fatal("unknown eth2 network", zap.String("network", slashingProtectionCfg.ETH2Options.Network))
+	}
+
+	slashingProtectionCfg.DBOptions.Logger = logger
+	slashingProtectionCfg.DBOptions.Ctx = cmd.Context()
+	db, err := storage.GetStorageFactory(slashingProtectionCfg.DBOptions)
+	if err != nil {
+		logger.Fatal("failed to create db!", zap.Error(err))
+	}
+	return logger, db, network
+}
+
+func init() {
+	global_config.ProcessArgs(&slashingProtectionCfg, &slashingProtectionArgs, SlashingProtectionCmd)
+	flags.AddInterchangeFileFlag(SlashingProtectionCmd)
+
+	SlashingProtectionCmd.AddCommand(exportSlashingProtectionCmd)
+	SlashingProtectionCmd.AddCommand(importSlashingProtectionCmd)
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"github.com/bloxapp/ssv/utils/logex"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"math/big"
 	"strings"
 	"testing"
 )
@@ -142,3 +144,102 @@ func unmarshalLog(t *testing.T, rawOperatorAdded string, abiVersion Version) (*t
 	require.NotNil(t, contractAbi)
 	return &vLogOperatorAdded, contractAbi
 }
+
+func TestParseV2LifecycleEvents(t *testing.T) {
+	contractAbi, err := abi.JSON(strings.NewReader(ContractABI(V2)))
+	require.NoError(t, err)
+	abiParser := NewParser(logex.Build("test", zap.InfoLevel, nil), V2)
+	owner := common.HexToAddress("0xa5cfD290965372553Efd5fDaeB91C335207b76E2")
+	ownerTopic := common.BytesToHash(owner.Bytes())
+
+	stringArg, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	operatorPubKey, err := abi.Arguments{{Type: stringArg}}.Pack("operator-pk")
+	require.NoError(t, err)
+	validatorPubKey, err := hex.DecodeString("8687eb8b88ff9c39e659c47b7bb76665fabfc4fc02c4246caca49700242fa9260a145969ede608b10c711ef2d57d0da1")
+	require.NoError(t, err)
+
+	pack := func(eventName string, args ...interface{}) []byte {
+		data, err := contractAbi.Events[eventName].Inputs.NonIndexed().Pack(args...)
+		require.NoError(t, err)
+		return data
+	}
+
+	t.Run("v2 validator deleted", func(t *testing.T) {
+		parsed, unpackErr, err := abiParser.ParseValidatorDeletedEvent(pack("ValidatorDeleted", owner, validatorPubKey), contractAbi)
+		require.NoError(t, err)
+		require.False(t, unpackErr)
+		require.Equal(t, owner, parsed.OwnerAddress)
+		require.Equal(t, validatorPubKey, parsed.PublicKey)
+	})
+
+	t.Run("v2 validator inactivated", func(t *testing.T) {
+		parsed, unpackErr, err := abiParser.ParseValidatorInactivatedEvent(pack("ValidatorInactivated", owner, validatorPubKey), contractAbi)
+		require.NoError(t, err)
+		require.False(t, unpackErr)
+		require.Equal(t, validatorPubKey, parsed.PublicKey)
+	})
+
+	t.Run("v2 operator fee updated", func(t *testing.T) {
+		data := pack("OperatorFeeUpdated", operatorPubKey, big.NewInt(100), big.NewInt(12))
+		parsed, isOperatorEvent, unpackErr, err := abiParser.ParseOperatorFeeUpdatedEvent("operator-pk", data,
+			[]common.Hash{contractAbi.Events["OperatorFeeUpdated"].ID, ownerTopic}, contractAbi)
+		require.NoError(t, err)
+		require.False(t, unpackErr)
+		require.True(t, isOperatorEvent)
+		require.Equal(t, "operator-pk", string(parsed.PublicKey))
+		require.Equal(t, owner, parsed.OwnerAddress)
+		require.EqualValues(t, 100, parsed.BlockNumber.Int64())
+		require.EqualValues(t, 12, parsed.Fee.Int64())
+	})
+
+	t.Run("v2 operator deleted", func(t *testing.T) {
+		parsed, isOperatorEvent, unpackErr, err := abiParser.ParseOperatorDeletedEvent("other-pk", pack("OperatorDeleted", operatorPubKey),
+			[]common.Hash{contractAbi.Events["OperatorDeleted"].ID, ownerTopic}, contractAbi)
+		require.NoError(t, err)
+		require.False(t, unpackErr)
+		require.False(t, isOperatorEvent)
+		require.Equal(t, "operator-pk", string(parsed.PublicKey))
+		require.Equal(t, owner, parsed.OwnerAddress)
+	})
+
+	t.Run("v2 network fee updated", func(t *testing.T) {
+		parsed, unpackErr, err := abiParser.ParseNetworkFeeUpdatedEvent(pack("NetworkFeeUpdated", big.NewInt(1), big.NewInt(2)), contractAbi)
+		require.NoError(t, err)
+		require.False(t, unpackErr)
+		require.EqualValues(t, 1, parsed.OldFee.Int64())
+		require.EqualValues(t, 2, parsed.NewFee.Int64())
+	})
+
+	t.Run("v2 account liquidated", func(t *testing.T) {
+		parsed, unpackErr, err := abiParser.ParseAccountLiquidatedEvent(
+			[]common.Hash{contractAbi.Events["AccountLiquidated"].ID, ownerTopic})
+		require.NoError(t, err)
+		require.False(t, unpackErr)
+		require.Equal(t, owner, parsed.OwnerAddress)
+
+		_, unpackErr, err = abiParser.ParseAccountLiquidatedEvent([]common.Hash{contractAbi.Events["AccountLiquidated"].ID})
+		require.Error(t, err)
+		require.True(t, unpackErr)
+	})
+
+	t.Run("v2 account enabled", func(t *testing.T) {
+		parsed, unpackErr, err := abiParser.ParseAccountEnabledEvent(
+			[]common.Hash{contractAbi.Events["AccountEnabled"].ID, ownerTopic})
+		require.NoError(t, err)
+		require.False(t, unpackErr)
+		require.Equal(t, owner, parsed.OwnerAddress)
+	})
+
+	t.Run("v2 corrupted data", func(t *testing.T) {
+		_, unpackErr, err := abiParser.ParseValidatorDeletedEvent([]byte{1, 2, 3}, contractAbi)
+		require.Error(t, err)
+		require.True(t, unpackErr)
+	})
+
+	t.Run("legacy is not supported", func(t *testing.T) {
+		_, _, err := NewParser(logex.Build("test", zap.InfoLevel, nil), Legacy).
+			ParseValidatorDeletedEvent(pack("ValidatorDeleted", owner, validatorPubKey), contractAbi)
+		require.Error(t, err)
+	})
+}
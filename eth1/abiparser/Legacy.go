@@ -90,6 +90,69 @@ func (adapter LegacyAdapter) ParseValidatorAddedEvent(
 	}, isOperatorEvent, unpackErr, err
 }
 
+// errLegacyNotSupported is returned for events that are not part of the legacy contract
+var errLegacyNotSupported = errors.New("event is not supported by the legacy contract")
+
+// ParseValidatorUpdatedEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseValidatorUpdatedEvent(logger *zap.Logger, operatorPrivateKey *rsa.PrivateKey, data []byte, contractAbi abi.ABI) (*ValidatorUpdatedEvent, bool, bool, error) {
+	return nil, false, false, errLegacyNotSupported
+}
+
+// ParseValidatorDeletedEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseValidatorDeletedEvent(logger *zap.Logger, data []byte, contractAbi abi.ABI) (*ValidatorDeletedEvent, bool, error) {
+	return nil, false, errLegacyNotSupported
+}
+
+// ParseValidatorActivatedEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseValidatorActivatedEvent(logger *zap.Logger, data []byte, contractAbi abi.ABI) (*ValidatorActivatedEvent, bool, error) {
+	return nil, false, errLegacyNotSupported
+}
+
+// ParseValidatorInactivatedEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseValidatorInactivatedEvent(logger *zap.Logger, data []byte, contractAbi abi.ABI) (*ValidatorInactivatedEvent, bool, error) {
+	return nil, false, errLegacyNotSupported
+}
+
+// ParseOperatorDeletedEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseOperatorDeletedEvent(logger *zap.Logger, operatorPubKey string, data []byte, topics []common.Hash, contractAbi abi.ABI) (*OperatorDeletedEvent, bool, bool, error) {
+	return nil, false, false, errLegacyNotSupported
+}
+
+// ParseOperatorActivatedEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseOperatorActivatedEvent(logger *zap.Logger, operatorPubKey string, data []byte, topics []common.Hash, contractAbi abi.ABI) (*OperatorActivatedEvent, bool, bool, error) {
+	return nil, false, false, errLegacyNotSupported
+}
+
+// ParseOperatorInactivatedEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseOperatorInactivatedEvent(logger *zap.Logger, operatorPubKey string, data []byte, topics []common.Hash, contractAbi abi.ABI) (*OperatorInactivatedEvent, bool, bool, error) {
+	return nil, false, false, errLegacyNotSupported
+}
+
+// ParseOperatorFeeUpdatedEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseOperatorFeeUpdatedEvent(logger *zap.Logger, operatorPubKey string, data []byte, topics []common.Hash, contractAbi abi.ABI) (*OperatorFeeUpdatedEvent, bool, bool, error) {
+	return nil, false, false, errLegacyNotSupported
+}
+
+// ParseOperatorScoreUpdatedEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseOperatorScoreUpdatedEvent(logger *zap.Logger, operatorPubKey string, data []byte, topics []common.Hash, contractAbi abi.ABI) (*OperatorScoreUpdatedEvent, bool, bool, error) {
+	return nil, false, false, errLegacyNotSupported
+}
+
+// ParseNetworkFeeUpdatedEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseNetworkFeeUpdatedEvent(logger *zap.Logger, data []byte, contractAbi abi.ABI) (*NetworkFeeUpdatedEvent, bool, error) {
+	return nil, false, errLegacyNotSupported
+}
+
+// ParseAccountLiquidatedEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseAccountLiquidatedEvent(logger *zap.Logger, topics []common.Hash) (*AccountLiquidatedEvent, bool, error) {
+	return nil, false, errLegacyNotSupported
+}
+
+// ParseAccountEnabledEvent is not supported by the legacy contract
+func (adapter LegacyAdapter) ParseAccountEnabledEvent(logger *zap.Logger, topics []common.Hash) (*AccountEnabledEvent, bool, error) {
+	return nil, false, errLegacyNotSupported
+}
+
 // LegacyAbi parsing events from legacy abi contract
 type LegacyAbi struct {
 }
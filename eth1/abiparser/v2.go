@@ -2,6 +2,7 @@ package abiparser
 
 import (
 	"crypto/rsa"
+	"math/big"
 	"strings"
 
 	"github.com/bloxapp/ssv/utils/rsaencryption"
@@ -28,6 +29,83 @@ type OperatorAddedEvent struct {
 	PublicKey    []byte
 }
 
+// ValidatorUpdatedEvent struct represents event received by the smart contract
+type ValidatorUpdatedEvent struct {
+	PublicKey          []byte
+	OwnerAddress       common.Address
+	OperatorPublicKeys [][]byte
+	SharesPublicKeys   [][]byte
+	EncryptedKeys      [][]byte
+}
+
+// ValidatorDeletedEvent struct represents event received by the smart contract
+type ValidatorDeletedEvent struct {
+	OwnerAddress common.Address
+	PublicKey    []byte
+}
+
+// ValidatorActivatedEvent struct represents event received by the smart contract
+type ValidatorActivatedEvent struct {
+	OwnerAddress common.Address
+	PublicKey    []byte
+}
+
+// ValidatorInactivatedEvent struct represents event received by the smart contract
+type ValidatorInactivatedEvent struct {
+	OwnerAddress common.Address
+	PublicKey    []byte
+}
+
+// OperatorDeletedEvent struct represents event received by the smart contract
+type OperatorDeletedEvent struct {
+	OwnerAddress common.Address
+	PublicKey    []byte
+}
+
+// OperatorActivatedEvent struct represents event received by the smart contract
+type OperatorActivatedEvent struct {
+	OwnerAddress common.Address
+	PublicKey    []byte
+}
+
+// OperatorInactivatedEvent struct represents event received by the smart contract
+type OperatorInactivatedEvent struct {
+	OwnerAddress common.Address
+	PublicKey    []byte
+}
+
+// OperatorFeeUpdatedEvent struct represents event received by the smart contract
+type OperatorFeeUpdatedEvent struct {
+	OwnerAddress common.Address
+	PublicKey    []byte
+	BlockNumber  *big.Int
+	Fee          *big.Int
+}
+
+// OperatorScoreUpdatedEvent struct represents event received by the smart contract
+type OperatorScoreUpdatedEvent struct {
+	OwnerAddress common.Address
+	PublicKey    []byte
+	BlockNumber  *big.Int
+	Score        *big.Int
+}
+
+// NetworkFeeUpdatedEvent struct represents event received by the smart contract
+type NetworkFeeUpdatedEvent struct {
+	OldFee *big.Int
+	NewFee *big.Int
+}
+
+// AccountLiquidatedEvent struct represents event received by the smart contract
+type AccountLiquidatedEvent struct {
+	OwnerAddress common.Address
+}
+
+// AccountEnabledEvent struct represents event received by the smart contract
+type AccountEnabledEvent struct {
+	OwnerAddress common.Address
+}
+
 // V2Abi parsing events from v2 abi contract
 type V2Abi struct {
 }
@@ -70,11 +148,239 @@ func (v2 *V2Abi) ParseValidatorAddedEvent(
 	operatorPrivateKey *rsa.PrivateKey,
 	data []byte,
 	contractAbi abi.ABI,
+) (*ValidatorAddedEvent, bool, bool, error) {
+	return parseValidatorSharesEvent(operatorPrivateKey, data, contractAbi, "ValidatorAdded")
+}
+
+// ParseValidatorUpdatedEvent parses ValidatorUpdatedEvent
+func (v2 *V2Abi) ParseValidatorUpdatedEvent(
+	logger *zap.Logger,
+	operatorPrivateKey *rsa.PrivateKey,
+	data []byte,
+	contractAbi abi.ABI,
+) (*ValidatorUpdatedEvent, bool, bool, error) {
+	event, isOperatorEvent, unpackErr, err := parseValidatorSharesEvent(operatorPrivateKey, data, contractAbi, "ValidatorUpdated")
+	if event == nil {
+		return nil, isOperatorEvent, unpackErr, err
+	}
+	validatorUpdatedEvent := ValidatorUpdatedEvent(*event)
+	return &validatorUpdatedEvent, isOperatorEvent, unpackErr, err
+}
+
+// ParseValidatorDeletedEvent parses ValidatorDeletedEvent
+func (v2 *V2Abi) ParseValidatorDeletedEvent(
+	logger *zap.Logger,
+	data []byte,
+	contractAbi abi.ABI,
+) (*ValidatorDeletedEvent, bool, error) {
+	var validatorDeletedEvent ValidatorDeletedEvent
+	if err := contractAbi.UnpackIntoInterface(&validatorDeletedEvent, "ValidatorDeleted", data); err != nil {
+		return nil, true, errors.Wrap(err, "failed to unpack ValidatorDeleted event")
+	}
+	return &validatorDeletedEvent, false, nil
+}
+
+// ParseValidatorActivatedEvent parses ValidatorActivatedEvent
+func (v2 *V2Abi) ParseValidatorActivatedEvent(
+	logger *zap.Logger,
+	data []byte,
+	contractAbi abi.ABI,
+) (*ValidatorActivatedEvent, bool, error) {
+	var validatorActivatedEvent ValidatorActivatedEvent
+	if err := contractAbi.UnpackIntoInterface(&validatorActivatedEvent, "ValidatorActivated", data); err != nil {
+		return nil, true, errors.Wrap(err, "failed to unpack ValidatorActivated event")
+	}
+	return &validatorActivatedEvent, false, nil
+}
+
+// ParseValidatorInactivatedEvent parses ValidatorInactivatedEvent
+func (v2 *V2Abi) ParseValidatorInactivatedEvent(
+	logger *zap.Logger,
+	data []byte,
+	contractAbi abi.ABI,
+) (*ValidatorInactivatedEvent, bool, error) {
+	var validatorInactivatedEvent ValidatorInactivatedEvent
+	if err := contractAbi.UnpackIntoInterface(&validatorInactivatedEvent, "ValidatorInactivated", data); err != nil {
+		return nil, true, errors.Wrap(err, "failed to unpack ValidatorInactivated event")
+	}
+	return &validatorInactivatedEvent, false, nil
+}
+
+// ParseOperatorDeletedEvent parses OperatorDeletedEvent
+func (v2 *V2Abi) ParseOperatorDeletedEvent(
+	logger *zap.Logger,
+	operatorPubKey string,
+	data []byte,
+	topics []common.Hash,
+	contractAbi abi.ABI,
+) (*OperatorDeletedEvent, bool, bool, error) {
+	var operatorDeletedEvent OperatorDeletedEvent
+	if err := contractAbi.UnpackIntoInterface(&operatorDeletedEvent, "OperatorDeleted", data); err != nil {
+		return nil, false, true, errors.Wrap(err, "failed to unpack OperatorDeleted event")
+	}
+	ownerAddress, pubKey, err := readOperatorEvent(logger, operatorDeletedEvent.PublicKey, topics)
+	if err != nil {
+		return nil, false, true, err
+	}
+	operatorDeletedEvent.OwnerAddress = ownerAddress
+	operatorDeletedEvent.PublicKey = []byte(pubKey)
+	return &operatorDeletedEvent, strings.EqualFold(pubKey, operatorPubKey), false, nil
+}
+
+// ParseOperatorActivatedEvent parses OperatorActivatedEvent
+func (v2 *V2Abi) ParseOperatorActivatedEvent(
+	logger *zap.Logger,
+	operatorPubKey string,
+	data []byte,
+	topics []common.Hash,
+	contractAbi abi.ABI,
+) (*OperatorActivatedEvent, bool, bool, error) {
+	var operatorActivatedEvent OperatorActivatedEvent
+	if err := contractAbi.UnpackIntoInterface(&operatorActivatedEvent, "OperatorActivated", data); err != nil {
+		return nil, false, true, errors.Wrap(err, "failed to unpack OperatorActivated event")
+	}
+	ownerAddress, pubKey, err := readOperatorEvent(logger, operatorActivatedEvent.PublicKey, topics)
+	if err != nil {
+		return nil, false, true, err
+	}
+	operatorActivatedEvent.OwnerAddress = ownerAddress
+	operatorActivatedEvent.PublicKey = []byte(pubKey)
+	return &operatorActivatedEvent, strings.EqualFold(pubKey, operatorPubKey), false, nil
+}
+
+// ParseOperatorInactivatedEvent parses OperatorInactivatedEvent
+func (v2 *V2Abi) ParseOperatorInactivatedEvent(
+	logger *zap.Logger,
+	operatorPubKey string,
+	data []byte,
+	topics []common.Hash,
+	contractAbi abi.ABI,
+) (*OperatorInactivatedEvent, bool, bool, error) {
+	var operatorInactivatedEvent OperatorInactivatedEvent
+	if err := contractAbi.UnpackIntoInterface(&operatorInactivatedEvent, "OperatorInactivated", data); err != nil {
+		return nil, false, true, errors.Wrap(err, "failed to unpack OperatorInactivated event")
+	}
+	ownerAddress, pubKey, err := readOperatorEvent(logger, operatorInactivatedEvent.PublicKey, topics)
+	if err != nil {
+		return nil, false, true, err
+	}
+	operatorInactivatedEvent.OwnerAddress = ownerAddress
+	operatorInactivatedEvent.PublicKey = []byte(pubKey)
+	return &operatorInactivatedEvent, strings.EqualFold(pubKey, operatorPubKey), false, nil
+}
+
+// ParseOperatorFeeUpdatedEvent parses OperatorFeeUpdatedEvent
+func (v2 *V2Abi) ParseOperatorFeeUpdatedEvent(
+	logger *zap.Logger,
+	operatorPubKey string,
+	data []byte,
+	topics []common.Hash,
+	contractAbi abi.ABI,
+) (*OperatorFeeUpdatedEvent, bool, bool, error) {
+	var operatorFeeUpdatedEvent OperatorFeeUpdatedEvent
+	if err := contractAbi.UnpackIntoInterface(&operatorFeeUpdatedEvent, "OperatorFeeUpdated", data); err != nil {
+		return nil, false, true, errors.Wrap(err, "failed to unpack OperatorFeeUpdated event")
+	}
+	ownerAddress, pubKey, err := readOperatorEvent(logger, operatorFeeUpdatedEvent.PublicKey, topics)
+	if err != nil {
+		return nil, false, true, err
+	}
+	operatorFeeUpdatedEvent.OwnerAddress = ownerAddress
+	operatorFeeUpdatedEvent.PublicKey = []byte(pubKey)
+	return &operatorFeeUpdatedEvent, strings.EqualFold(pubKey, operatorPubKey), false, nil
+}
+
+// ParseOperatorScoreUpdatedEvent parses OperatorScoreUpdatedEvent
+func (v2 *V2Abi) ParseOperatorScoreUpdatedEvent(
+	logger *zap.Logger,
+	operatorPubKey string,
+	data []byte,
+	topics []common.Hash,
+	contractAbi abi.ABI,
+) (*OperatorScoreUpdatedEvent, bool, bool, error) {
+	var operatorScoreUpdatedEvent OperatorScoreUpdatedEvent
+	if err := contractAbi.UnpackIntoInterface(&operatorScoreUpdatedEvent, "OperatorScoreUpdated", data); err != nil {
+		return nil, false, true, errors.Wrap(err, "failed to unpack OperatorScoreUpdated event")
+	}
+	ownerAddress, pubKey, err := readOperatorEvent(logger, operatorScoreUpdatedEvent.PublicKey, topics)
+	if err != nil {
+		return nil, false, true, err
+	}
+	operatorScoreUpdatedEvent.OwnerAddress = ownerAddress
+	operatorScoreUpdatedEvent.PublicKey = []byte(pubKey)
+	return &operatorScoreUpdatedEvent, strings.EqualFold(pubKey, operatorPubKey), false, nil
+}
+
+// ParseNetworkFeeUpdatedEvent parses NetworkFeeUpdatedEvent
+func (v2 *V2Abi) ParseNetworkFeeUpdatedEvent(
+	logger *zap.Logger,
+	data []byte,
+	contractAbi abi.ABI,
+) (*NetworkFeeUpdatedEvent, bool, error) {
+	var networkFeeUpdatedEvent NetworkFeeUpdatedEvent
+	if err := contractAbi.UnpackIntoInterface(&networkFeeUpdatedEvent, "NetworkFeeUpdated", data); err != nil {
+		return nil, true, errors.Wrap(err, "failed to unpack NetworkFeeUpdated event")
+	}
+	return &networkFeeUpdatedEvent, false, nil
+}
+
+// ParseAccountLiquidatedEvent parses AccountLiquidatedEvent
+func (v2 *V2Abi) ParseAccountLiquidatedEvent(logger *zap.Logger, topics []common.Hash) (*AccountLiquidatedEvent, bool, error) {
+	ownerAddress, err := readOwnerAddressTopic(topics)
+	if err != nil {
+		return nil, true, errors.Wrap(err, "failed to read AccountLiquidated event")
+	}
+	return &AccountLiquidatedEvent{OwnerAddress: ownerAddress}, false, nil
+}
+
+// ParseAccountEnabledEvent parses AccountEnabledEvent
+func (v2 *V2Abi) ParseAccountEnabledEvent(logger *zap.Logger, topics []common.Hash) (*AccountEnabledEvent, bool, error) {
+	ownerAddress, err := readOwnerAddressTopic(topics)
+	if err != nil {
+		return nil, true, errors.Wrap(err, "failed to read AccountEnabled event")
+	}
+	return &AccountEnabledEvent{OwnerAddress: ownerAddress}, false, nil
+}
+
+// readOwnerAddressTopic reads the owner address of an account event from the indexed topic
+func readOwnerAddressTopic(topics []common.Hash) (common.Address, error) {
+	if len(topics) < 2 {
+		return common.Address{}, errors.New("owner address topic is missing")
+	}
+	return common.HexToAddress(topics[1].Hex()), nil
+}
+
+// readOperatorEvent reads the owner address from the indexed topic and decodes the operator public key
+func readOperatorEvent(logger *zap.Logger, publicKey []byte, topics []common.Hash) (common.Address, string, error) {
+	outAbi, err := getOutAbi()
+	if err != nil {
+		return common.Address{}, "", err
+	}
+	pubKey, err := readOperatorPubKey(publicKey, outAbi)
+	if err != nil {
+		return common.Address{}, "", errors.Wrap(err, "failed to read OperatorPublicKey")
+	}
+	var ownerAddress common.Address
+	if len(topics) > 1 {
+		ownerAddress = common.HexToAddress(topics[1].Hex())
+	} else {
+		logger.Error("operator event missing topics. no owner address provided.")
+	}
+	return ownerAddress, pubKey, nil
+}
+
+// parseValidatorSharesEvent parses an event that carries the shares of a validator (ValidatorAdded, ValidatorUpdated),
+// the share that belongs to the operator is decrypted in place
+func parseValidatorSharesEvent(
+	operatorPrivateKey *rsa.PrivateKey,
+	data []byte,
+	contractAbi abi.ABI,
+	eventName string,
 ) (*ValidatorAddedEvent, bool, bool, error) {
 	var validatorAddedEvent ValidatorAddedEvent
-	err := contractAbi.UnpackIntoInterface(&validatorAddedEvent, "ValidatorAdded", data)
+	err := contractAbi.UnpackIntoInterface(&validatorAddedEvent, eventName, data)
 	if err != nil {
-		return nil, false, true, errors.Wrap(err, "Failed to unpack ValidatorAdded event")
+		return nil, false, true, errors.Wrapf(err, "Failed to unpack %s event", eventName)
 	}
 
 	var isOperatorEvent bool
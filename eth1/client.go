@@ -2,6 +2,7 @@ package eth1
 
 import (
 	"crypto/rsa"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/prysmaticlabs/prysm/async/event"
 	"math/big"
@@ -10,9 +11,10 @@ import (
 
 // Options configurations related to eth1
 type Options struct {
-	ETH1Addr              string        `yaml:"ETH1Addr" env:"ETH_1_ADDR" env-required:"true" env-description:"ETH1 node WebSocket address"`
+	ETH1Addr              string        `yaml:"ETH1Addr" env:"ETH_1_ADDR" env-required:"true" env-description:"ETH1 node WebSocket address, or a comma separated list of addresses to rotate between"`
 	ETH1SyncOffset        string        `yaml:"ETH1SyncOffset" env:"ETH_1_SYNC_OFFSET" env-description:"block number to start the sync from"`
 	ETH1ConnectionTimeout time.Duration `yaml:"ETH1ConnectionTimeout" env:"ETH_1_CONNECTION_TIMEOUT" env-default:"10s" env-description:"eth1 node connection timeout"`
+	ETH1HeadTimeout       time.Duration `yaml:"ETH1HeadTimeout" env:"ETH_1_HEAD_TIMEOUT" env-default:"2m" env-description:"rotate to the next eth1 node if no new head was received within this duration"`
 	RegistryContractAddr  string        `yaml:"RegistryContractAddr" env:"REGISTRY_CONTRACT_ADDR_KEY" env-default:"0x9573C41F0Ed8B72f3bD6A9bA6E3e15426A0aa65B" env-description:"registry contract address"`
 	RegistryContractABI   string        `yaml:"RegistryContractABI" env:"REGISTRY_CONTRACT_ABI" env-description:"registry contract abi json file"`
 	CleanRegistryData     bool          `yaml:"CleanRegistryData" env:"CLEAN_REGISTRY_DATA" env-default:"false" env-description:"cleans registry contract data (validator shares) and forces re-sync"`
@@ -45,4 +47,5 @@ type Client interface {
 	EventsFeed() *event.Feed
 	Start() error
 	Sync(fromBlock *big.Int) error
+	IsLiquidatable(ownerAddress common.Address) (bool, error)
 }
@@ -0,0 +1,228 @@
+package goeth
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bloxapp/ssv/eth1"
+	"github.com/bloxapp/ssv/eth1/abiparser"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// emitterCode deploys a contract that emits a log for every call,
+// the first 32 bytes of the call data are the topic and the rest is the log data
+const emitterCode = "601380600b6000396000f3" + "3660209003806020600037600035906000a100"
+
+var testChainID = big.NewInt(1337)
+
+// simulatedConn wraps a simulated backend as an eth1 node
+type simulatedConn struct {
+	*backends.SimulatedBackend
+}
+
+func (c *simulatedConn) BlockNumber(ctx context.Context) (uint64, error) {
+	return c.Blockchain().CurrentBlock().NumberU64(), nil
+}
+
+func (c *simulatedConn) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error) {
+	return nil, nil
+}
+
+type simulatedChain struct {
+	t       *testing.T
+	key     []byte
+	nonce   uint64
+	backend []*simulatedConn
+}
+
+// newSimulatedChain creates the given number of simulated backends with the same genesis,
+// and deploys the emitter contract on all of them
+func newSimulatedChain(t *testing.T, n int) (*simulatedChain, common.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	chain := &simulatedChain{t: t, key: crypto.FromECDSA(key)}
+	for i := 0; i < n; i++ {
+		alloc := core.GenesisAlloc{from: {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))}}
+		sim := backends.NewSimulatedBackend(alloc, 10000000)
+		t.Cleanup(func() { _ = sim.Close() })
+		chain.backend = append(chain.backend, &simulatedConn{sim})
+	}
+	code, err := hex.DecodeString(emitterCode)
+	require.NoError(t, err)
+	chain.send(nil, code, chain.backend...)
+	return chain, crypto.CreateAddress(from, 0)
+}
+
+// send sends a transaction to the given backends and commits a block on each of them
+func (c *simulatedChain) send(to *common.Address, data []byte, conns ...*simulatedConn) {
+	key, err := crypto.ToECDSA(c.key)
+	require.NoError(c.t, err)
+	var tx *types.Transaction
+	if to == nil {
+		tx = types.NewContractCreation(c.nonce, big.NewInt(0), 1000000, big.NewInt(1e10), data)
+	} else {
+		tx = types.NewTransaction(c.nonce, *to, big.NewInt(0), 1000000, big.NewInt(1e10), data)
+	}
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(testChainID), key)
+	require.NoError(c.t, err)
+	for _, conn := range conns {
+		require.NoError(c.t, conn.SendTransaction(context.Background(), signed))
+		conn.Commit()
+	}
+	c.nonce++
+}
+
+// emit emits the given log from the emitter contract on the given backends
+func (c *simulatedChain) emit(contract common.Address, vLog types.Log, conns ...*simulatedConn) {
+	c.send(&contract, append(vLog.Topics[0].Bytes(), vLog.Data...), conns...)
+}
+
+type syncOffsetStorageMock struct {
+	lock   sync.Mutex
+	offset *eth1.SyncOffset
+}
+
+func (s *syncOffsetStorageMock) SaveSyncOffset(offset *eth1.SyncOffset) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.offset = new(eth1.SyncOffset).Set(offset)
+	return nil
+}
+
+func (s *syncOffsetStorageMock) GetSyncOffset() (*eth1.SyncOffset, bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.offset == nil {
+		return nil, false, nil
+	}
+	return new(eth1.SyncOffset).Set(s.offset), true, nil
+}
+
+func TestEth1Client_Failover(t *testing.T) {
+	chain, contract := newSimulatedChain(t, 2)
+	sim1, sim2 := chain.backend[0], chain.backend[1]
+	var operatorAdded types.Log
+	require.NoError(t, json.Unmarshal([]byte(rawOperatorAdded), &operatorAdded))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	storage := &syncOffsetStorageMock{}
+	ec := newEth1Client(eth1.Legacy)
+	ec.ctx = ctx
+	ec.nodeAddrs = []string{"sim1", "sim2"}
+	ec.dial = func(ctx context.Context, addr string) (eth1Conn, error) {
+		switch addr {
+		case "sim1":
+			return sim1, nil
+		case "sim2":
+			return sim2, nil
+		}
+		return nil, errors.New("unknown node")
+	}
+	ec.registryContractAddr = contract.Hex()
+	ec.contractABI = eth1.ContractABI(eth1.Legacy)
+	ec.connectionTimeout = time.Second
+	ec.headTimeout = time.Second
+	ec.syncOffsetStorage = storage
+	require.NoError(t, ec.connect())
+
+	blocks := make(chan uint64, 10)
+	cn := make(chan *eth1.Event)
+	sub := ec.EventsFeed().Subscribe(cn)
+	defer sub.Unsubscribe()
+	go func() {
+		for e := range cn {
+			if _, ok := e.Data.(abiparser.OperatorAddedEvent); ok {
+				blocks <- e.Log.BlockNumber
+			}
+		}
+	}()
+	nextBlock := func() uint64 {
+		select {
+		case b := <-blocks:
+			return b
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "timed out waiting for event")
+		}
+		return 0
+	}
+
+	require.NoError(t, ec.Start())
+	// both nodes are in sync
+	chain.emit(contract, operatorAdded, sim1, sim2)
+	require.EqualValues(t, 2, nextBlock())
+	offset, found, err := storage.GetSyncOffset()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.EqualValues(t, 2, offset.Uint64())
+
+	// the first node stalls, the event is received once the client rotates to the second node
+	chain.emit(contract, operatorAdded, sim2)
+	require.EqualValues(t, 3, nextBlock())
+	chain.emit(contract, operatorAdded, sim2)
+	require.EqualValues(t, 4, nextBlock())
+
+	// events are not duplicated by the rotations
+	select {
+	case b := <-blocks:
+		require.FailNow(t, "duplicated event", "block %d", b)
+	case <-time.After(2500 * time.Millisecond):
+	}
+	offset, _, err = storage.GetSyncOffset()
+	require.NoError(t, err)
+	require.EqualValues(t, 4, offset.Uint64())
+}
+
+func TestEth1Client_markProcessed(t *testing.T) {
+	ec := newEth1Client(eth1.Legacy)
+	require.True(t, ec.markProcessed(types.Log{BlockNumber: 10, Index: 1}))
+	require.False(t, ec.markProcessed(types.Log{BlockNumber: 10, Index: 1}))
+	require.False(t, ec.markProcessed(types.Log{BlockNumber: 9, Index: 5}))
+	require.True(t, ec.markProcessed(types.Log{BlockNumber: 10, Index: 2}))
+	require.True(t, ec.markProcessed(types.Log{BlockNumber: 11, Index: 0}))
+}
+
+func TestEth1Client_saveSyncOffset(t *testing.T) {
+	ec := newEth1Client(eth1.Legacy)
+	storage := &syncOffsetStorageMock{}
+	ec.syncOffsetStorage = storage
+
+	ec.saveSyncOffset(10)
+	ec.markFailed(types.Log{BlockNumber: 12, Index: 1})
+	ec.saveSyncOffset(15)
+	offset, _, err := storage.GetSyncOffset()
+	require.NoError(t, err)
+	require.EqualValues(t, 12, offset.Uint64())
+
+	require.Nil(t, ec.failedBlock)
+
+	// the failed block was reset after the successful save
+	ec.saveSyncOffset(15)
+	offset, _, err = storage.GetSyncOffset()
+	require.NoError(t, err)
+	require.EqualValues(t, 15, offset.Uint64())
+
+	// an earlier failure lowers the limit, while the stored offset is never lowered
+	ec.markFailed(types.Log{BlockNumber: 16})
+	ec.markFailed(types.Log{BlockNumber: 18})
+	require.EqualValues(t, 16, *ec.failedBlock)
+	ec.markFailed(types.Log{BlockNumber: 11})
+	ec.saveSyncOffset(20)
+	offset, _, err = storage.GetSyncOffset()
+	require.NoError(t, err)
+	require.EqualValues(t, 15, offset.Uint64())
+	require.Nil(t, ec.failedBlock)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bloxapp/ssv/eth1"
@@ -23,9 +24,36 @@ import (
 
 const (
 	healthCheckTimeout        = 10 * time.Second
+	defaultHeadTimeout        = 2 * time.Minute
 	blocksInBatch      uint64 = 100000
 )
 
+// eth1Conn is the subset of the eth1 node api that is used by the client
+type eth1Conn interface {
+	ethereum.LogFilterer
+	ethereum.ContractCaller
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+	SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error)
+}
+
+// dialFunc creates a connection to the eth1 node in the given address
+type dialFunc func(ctx context.Context, addr string) (eth1Conn, error)
+
+func dialEthClient(ctx context.Context, addr string) (eth1Conn, error) {
+	conn, err := ethclient.DialContext(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// logPosition is the position of a log in the chain
+type logPosition struct {
+	block uint64
+	index uint
+}
+
 // ClientOptions are the options for the client
 type ClientOptions struct {
 	Ctx                        context.Context
@@ -34,8 +62,10 @@ type ClientOptions struct {
 	RegistryContractAddr       string
 	ContractABI                string
 	ConnectionTimeout          time.Duration
+	HeadTimeout                time.Duration
 	ShareEncryptionKeyProvider eth1.ShareEncryptionKeyProvider
 	OperatorPubKey             string
+	SyncOffsetStorage          eth1.SyncOffsetStorage
 
 	AbiVersion eth1.Version
 }
@@ -43,16 +73,30 @@ type ClientOptions struct {
 // eth1Client is the internal implementation of Client
 type eth1Client struct {
 	ctx    context.Context
-	conn   *ethclient.Client
 	logger *zap.Logger
 
+	connLock  sync.RWMutex
+	conn      eth1Conn
+	nodeIndex int
+	dial      dialFunc
+
 	shareEncryptionKeyProvider eth1.ShareEncryptionKeyProvider
 	operatorPubKey             string
+	syncOffsetStorage          eth1.SyncOffsetStorage
 
-	nodeAddr             string
+	nodeAddrs            []string
 	registryContractAddr string
 	contractABI          string
 	connectionTimeout    time.Duration
+	headTimeout          time.Duration
+
+	// lastProcessed is the position of the last processed log, used to skip logs that were already processed
+	lastProcessed *logPosition
+	// failedBlock is the block of the first streamed log that failed to be handled,
+	// the next saved sync offset is not advanced past it so the log is processed again after a restart.
+	// it is reset once the sync offset was saved
+	failedBlock       *uint64
+	lastProcessedLock sync.Mutex
 
 	eventsFeed *event.Feed
 
@@ -62,21 +106,35 @@ type eth1Client struct {
 // verifies that the client implements HealthCheckAgent
 var _ metrics.HealthCheckAgent = &eth1Client{}
 
-// NewEth1Client creates a new instance
+// NewEth1Client creates a new instance.
+// NodeAddr may hold a comma separated list of eth1 nodes, the client rotates between them
+// when the subscription fails or when no new head is received for HeadTimeout
 func NewEth1Client(opts ClientOptions) (eth1.Client, error) {
 	logger := opts.Logger.With(zap.String("component", "eth1GoETH"),
 		zap.String("address", opts.RegistryContractAddr))
 	logger.Info("eth1 addresses", zap.String("address", opts.NodeAddr))
 
+	nodeAddrs := parseNodeAddrs(opts.NodeAddr)
+	if len(nodeAddrs) == 0 {
+		return nil, errors.New("no eth1 node address was provided")
+	}
+	headTimeout := opts.HeadTimeout
+	if headTimeout == 0 {
+		headTimeout = defaultHeadTimeout
+	}
+
 	ec := eth1Client{
 		ctx:                        opts.Ctx,
 		logger:                     logger,
+		dial:                       dialEthClient,
 		shareEncryptionKeyProvider: opts.ShareEncryptionKeyProvider,
 		operatorPubKey:             opts.OperatorPubKey,
-		nodeAddr:                   opts.NodeAddr,
+		syncOffsetStorage:          opts.SyncOffsetStorage,
+		nodeAddrs:                  nodeAddrs,
 		registryContractAddr:       opts.RegistryContractAddr,
 		contractABI:                opts.ContractABI,
 		connectionTimeout:          opts.ConnectionTimeout,
+		headTimeout:                headTimeout,
 		eventsFeed:                 new(event.Feed),
 		abiVersion:                 opts.AbiVersion,
 	}
@@ -103,23 +161,68 @@ func (ec *eth1Client) Start() error {
 	return err
 }
 
-// Sync reads events history
+// Sync reads events history, the sync is retried with the next eth1 node on failure
 func (ec *eth1Client) Sync(fromBlock *big.Int) error {
-	err := ec.syncSmartContractsEvents(fromBlock)
-	if err != nil {
+	var err error
+	for i := 0; i < len(ec.nodeAddrs); i++ {
+		if err = ec.syncSmartContractsEvents(fromBlock); err == nil {
+			return nil
+		}
 		ec.logger.Error("Failed to sync contract events", zap.Error(err))
+		if i+1 == len(ec.nodeAddrs) || ec.ctx.Err() != nil {
+			break
+		}
+		if connErr := ec.connectNext(); connErr != nil {
+			break
+		}
 	}
 	return err
 }
 
+// IsLiquidatable calls the contract to check whether the given owner account can be liquidated
+func (ec *eth1Client) IsLiquidatable(ownerAddress common.Address) (bool, error) {
+	if ec.abiVersion != eth1.V2 {
+		return false, errors.New("liquidation is not supported by the contract")
+	}
+	contractAbi, err := abi.JSON(strings.NewReader(ec.contractABI))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to parse ABI interface")
+	}
+	data, err := contractAbi.Pack("liquidatable", ownerAddress)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to pack liquidatable call")
+	}
+	conn := ec.getConn()
+	if conn == nil {
+		return false, errors.New("not connected to eth1 node")
+	}
+	contractAddr := common.HexToAddress(ec.registryContractAddr)
+	ctx, cancel := context.WithTimeout(ec.ctx, ec.connectionTimeout)
+	defer cancel()
+	res, err := conn.CallContract(ctx, ethereum.CallMsg{To: &contractAddr, Data: data}, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to call liquidatable")
+	}
+	out, err := contractAbi.Unpack("liquidatable", res)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to unpack liquidatable result")
+	}
+	liquidatable, ok := out[0].(bool)
+	if !ok {
+		return false, errors.New("unexpected liquidatable result")
+	}
+	return liquidatable, nil
+}
+
 // HealthCheck provides health status of eth1 node
 func (ec *eth1Client) HealthCheck() []string {
-	if ec.conn == nil {
+	conn := ec.getConn()
+	if conn == nil {
 		return []string{"not connected to eth1 node"}
 	}
 	ctx, cancel := context.WithTimeout(ec.ctx, healthCheckTimeout)
 	defer cancel()
-	sp, err := ec.conn.SyncProgress(ctx)
+	sp, err := conn.SyncProgress(ctx)
 	if err != nil {
 		reportNodeStatus(statusUnknown)
 		return []string{"could not get eth1 node sync progress"}
@@ -134,28 +237,83 @@ func (ec *eth1Client) HealthCheck() []string {
 	return []string{}
 }
 
-// connect connects to eth1 client
+// parseNodeAddrs splits a comma separated list of eth1 node addresses
+func parseNodeAddrs(addrs string) []string {
+	var ret []string
+	for _, addr := range strings.Split(addrs, ",") {
+		if addr = strings.TrimSpace(addr); len(addr) > 0 {
+			ret = append(ret, addr)
+		}
+	}
+	return ret
+}
+
+func (ec *eth1Client) getConn() eth1Conn {
+	ec.connLock.RLock()
+	defer ec.connLock.RUnlock()
+	return ec.conn
+}
+
+// connect connects to the first available eth1 node
 func (ec *eth1Client) connect() error {
-	// Create an IPC based RPC connection to a remote node
-	ec.logger.Info("dialing eth1 node...")
+	var err error
+	for i := range ec.nodeAddrs {
+		if err = ec.connectTo(i); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// connectNext connects to the next available eth1 node, starting from the one after the current node.
+// in case of a single node, it reconnects to the same node
+func (ec *eth1Client) connectNext() error {
+	ec.connLock.RLock()
+	current := ec.nodeIndex
+	ec.connLock.RUnlock()
+
+	var err error
+	for i := 1; i <= len(ec.nodeAddrs); i++ {
+		if err = ec.connectTo((current + i) % len(ec.nodeAddrs)); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// connectTo connects to the eth1 node in the given index and replaces the current connection
+func (ec *eth1Client) connectTo(index int) error {
+	logger := ec.logger.With(zap.String("eth1Addr", ec.nodeAddrs[index]))
+	logger.Info("dialing eth1 node...")
 	ctx, cancel := context.WithTimeout(context.Background(), ec.connectionTimeout)
 	defer cancel()
-	conn, err := ethclient.DialContext(ctx, ec.nodeAddr)
+	conn, err := ec.dial(ctx, ec.nodeAddrs[index])
 	if err != nil {
-		ec.logger.Error("could not connect to the eth1 client", zap.Error(err))
+		logger.Error("could not connect to the eth1 client", zap.Error(err))
 		return err
 	}
-	ec.logger.Info("successfully connected to eth1 goETH")
+	logger.Info("successfully connected to eth1 goETH")
+
+	ec.connLock.Lock()
+	prev := ec.conn
 	ec.conn = conn
+	ec.nodeIndex = index
+	ec.connLock.Unlock()
+	if closer, ok := prev.(interface{ Close() }); ok && prev != conn {
+		closer.Close()
+	}
 	return nil
 }
 
-// reconnect tries to reconnect multiple times with an exponent interval
-func (ec *eth1Client) reconnect() {
+// rotate connects to the next eth1 node, retrying multiple times with an exponent interval
+func (ec *eth1Client) rotate() {
+	if err := ec.connectNext(); err == nil {
+		return
+	}
 	limit := 64 * time.Second
 	tasks.ExecWithInterval(func(lastTick time.Duration) (stop bool, cont bool) {
 		ec.logger.Info("reconnecting to eth1 node")
-		if err := ec.connect(); err != nil {
+		if err := ec.connectNext(); err != nil {
 			// continue until reaching to limit, and then panic as eth1 connection is required
 			if lastTick >= limit {
 				ec.logger.Panic("failed to reconnect to eth1 node", zap.Error(err))
@@ -167,10 +325,6 @@ func (ec *eth1Client) reconnect() {
 		return true, false
 	}, 1*time.Second, limit+(1*time.Second))
 	ec.logger.Debug("managed to reconnect to eth1 node")
-	if err := ec.streamSmartContractEvents(); err != nil {
-		// TODO: panic?
-		ec.logger.Error("failed to stream events after reconnection", zap.Error(err))
-	}
 }
 
 // fireEvent notifies observers about some contract event
@@ -181,6 +335,76 @@ func (ec *eth1Client) fireEvent(log types.Log, data interface{}, isOperatorEvent
 	//ec.logger.Debug("events was sent to subscribers", zap.Int("num of subscribers", n))
 }
 
+// markProcessed marks the given log as processed, returns false if the log was already processed
+func (ec *eth1Client) markProcessed(vLog types.Log) bool {
+	ec.lastProcessedLock.Lock()
+	defer ec.lastProcessedLock.Unlock()
+
+	if last := ec.lastProcessed; last != nil {
+		if vLog.BlockNumber < last.block || (vLog.BlockNumber == last.block && vLog.Index <= last.index) {
+			return false
+		}
+	}
+	ec.lastProcessed = &logPosition{block: vLog.BlockNumber, index: vLog.Index}
+	return true
+}
+
+// saveSyncOffset upgrades the stored sync offset to the given block,
+// a failed block limits the saved offset once and is reset after a successful save
+func (ec *eth1Client) saveSyncOffset(block uint64) {
+	if ec.syncOffsetStorage == nil {
+		return
+	}
+	ec.lastProcessedLock.Lock()
+	defer ec.lastProcessedLock.Unlock()
+	if ec.failedBlock != nil && block > *ec.failedBlock {
+		block = *ec.failedBlock
+	}
+	offset, found, err := ec.syncOffsetStorage.GetSyncOffset()
+	if err == nil && found && offset != nil && offset.Uint64() >= block {
+		ec.failedBlock = nil
+		return
+	}
+	if err := ec.syncOffsetStorage.SaveSyncOffset(new(eth1.SyncOffset).SetUint64(block)); err != nil {
+		ec.logger.Warn("could not save sync offset", zap.Uint64("block", block), zap.Error(err))
+		return
+	}
+	ec.failedBlock = nil
+}
+
+// markFailed marks the block of a log that failed to be handled, the sync offset won't be advanced past it
+func (ec *eth1Client) markFailed(vLog types.Log) {
+	ec.lastProcessedLock.Lock()
+	defer ec.lastProcessedLock.Unlock()
+
+	if ec.failedBlock != nil && *ec.failedBlock <= vLog.BlockNumber {
+		return
+	}
+	block := vLog.BlockNumber
+	ec.failedBlock = &block
+	ec.logger.Warn("sync offset won't advance past a failed event, it will be processed again after a restart",
+		zap.Uint64("block", block), zap.Uint("index", vLog.Index), zap.String("txHash", vLog.TxHash.Hex()))
+}
+
+// resumeOffset returns the block to resume from after rotating to another node,
+// the stored sync offset is preferred over the last processed block
+func (ec *eth1Client) resumeOffset() *big.Int {
+	if ec.syncOffsetStorage != nil {
+		offset, found, err := ec.syncOffsetStorage.GetSyncOffset()
+		if err != nil {
+			ec.logger.Warn("failed to get sync offset", zap.Error(err))
+		} else if found && offset != nil {
+			return offset
+		}
+	}
+	ec.lastProcessedLock.Lock()
+	defer ec.lastProcessedLock.Unlock()
+	if ec.lastProcessed != nil {
+		return new(big.Int).SetUint64(ec.lastProcessed.block)
+	}
+	return nil
+}
+
 // streamSmartContractEvents sync events history of the given contract
 func (ec *eth1Client) streamSmartContractEvents() error {
 	ec.logger.Debug("streaming smart contract events")
@@ -190,53 +414,145 @@ func (ec *eth1Client) streamSmartContractEvents() error {
 		return errors.Wrap(err, "failed to parse ABI interface")
 	}
 
-	sub, logs, err := ec.subscribeToLogs()
+	s, err := ec.subscribe()
 	if err != nil {
 		return errors.Wrap(err, "Failed to subscribe to logs")
 	}
 
-	go func() {
-		if err := ec.listenToSubscription(logs, sub, contractAbi); err != nil {
-			ec.reconnect()
-		}
-	}()
+	go ec.stream(s, contractAbi)
 
 	return nil
 }
 
-func (ec *eth1Client) subscribeToLogs() (ethereum.Subscription, chan types.Log, error) {
+// stream listens to the given subscription, and rotates to the next eth1 node once the subscription fails
+func (ec *eth1Client) stream(s *logsSubscription, contractAbi abi.ABI) {
+	for {
+		err := ec.listenToSubscription(s, contractAbi)
+		s.unsubscribe()
+		if err == nil || ec.ctx.Err() != nil {
+			return
+		}
+		ec.logger.Warn("eth1 events stream failed, rotating eth1 node", zap.Error(err))
+		for {
+			ec.rotate()
+			if s, err = ec.resume(contractAbi); err == nil {
+				break
+			}
+			if ec.ctx.Err() != nil {
+				return
+			}
+			ec.logger.Warn("could not resume streaming events", zap.Error(err))
+		}
+	}
+}
+
+// resume subscribes to the current eth1 node and processes the events that were missed since the sync offset.
+// the subscription is created first so no event is lost, events that were already processed are skipped
+func (ec *eth1Client) resume(contractAbi abi.ABI) (*logsSubscription, error) {
+	s, err := ec.subscribe()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to subscribe to logs")
+	}
+	if fromBlock := ec.resumeOffset(); fromBlock != nil {
+		logs, nSuccess, err := ec.fetchAndProcessEvents(fromBlock, nil, contractAbi)
+		if err != nil {
+			s.unsubscribe()
+			return nil, errors.Wrap(err, "failed to fetch missed events")
+		}
+		if len(logs) > 0 && nSuccess == len(logs) {
+			ec.saveSyncOffset(logs[len(logs)-1].BlockNumber)
+		}
+		ec.logger.Debug("resumed events stream", zap.Uint64("fromBlock", fromBlock.Uint64()),
+			zap.Int("results", len(logs)))
+	}
+	return s, nil
+}
+
+// logsSubscription holds the subscriptions to the contract logs and to new heads
+type logsSubscription struct {
+	logsSub  ethereum.Subscription
+	logs     chan types.Log
+	headsSub ethereum.Subscription
+	heads    chan *types.Header
+}
+
+func (s *logsSubscription) unsubscribe() {
+	s.logsSub.Unsubscribe()
+	s.headsSub.Unsubscribe()
+}
+
+func (ec *eth1Client) subscribe() (*logsSubscription, error) {
+	conn := ec.getConn()
+	if conn == nil {
+		return nil, errors.New("not connected to eth1 node")
+	}
 	contractAddress := common.HexToAddress(ec.registryContractAddr)
 	query := ethereum.FilterQuery{
 		Addresses: []common.Address{contractAddress},
 	}
 	logs := make(chan types.Log)
-	sub, err := ec.conn.SubscribeFilterLogs(ec.ctx, query, logs)
+	logsSub, err := conn.SubscribeFilterLogs(ec.ctx, query, logs)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to subscribe to logs")
+	}
+	heads := make(chan *types.Header)
+	headsSub, err := conn.SubscribeNewHead(ec.ctx, heads)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "Failed to subscribe to logs")
+		logsSub.Unsubscribe()
+		return nil, errors.Wrap(err, "Failed to subscribe to new heads")
 	}
 	ec.logger.Debug("subscribed to results of the streaming filter query")
 
-	return sub, logs, nil
+	return &logsSubscription{logsSub: logsSub, logs: logs, headsSub: headsSub, heads: heads}, nil
 }
 
-// listenToSubscription listen to new event logs from the contract
-func (ec *eth1Client) listenToSubscription(logs chan types.Log, sub ethereum.Subscription, contractAbi abi.ABI) error {
+// listenToSubscription listen to new event logs from the contract,
+// returns an error once the subscription fails or when no new head was received for the head timeout
+func (ec *eth1Client) listenToSubscription(s *logsSubscription, contractAbi abi.ABI) error {
+	headTimer := time.NewTimer(ec.headTimeout)
+	defer headTimer.Stop()
 	for {
 		select {
-		case err := <-sub.Err():
+		case <-ec.ctx.Done():
+			return nil
+		case err := <-s.logsSub.Err():
 			ec.logger.Warn("failed to read logs from subscription", zap.Error(err))
-			return err
-		case vLog := <-logs:
+			return errors.Wrap(subscriptionErr(err), "logs subscription failed")
+		case err := <-s.headsSub.Err():
+			ec.logger.Warn("failed to read heads from subscription", zap.Error(err))
+			return errors.Wrap(subscriptionErr(err), "heads subscription failed")
+		case <-headTimer.C:
+			return errors.Errorf("no new head was received for %s", ec.headTimeout)
+		case <-s.heads:
+			if !headTimer.Stop() {
+				<-headTimer.C
+			}
+			headTimer.Reset(ec.headTimeout)
+		case vLog := <-s.logs:
+			if !ec.markProcessed(vLog) {
+				ec.logger.Debug("skipping contract event that was already processed")
+				continue
+			}
 			ec.logger.Debug("received contract event from stream")
 			_, err := ec.handleEvent(vLog, contractAbi)
 			if err != nil {
 				ec.logger.Error("Failed to handle event", zap.Error(err))
+				ec.markFailed(vLog)
 				continue
 			}
+			ec.saveSyncOffset(vLog.BlockNumber)
 		}
 	}
 }
 
+// subscriptionErr returns a non nil error for a subscription that was closed
+func subscriptionErr(err error) error {
+	if err == nil {
+		return errors.New("subscription was closed")
+	}
+	return err
+}
+
 // syncSmartContractsEvents sync events history of the given contract
 func (ec *eth1Client) syncSmartContractsEvents(fromBlock *big.Int) error {
 	ec.logger.Debug("syncing smart contract events", zap.Uint64("fromBlock", fromBlock.Uint64()))
@@ -245,7 +561,11 @@ func (ec *eth1Client) syncSmartContractsEvents(fromBlock *big.Int) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to parse ABI interface")
 	}
-	currentBlock, err := ec.conn.BlockNumber(ec.ctx)
+	conn := ec.getConn()
+	if conn == nil {
+		return errors.New("not connected to eth1 node")
+	}
+	currentBlock, err := conn.BlockNumber(ec.ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get current block")
 	}
@@ -310,7 +630,11 @@ func (ec *eth1Client) fetchAndProcessEvents(fromBlock, toBlock *big.Int, contrac
 		logger = logger.With(zap.Int64("toBlock", toBlock.Int64()))
 	}
 	logger.Debug("fetching event logs")
-	logs, err := ec.conn.FilterLogs(ec.ctx, query)
+	conn := ec.getConn()
+	if conn == nil {
+		return nil, 0, errors.New("not connected to eth1 node")
+	}
+	logs, err := conn.FilterLogs(ec.ctx, query)
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "failed to get event logs")
 	}
@@ -319,6 +643,9 @@ func (ec *eth1Client) fetchAndProcessEvents(fromBlock, toBlock *big.Int, contrac
 	logger.Debug("got event logs")
 
 	for _, vLog := range logs {
+		if !ec.markProcessed(vLog) {
+			continue
+		}
 		unpackErr, err := ec.handleEvent(vLog, contractAbi)
 		if err != nil {
 			if !unpackErr {
@@ -365,6 +692,90 @@ func (ec *eth1Client) handleEvent(vLog types.Log, contractAbi abi.ABI) (bool, er
 			return unpackErr, errors.Wrap(err, "failed to parse ValidatorAdded event")
 		}
 		ec.fireEvent(vLog, *parsed, isOperatorEvent)
+	case "ValidatorUpdated":
+		parsed, isOperatorEvent, unpackErr, err := abiParser.ParseValidatorUpdatedEvent(shareEncryptionKey, vLog.Data, contractAbi)
+		reportSyncEvent(eventName, isOperatorEvent, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse ValidatorUpdated event")
+		}
+		ec.fireEvent(vLog, *parsed, isOperatorEvent)
+	case "ValidatorDeleted":
+		parsed, unpackErr, err := abiParser.ParseValidatorDeletedEvent(vLog.Data, contractAbi)
+		reportSyncEvent(eventName, false, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse ValidatorDeleted event")
+		}
+		ec.fireEvent(vLog, *parsed, false)
+	case "ValidatorActivated":
+		parsed, unpackErr, err := abiParser.ParseValidatorActivatedEvent(vLog.Data, contractAbi)
+		reportSyncEvent(eventName, false, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse ValidatorActivated event")
+		}
+		ec.fireEvent(vLog, *parsed, false)
+	case "ValidatorInactivated":
+		parsed, unpackErr, err := abiParser.ParseValidatorInactivatedEvent(vLog.Data, contractAbi)
+		reportSyncEvent(eventName, false, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse ValidatorInactivated event")
+		}
+		ec.fireEvent(vLog, *parsed, false)
+	case "OperatorDeleted":
+		parsed, isOperatorEvent, unpackErr, err := abiParser.ParseOperatorDeletedEvent(ec.operatorPubKey, vLog.Data, vLog.Topics, contractAbi)
+		reportSyncEvent(eventName, isOperatorEvent, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse OperatorDeleted event")
+		}
+		ec.fireEvent(vLog, *parsed, isOperatorEvent)
+	case "OperatorActivated":
+		parsed, isOperatorEvent, unpackErr, err := abiParser.ParseOperatorActivatedEvent(ec.operatorPubKey, vLog.Data, vLog.Topics, contractAbi)
+		reportSyncEvent(eventName, isOperatorEvent, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse OperatorActivated event")
+		}
+		ec.fireEvent(vLog, *parsed, isOperatorEvent)
+	case "OperatorInactivated":
+		parsed, isOperatorEvent, unpackErr, err := abiParser.ParseOperatorInactivatedEvent(ec.operatorPubKey, vLog.Data, vLog.Topics, contractAbi)
+		reportSyncEvent(eventName, isOperatorEvent, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse OperatorInactivated event")
+		}
+		ec.fireEvent(vLog, *parsed, isOperatorEvent)
+	case "OperatorFeeUpdated":
+		parsed, isOperatorEvent, unpackErr, err := abiParser.ParseOperatorFeeUpdatedEvent(ec.operatorPubKey, vLog.Data, vLog.Topics, contractAbi)
+		reportSyncEvent(eventName, isOperatorEvent, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse OperatorFeeUpdated event")
+		}
+		ec.fireEvent(vLog, *parsed, isOperatorEvent)
+	case "OperatorScoreUpdated":
+		parsed, isOperatorEvent, unpackErr, err := abiParser.ParseOperatorScoreUpdatedEvent(ec.operatorPubKey, vLog.Data, vLog.Topics, contractAbi)
+		reportSyncEvent(eventName, isOperatorEvent, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse OperatorScoreUpdated event")
+		}
+		ec.fireEvent(vLog, *parsed, isOperatorEvent)
+	case "NetworkFeeUpdated":
+		parsed, unpackErr, err := abiParser.ParseNetworkFeeUpdatedEvent(vLog.Data, contractAbi)
+		reportSyncEvent(eventName, false, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse NetworkFeeUpdated event")
+		}
+		ec.fireEvent(vLog, *parsed, false)
+	case "AccountLiquidated":
+		parsed, unpackErr, err := abiParser.ParseAccountLiquidatedEvent(vLog.Topics)
+		reportSyncEvent(eventName, false, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse AccountLiquidated event")
+		}
+		ec.fireEvent(vLog, *parsed, false)
+	case "AccountEnabled":
+		parsed, unpackErr, err := abiParser.ParseAccountEnabledEvent(vLog.Topics)
+		reportSyncEvent(eventName, false, err)
+		if err != nil {
+			return unpackErr, errors.Wrap(err, "failed to parse AccountEnabled event")
+		}
+		ec.fireEvent(vLog, *parsed, false)
 	default:
 		ec.logger.Debug("unknown contract event was received", zap.String("hash", vLog.TxHash.Hex()), zap.String("eventName", eventName))
 	}
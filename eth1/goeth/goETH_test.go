@@ -3,11 +3,14 @@ package goeth
 import (
 	"context"
 	"crypto/rsa"
+	"encoding/hex"
 	"encoding/json"
 	"github.com/bloxapp/ssv/eth1"
 	"github.com/bloxapp/ssv/eth1/abiparser"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/prysmaticlabs/prysm/async/event"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -88,6 +91,33 @@ func TestEth1Client_handleEvent(t *testing.T) {
 	}
 }
 
+// liquidatableCode deploys a contract that returns true for every call
+const liquidatableCode = "600a80600b6000396000f3" + "600160005260206000f3"
+
+func TestEth1Client_IsLiquidatable(t *testing.T) {
+	chain, _ := newSimulatedChain(t, 1)
+	code, err := hex.DecodeString(liquidatableCode)
+	require.NoError(t, err)
+	chain.send(nil, code, chain.backend...)
+	key, err := crypto.ToECDSA(chain.key)
+	require.NoError(t, err)
+	contract := crypto.CreateAddress(crypto.PubkeyToAddress(key.PublicKey), 1)
+
+	ec := newEth1Client(eth1.V2)
+	ec.conn = chain.backend[0]
+	ec.registryContractAddr = contract.Hex()
+	ec.contractABI = eth1.ContractABI(eth1.V2)
+	ec.connectionTimeout = time.Second
+
+	liquidatable, err := ec.IsLiquidatable(common.HexToAddress("0xa5cfD290965372553Efd5fDaeB91C335207b76E2"))
+	require.NoError(t, err)
+	require.True(t, liquidatable)
+
+	ec.abiVersion = eth1.Legacy
+	_, err = ec.IsLiquidatable(common.HexToAddress("0xa5cfD290965372553Efd5fDaeB91C335207b76E2"))
+	require.EqualError(t, err, "liquidation is not supported by the contract")
+}
+
 func newEth1Client(abiVersion eth1.Version) *eth1Client {
 	ec := eth1Client{
 		ctx:    context.TODO(),
@@ -1,6 +1,7 @@
 package eth1
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/prysm/async/event"
 	"math/big"
 	"time"
@@ -12,6 +13,8 @@ type ClientMock struct {
 
 	SyncTimeout  time.Duration
 	SyncResponse error
+
+	Liquidatable map[common.Address]bool
 }
 
 // EventsFeed returns the contract events feed
@@ -29,3 +32,8 @@ func (ec *ClientMock) Sync(fromBlock *big.Int) error {
 	<-time.After(ec.SyncTimeout)
 	return ec.SyncResponse
 }
+
+// IsLiquidatable mocking the liquidatable call
+func (ec *ClientMock) IsLiquidatable(ownerAddress common.Address) (bool, error) {
+	return ec.Liquidatable[ownerAddress], nil
+}
@@ -0,0 +1,290 @@
+package analytics
+
+import (
+	"context"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/exporter/storage"
+	"github.com/bloxapp/ssv/ibft/leader/deterministic"
+	"github.com/bloxapp/ssv/ibft/proto"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/collections"
+	"github.com/bloxapp/ssv/utils/format"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultSlotsPerEpoch is used if the network was not provided
+	defaultSlotsPerEpoch = 32
+	// defaultInterval is the default interval between aggregations
+	defaultInterval = 6 * time.Minute
+	// batchSize is the max number of decided messages that are read at once
+	batchSize = 256
+	// settleEpochs is the number of epochs to wait before aggregating a decided message,
+	// so the late commits that were added to it are counted
+	settleEpochs = 1
+	// gapTimeoutEpochs is the number of epochs to wait for missing decided messages to be backfilled,
+	// before they are skipped
+	gapTimeoutEpochs = 32
+)
+
+// dutyRoles are the roles whose decided messages are aggregated
+var dutyRoles = []beacon.RoleType{beacon.RoleTypeAttester, beacon.RoleTypeAggregator, beacon.RoleTypeProposer}
+
+// Options contains options to create the analytics
+type Options struct {
+	Logger      *zap.Logger
+	DB          basedb.IDb
+	IbftStorage collections.Iibft
+	Validators  storage.ValidatorsCollection
+	// SlotsPerEpoch is used to map decided messages into epochs
+	SlotsPerEpoch uint64
+	// Interval between aggregations
+	Interval time.Duration
+	// CurrentEpoch returns the current epoch, if not provided messages are aggregated once saved
+	// and missing messages are awaited until they are backfilled
+	CurrentEpoch func() uint64
+}
+
+// Analytics aggregates the performance of operators per epoch from decided messages
+type Analytics interface {
+	// Start aggregates periodically until the given context is done
+	Start(ctx context.Context)
+	// Aggregate processes the decided messages that were saved since the last aggregation
+	Aggregate() error
+	// GetOperatorStats returns the stats of the given operator in the given range of epochs
+	GetOperatorStats(operatorPubKey string, fromEpoch, toEpoch uint64) ([]OperatorStats, error)
+}
+
+type analytics struct {
+	logger        *zap.Logger
+	store         *statsStorage
+	ibftStorage   collections.Iibft
+	validators    storage.ValidatorsCollection
+	slotsPerEpoch uint64
+	interval      time.Duration
+	currentEpoch  func() uint64
+}
+
+// New creates a new instance of Analytics
+func New(opts Options) Analytics {
+	a := analytics{
+		logger:        opts.Logger.With(zap.String("component", "exporter/analytics")),
+		store:         newStatsStorage(opts.DB),
+		ibftStorage:   opts.IbftStorage,
+		validators:    opts.Validators,
+		slotsPerEpoch: opts.SlotsPerEpoch,
+		interval:      opts.Interval,
+		currentEpoch:  opts.CurrentEpoch,
+	}
+	if a.slotsPerEpoch == 0 {
+		a.slotsPerEpoch = defaultSlotsPerEpoch
+	}
+	if a.interval == 0 {
+		a.interval = defaultInterval
+	}
+	return &a
+}
+
+// Start aggregates periodically until the given context is done
+func (a *analytics) Start(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		if err := a.Aggregate(); err != nil {
+			a.logger.Warn("could not aggregate operators stats", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Aggregate processes the decided messages of all validators that were saved since the last aggregation
+func (a *analytics) Aggregate() error {
+	validators, err := a.validators.ListValidators(0, math.MaxInt64)
+	if err != nil {
+		return errors.Wrap(err, "could not list validators")
+	}
+	start := time.Now()
+	for _, v := range validators {
+		if err := a.aggregateValidator(v); err != nil {
+			a.logger.Warn("could not aggregate validator", zap.String("pubKey", v.PublicKey), zap.Error(err))
+		}
+	}
+	a.logger.Debug("aggregated operators stats", zap.Int("validators", len(validators)),
+		zap.Duration("took", time.Since(start)))
+	return nil
+}
+
+// aggregateValidator processes the new decided messages of all the duty roles of the given validator
+func (a *analytics) aggregateValidator(v storage.ValidatorInformation) error {
+	pkRaw, err := hex.DecodeString(v.PublicKey)
+	if err != nil {
+		return errors.Wrap(err, "could not decode validator public key")
+	}
+	for _, role := range dutyRoles {
+		if err := a.aggregateRole(v, pkRaw, role); err != nil {
+			return errors.Wrapf(err, "could not aggregate %s", role.String())
+		}
+	}
+	return nil
+}
+
+// aggregateRole processes the new decided messages of the given validator and role in batches,
+// each batch is persisted together with the cursor so messages are counted once.
+// the cursor stops at the first missing sequence, so messages that are backfilled later on are counted,
+// and at messages that are not settled yet, so late commits are included in the signers
+func (a *analytics) aggregateRole(v storage.ValidatorInformation, pkRaw []byte, role beacon.RoleType) error {
+	identifier := []byte(format.IdentifierFormat(pkRaw, role.String()))
+	highest, found, err := a.ibftStorage.GetHighestDecidedInstance(identifier)
+	if err != nil {
+		return errors.Wrap(err, "could not read highest decided")
+	}
+	if !found {
+		return nil
+	}
+	next, err := a.store.nextSeq(v.PublicKey, role)
+	if err != nil {
+		return errors.Wrap(err, "could not read cursor")
+	}
+	for from := next; from <= highest.Message.SeqNumber; from += batchSize {
+		to := from + batchSize - 1
+		if to > highest.Message.SeqNumber {
+			to = highest.Message.SeqNumber
+		}
+		msgs, err := a.ibftStorage.GetDecidedInRange(identifier, from, to)
+		if err != nil {
+			return errors.Wrap(err, "could not read decided messages")
+		}
+		stats := make(map[statsKey]*OperatorStats)
+		stopped := false
+		for _, msg := range msgs {
+			seq := msg.Message.SeqNumber
+			epoch, err := a.msgEpoch(role, msg)
+			if seq > next {
+				if !a.isGapExpired(epoch, err) {
+					stopped = true
+					break
+				}
+				a.logger.Debug("skipping missing decided messages", zap.String("pubKey", v.PublicKey),
+					zap.String("role", role.String()), zap.Uint64("from", next), zap.Uint64("to", seq-1))
+			}
+			if err != nil {
+				metricsSkippedDecided.Inc()
+				a.logger.Debug("skipping decided message", zap.String("pubKey", v.PublicKey),
+					zap.String("role", role.String()), zap.Uint64("seq", seq), zap.Error(err))
+				next = seq + 1
+				continue
+			}
+			if !a.isSettled(epoch) {
+				stopped = true
+				break
+			}
+			next = seq + 1
+			if err := a.aggregateMsg(stats, v, msg, epoch); err != nil {
+				metricsSkippedDecided.Inc()
+				a.logger.Debug("skipping decided message", zap.String("pubKey", v.PublicKey),
+					zap.String("role", role.String()), zap.Uint64("seq", seq), zap.Error(err))
+				continue
+			}
+			metricsProcessedDecided.Inc()
+		}
+		if err := a.store.save(v.PublicKey, role, next, stats); err != nil {
+			return errors.Wrap(err, "could not save stats")
+		}
+		if stopped {
+			return nil
+		}
+	}
+	return nil
+}
+
+// isSettled returns true if the given epoch is old enough, so late commits of its decided messages were received
+func (a *analytics) isSettled(epoch uint64) bool {
+	if a.currentEpoch == nil {
+		return true
+	}
+	return epoch+settleEpochs < a.currentEpoch()
+}
+
+// isGapExpired returns true if the missing sequences before a message of the given epoch are not expected
+// to be backfilled anymore, e.g. as they were pruned by peers. gaps before unreadable messages are not awaited
+func (a *analytics) isGapExpired(epoch uint64, epochErr error) bool {
+	if epochErr != nil {
+		return true
+	}
+	if a.currentEpoch == nil {
+		return false
+	}
+	return epoch+gapTimeoutEpochs < a.currentEpoch()
+}
+
+// aggregateMsg adds the given decided message to the stats of the operators of the validator
+func (a *analytics) aggregateMsg(stats map[statsKey]*OperatorStats, v storage.ValidatorInformation, msg *proto.SignedMessage, epoch uint64) error {
+	leader, err := roundLeader(msg.Message, uint64(len(v.Operators)))
+	if err != nil {
+		return errors.Wrap(err, "could not calculate leader")
+	}
+	signers := make(map[uint64]bool, len(msg.SignerIds))
+	for _, id := range msg.SignerIds {
+		signers[id] = true
+	}
+	var roundChanges uint64
+	if msg.Message.Round > 1 {
+		roundChanges = msg.Message.Round - 1
+	}
+	for _, o := range v.Operators {
+		key := statsKey{operator: o.PublicKey, epoch: epoch}
+		s, ok := stats[key]
+		if !ok {
+			s = &OperatorStats{OperatorPublicKey: o.PublicKey, Epoch: epoch}
+			stats[key] = s
+		}
+		s.Decided++
+		s.RoundChanges += roundChanges
+		if signers[o.ID] {
+			s.Signed++
+		}
+		if o.ID == leader {
+			s.Leader++
+		}
+	}
+	return nil
+}
+
+// msgEpoch returns the epoch of the given decided message according to the slot of its duty
+func (a *analytics) msgEpoch(role beacon.RoleType, msg *proto.SignedMessage) (uint64, error) {
+	slot, err := beacon.DecidedValueSlot(role, msg.Message.Value)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not decode decided value")
+	}
+	return uint64(slot) / a.slotsPerEpoch, nil
+}
+
+// roundLeader returns the id of the operator that was the leader of the decided round,
+// the leader is selected in the same way as in ibft instances
+func roundLeader(msg *proto.Message, committeeSize uint64) (uint64, error) {
+	if committeeSize == 0 {
+		return 0, errors.New("empty committee")
+	}
+	seed := append(append([]byte{}, msg.Lambda...), []byte(strconv.FormatUint(msg.SeqNumber, 10))...)
+	selector, err := deterministic.New(seed, committeeSize)
+	if err != nil {
+		return 0, err
+	}
+	// node ids start from 1
+	return selector.Calculate(msg.Round) + 1, nil
+}
+
+// GetOperatorStats returns the stats of the given operator in the given range of epochs
+func (a *analytics) GetOperatorStats(operatorPubKey string, fromEpoch, toEpoch uint64) ([]OperatorStats, error) {
+	return a.store.getOperatorStats(operatorPubKey, fromEpoch, toEpoch)
+}
@@ -0,0 +1,256 @@
+package analytics
+
+import (
+	"encoding/hex"
+	"testing"
+
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/exporter/storage"
+	"github.com/bloxapp/ssv/ibft/proto"
+	ssvstorage "github.com/bloxapp/ssv/storage"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/collections"
+	"github.com/bloxapp/ssv/utils/format"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testValidatorPK = "82e9b36feb8147d3f82c1a03ba246d4a63ac1ce0b1dabbb6991940a06401ab46fb4afbf971a3c145fdad2d4bddd30e12"
+
+type testEnv struct {
+	t            *testing.T
+	pkRaw        []byte
+	ibftStorage  *collections.IbftStorage
+	analytics    *analytics
+	currentEpoch uint64
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	db, err := ssvstorage.GetStorageFactory(basedb.Options{
+		Type:   "badger-memory",
+		Logger: zap.L(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+
+	validators := storage.NewExporterStorage(db, zap.L())
+	require.NoError(t, validators.SaveValidatorInformation(&storage.ValidatorInformation{
+		PublicKey: testValidatorPK,
+		Operators: []storage.OperatorNodeLink{
+			{ID: 1, PublicKey: "op1"}, {ID: 2, PublicKey: "op2"}, {ID: 3, PublicKey: "op3"}, {ID: 4, PublicKey: "op4"},
+		},
+	}))
+	ibftStorage := collections.NewIbft(db, zap.L(), "attestation")
+	pkRaw, err := hex.DecodeString(testValidatorPK)
+	require.NoError(t, err)
+
+	env := &testEnv{
+		t:            t,
+		pkRaw:        pkRaw,
+		ibftStorage:  &ibftStorage,
+		currentEpoch: 1000,
+	}
+	env.analytics = New(Options{
+		Logger:      zap.L(),
+		DB:          db,
+		IbftStorage: &ibftStorage,
+		Validators:  validators,
+		CurrentEpoch: func() uint64 {
+			return env.currentEpoch
+		},
+	}).(*analytics)
+	return env
+}
+
+func testAttestationData(slot uint64) *spec.AttestationData {
+	return &spec.AttestationData{
+		Slot:            spec.Slot(slot),
+		BeaconBlockRoot: spec.Root{},
+		Source:          &spec.Checkpoint{Root: spec.Root{}},
+		Target:          &spec.Checkpoint{Root: spec.Root{}},
+	}
+}
+
+// decide saves an attester decided message of the given slot
+func (env *testEnv) decide(seq, slot, round uint64, signers ...uint64) *proto.SignedMessage {
+	value, err := testAttestationData(slot).MarshalSSZ()
+	require.NoError(env.t, err)
+	return env.decideRole(beacon.RoleTypeAttester, seq, value, round, signers...)
+}
+
+// decideRole saves a decided message of the given role with the given value
+func (env *testEnv) decideRole(role beacon.RoleType, seq uint64, value []byte, round uint64, signers ...uint64) *proto.SignedMessage {
+	msg := &proto.SignedMessage{
+		Message: &proto.Message{
+			Type:      proto.RoundState_Commit,
+			Round:     round,
+			Lambda:    []byte(format.IdentifierFormat(env.pkRaw, role.String())),
+			SeqNumber: seq,
+			Value:     value,
+		},
+		SignerIds: signers,
+	}
+	require.NoError(env.t, env.ibftStorage.SaveDecided(msg))
+	highest, found, err := env.ibftStorage.GetHighestDecidedInstance(msg.Message.Lambda)
+	require.NoError(env.t, err)
+	if !found || highest.Message.SeqNumber <= seq {
+		require.NoError(env.t, env.ibftStorage.SaveHighestDecidedInstance(msg))
+	}
+	return msg
+}
+
+// requireDecided checks the number of decided instances of op1 in the given epochs
+func (env *testEnv) requireDecided(expected map[uint64]uint64) {
+	stats, err := env.analytics.GetOperatorStats("op1", 0, 1000)
+	require.NoError(env.t, err)
+	actual := map[uint64]uint64{}
+	for _, s := range stats {
+		actual[s.Epoch] = s.Decided
+	}
+	require.Equal(env.t, expected, actual)
+}
+
+func TestAnalytics_Aggregate(t *testing.T) {
+	env := newTestEnv(t)
+	leaders := map[uint64]uint64{}
+	countLeader := func(msg *proto.SignedMessage, epoch uint64) {
+		leader, err := roundLeader(msg.Message, 4)
+		require.NoError(t, err)
+		if leader == 1 {
+			leaders[epoch]++
+		}
+	}
+	countLeader(env.decide(0, 5, 1, 1, 2, 3), 0)
+	countLeader(env.decide(1, 40, 3, 1, 2, 4), 1)
+	// invalid value is skipped
+	invalid := env.decide(2, 0, 1, 1, 2, 3)
+	invalid.Message.Value = []byte{1, 2, 3}
+	require.NoError(t, env.ibftStorage.SaveDecided(invalid))
+	countLeader(env.decide(3, 45, 1, 2, 3, 4), 1)
+
+	require.NoError(t, env.analytics.Aggregate())
+	stats, err := env.analytics.GetOperatorStats("op1", 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []OperatorStats{
+		{OperatorPublicKey: "op1", Epoch: 0, Decided: 1, Signed: 1, Leader: leaders[0]},
+		{OperatorPublicKey: "op1", Epoch: 1, Decided: 2, Signed: 1, Leader: leaders[1], RoundChanges: 2},
+	}, stats)
+
+	// new messages are added to the existing windows, without counting old messages twice
+	countLeader(env.decide(4, 50, 2, 1, 3, 4), 1)
+	require.NoError(t, env.analytics.Aggregate())
+	stats, err = env.analytics.GetOperatorStats("op1", 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []OperatorStats{
+		{OperatorPublicKey: "op1", Epoch: 1, Decided: 3, Signed: 2, Leader: leaders[1], RoundChanges: 3},
+	}, stats)
+
+	// a single leader for each decided instance
+	var totalLeader uint64
+	for _, op := range []string{"op1", "op2", "op3", "op4"} {
+		stats, err := env.analytics.GetOperatorStats(op, 0, 10)
+		require.NoError(t, err)
+		for _, s := range stats {
+			totalLeader += s.Leader
+		}
+	}
+	require.EqualValues(t, 4, totalLeader)
+
+	stats, err = env.analytics.GetOperatorStats("unknown", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, stats, 0)
+}
+
+func TestAnalytics_AggregateGaps(t *testing.T) {
+	env := newTestEnv(t)
+	env.currentEpoch = 10
+	env.decide(0, 5, 1, 1, 2, 3)
+	env.decide(2, 70, 1, 1, 2, 3)
+
+	// the cursor stops at the missing sequence
+	require.NoError(t, env.analytics.Aggregate())
+	env.requireDecided(map[uint64]uint64{0: 1})
+
+	// backfilled messages are counted
+	env.decide(1, 40, 1, 1, 2, 3)
+	require.NoError(t, env.analytics.Aggregate())
+	env.requireDecided(map[uint64]uint64{0: 1, 1: 1, 2: 1})
+
+	// missing messages are awaited until the gap timeout
+	env.decide(5, 100, 1, 1, 2, 3)
+	require.NoError(t, env.analytics.Aggregate())
+	env.requireDecided(map[uint64]uint64{0: 1, 1: 1, 2: 1})
+	env.currentEpoch = 3 + gapTimeoutEpochs + 1
+	require.NoError(t, env.analytics.Aggregate())
+	env.requireDecided(map[uint64]uint64{0: 1, 1: 1, 2: 1, 3: 1})
+}
+
+func TestAnalytics_AggregateLateCommits(t *testing.T) {
+	env := newTestEnv(t)
+	env.currentEpoch = 1
+	msg := env.decide(0, 40, 1, 2, 3, 4)
+
+	// messages of the current epoch are not aggregated yet
+	require.NoError(t, env.analytics.Aggregate())
+	env.requireDecided(map[uint64]uint64{})
+
+	// late commit of op1
+	msg.SignerIds = append(msg.SignerIds, 1)
+	require.NoError(t, env.ibftStorage.SaveDecided(msg))
+	env.currentEpoch = 1 + settleEpochs + 1
+	require.NoError(t, env.analytics.Aggregate())
+	stats, err := env.analytics.GetOperatorStats("op1", 1, 1)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.EqualValues(t, 1, stats[0].Signed)
+}
+
+func TestAnalytics_AggregateRoles(t *testing.T) {
+	env := newTestEnv(t)
+	env.decide(0, 5, 1, 1, 2, 3)
+
+	aggregateAndProof := &spec.AggregateAndProof{
+		Aggregate: &spec.Attestation{
+			AggregationBits: []byte{0x01},
+			Data:            testAttestationData(40),
+		},
+	}
+	value, err := aggregateAndProof.MarshalSSZ()
+	require.NoError(t, err)
+	env.decideRole(beacon.RoleTypeAggregator, 0, value, 1, 1, 2, 3)
+
+	block := &eth2spec.VersionedBeaconBlock{
+		Version: eth2spec.DataVersionPhase0,
+		Phase0: &spec.BeaconBlock{
+			Slot: 70,
+			Body: &spec.BeaconBlockBody{
+				ETH1Data: &spec.ETH1Data{BlockHash: make([]byte, 32)},
+				Graffiti: make([]byte, 32),
+			},
+		},
+	}
+	value, err = beacon.MarshalBeaconBlock(block)
+	require.NoError(t, err)
+	env.decideRole(beacon.RoleTypeProposer, 0, value, 1, 1, 2, 3)
+
+	require.NoError(t, env.analytics.Aggregate())
+	env.requireDecided(map[uint64]uint64{0: 1, 1: 1, 2: 1})
+}
+
+func TestRoundLeader(t *testing.T) {
+	msg := &proto.Message{Lambda: []byte("lambda"), SeqNumber: 1, Round: 1}
+	leader, err := roundLeader(msg, 4)
+	require.NoError(t, err)
+	require.True(t, leader >= 1 && leader <= 4)
+
+	// the leader is rotated on round change
+	msg.Round = 2
+	next, err := roundLeader(msg, 4)
+	require.NoError(t, err)
+	require.Equal(t, leader%4+1, next)
+
+	_, err = roundLeader(msg, 0)
+	require.Error(t, err)
+}
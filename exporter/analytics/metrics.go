@@ -0,0 +1,28 @@
+package analytics
+
+import (
+	"log"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	metricsProcessedDecided = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ssv:exporter:analytics:processed_decided",
+		Help: "Count decided messages that were aggregated into operators stats",
+	})
+	metricsSkippedDecided = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ssv:exporter:analytics:skipped_decided",
+		Help: "Count decided messages that could not be aggregated",
+	})
+)
+
+func init() {
+	if err := prometheus.Register(metricsProcessedDecided); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsSkippedDecided); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+}
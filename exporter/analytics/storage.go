@@ -0,0 +1,138 @@
+package analytics
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/pkg/errors"
+)
+
+func cursorsPrefix() []byte {
+	return []byte("exporter/analytics/cursors/")
+}
+
+func statsPrefix() []byte {
+	return []byte("exporter/analytics/operators/")
+}
+
+// OperatorStats is the performance of an operator in a single epoch
+type OperatorStats struct {
+	OperatorPublicKey string `json:"operatorPublicKey"`
+	Epoch             uint64 `json:"epoch"`
+	// Decided is the number of decided instances of the operator's validators
+	Decided uint64 `json:"decided"`
+	// Signed is the number of decided instances where the operator was part of the signers
+	Signed uint64 `json:"signed"`
+	// Leader is the number of decided instances where the operator was the leader of the decided round
+	Leader uint64 `json:"leader"`
+	// RoundChanges is the number of round changes in decided instances of the operator's validators
+	RoundChanges uint64 `json:"roundChanges"`
+}
+
+// add adds the given stats
+func (s *OperatorStats) add(other *OperatorStats) {
+	s.Decided += other.Decided
+	s.Signed += other.Signed
+	s.Leader += other.Leader
+	s.RoundChanges += other.RoundChanges
+}
+
+type statsKey struct {
+	operator string
+	epoch    uint64
+}
+
+// statsStorage persists the stats of operators, rolled up per epoch, and the cursor of each validator.
+// stats are keyed by operator (hash of public key) and epoch so an operator's epochs are stored together
+type statsStorage struct {
+	db basedb.IDb
+}
+
+func newStatsStorage(db basedb.IDb) *statsStorage {
+	return &statsStorage{db: db}
+}
+
+// nextSeq returns the next sequence number to be aggregated for the given validator and role
+func (s *statsStorage) nextSeq(validatorPubKey string, role beacon.RoleType) (uint64, error) {
+	obj, found, err := s.db.Get(cursorsPrefix(), cursorKey(validatorPubKey, role))
+	if err != nil || !found {
+		return 0, err
+	}
+	if len(obj.Value) != 8 {
+		return 0, errors.New("invalid cursor")
+	}
+	return binary.BigEndian.Uint64(obj.Value), nil
+}
+
+// save adds the given stats to the persisted windows and updates the cursor of the validator and role,
+// in a single transaction
+func (s *statsStorage) save(validatorPubKey string, role beacon.RoleType, next uint64, stats map[statsKey]*OperatorStats) error {
+	return s.db.Update(func(txn basedb.Txn) error {
+		for key, st := range stats {
+			k := operatorEpochKey(key.operator, key.epoch)
+			obj, found, err := txn.Get(statsPrefix(), k)
+			if err != nil {
+				return errors.Wrap(err, "could not read stats")
+			}
+			if found {
+				var existing OperatorStats
+				if err := json.Unmarshal(obj.Value, &existing); err != nil {
+					return errors.Wrap(err, "could not unmarshal stats")
+				}
+				st.add(&existing)
+			}
+			raw, err := json.Marshal(st)
+			if err != nil {
+				return errors.Wrap(err, "could not marshal stats")
+			}
+			if err := txn.Set(statsPrefix(), k, raw); err != nil {
+				return errors.Wrap(err, "could not save stats")
+			}
+		}
+		cursor := make([]byte, 8)
+		binary.BigEndian.PutUint64(cursor, next)
+		return txn.Set(cursorsPrefix(), cursorKey(validatorPubKey, role), cursor)
+	})
+}
+
+// getOperatorStats returns the stats of the given operator in the given range of epochs, sorted by epoch
+func (s *statsStorage) getOperatorStats(operatorPubKey string, from, to uint64) ([]OperatorStats, error) {
+	var res []OperatorStats
+	err := s.db.GetAll(append(statsPrefix(), operatorKey(operatorPubKey)...), func(i int, obj basedb.Obj) error {
+		var st OperatorStats
+		if err := json.Unmarshal(obj.Value, &st); err != nil {
+			return errors.Wrap(err, "could not unmarshal stats")
+		}
+		if st.Epoch >= from && st.Epoch <= to {
+			res = append(res, st)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Epoch < res[j].Epoch
+	})
+	return res, nil
+}
+
+func cursorKey(validatorPubKey string, role beacon.RoleType) []byte {
+	return []byte(fmt.Sprintf("%s/%s", validatorPubKey, role.String()))
+}
+
+func operatorKey(operatorPubKey string) []byte {
+	return []byte(fmt.Sprintf("%x/", sha256.Sum256([]byte(operatorPubKey))))
+}
+
+func operatorEpochKey(operatorPubKey string, epoch uint64) []byte {
+	k := operatorKey(operatorPubKey)
+	e := make([]byte, 8)
+	binary.BigEndian.PutUint64(e, epoch)
+	return append(k, e...)
+}
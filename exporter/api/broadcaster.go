@@ -14,17 +14,20 @@ type Broadcaster interface {
 	Broadcast(msg Message) error
 	Register(conn broadcasted) bool
 	Deregister(conn broadcasted) bool
+	UseOperatorsLookup(lookup OperatorsLookup)
 }
 
 type broadcasted interface {
 	ID() string
 	Send([]byte)
+	Match(sm *streamMessage) bool
 }
 
 type broadcaster struct {
 	logger      *zap.Logger
 	mut         sync.Mutex
 	connections map[string]broadcasted
+	lookup      OperatorsLookup
 }
 
 func newBroadcaster(logger *zap.Logger) Broadcaster {
@@ -57,7 +60,13 @@ func (b *broadcaster) FromFeed(msgFeed *event.Feed) error {
 	}
 }
 
-// Broadcast broadcasts a message to all available connections
+// UseOperatorsLookup sets the lookup that is used for matching decided messages to operators subscriptions
+func (b *broadcaster) UseOperatorsLookup(lookup OperatorsLookup) {
+	b.lookup = lookup
+}
+
+// Broadcast broadcasts a message to all the connections that are subscribed to it,
+// sending is not blocking as slow connections are dropped
 func (b *broadcaster) Broadcast(msg Message) error {
 	data, err := json.Marshal(&msg)
 	if err != nil {
@@ -76,9 +85,12 @@ func (b *broadcaster) Broadcast(msg Message) error {
 		conns = append(conns, c)
 	}
 	b.mut.Unlock()
-	// send to all connections
+	// send to all matching connections
+	sm := &streamMessage{msg: &msg, lookup: b.lookup}
 	for _, c := range conns {
-		c.Send(data[:])
+		if c.Match(sm) {
+			c.Send(data[:])
+		}
 	}
 
 	return nil
@@ -18,6 +18,8 @@ func TestConn_Send_FullQueue(t *testing.T) {
 	for i := 0; i < chanSize+2; i++ {
 		c.Send([]byte(fmt.Sprintf("test-%d", i)))
 	}
+	// the slow connection is dropped
+	require.Error(t, c.(*conn).ctx.Err())
 }
 
 func TestBroadcaster(t *testing.T) {
@@ -51,6 +53,27 @@ func TestBroadcaster(t *testing.T) {
 	require.Equal(t, bm2.Size(), 1)
 }
 
+func TestBroadcaster_Subscriptions(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	b := newBroadcaster(logger)
+	b.UseOperatorsLookup(func(validatorPubKey string) []string {
+		return []string{"operator-" + validatorPubKey}
+	})
+
+	c := newConn(context.Background(), logger, nil, "test", 0, false).(*conn)
+	all := newBroadcastedMock("all")
+	require.True(t, b.Register(c))
+	require.True(t, b.Register(all))
+
+	_, err := c.subs.add(&Subscription{Operators: []string{"operator-" + testValidatorPK}})
+	require.Nil(t, err)
+
+	require.NoError(t, b.Broadcast(Message{Type: TypeDecided, Filter: MessageFilter{PublicKey: testValidatorPK}}))
+	require.NoError(t, b.Broadcast(Message{Type: TypeDecided, Filter: MessageFilter{PublicKey: "other"}}))
+	require.Len(t, c.send, 1)
+	require.Equal(t, 2, all.Size())
+}
+
 type broadcastedMock struct {
 	mut  sync.Mutex
 	msgs [][]byte
@@ -76,6 +99,10 @@ func (b *broadcastedMock) Send(msg []byte) {
 	b.msgs = append(b.msgs, msg)
 }
 
+func (b *broadcastedMock) Match(sm *streamMessage) bool {
+	return true
+}
+
 func (b *broadcastedMock) Size() int {
 	b.mut.Lock()
 	defer b.mut.Unlock()
@@ -20,8 +20,8 @@ var (
 	// pingInterval period to send ping messages. Must be less than pingTimeout.
 	pingInterval = (pingTimeout * 8) / 10
 
-	// maxMessageSize max msg size allowed from peer.
-	maxMessageSize = int64(1024)
+	// maxMessageSize max msg size allowed from peer, big enough for subscription messages
+	maxMessageSize = int64(16 * 1024)
 
 	chanSize = 256
 
@@ -42,8 +42,10 @@ type Conn interface {
 	ID() string
 	ReadNext() []byte
 	Send(msg []byte)
+	Match(sm *streamMessage) bool
 	WriteLoop()
 	ReadLoop()
+	SubscriptionsLoop()
 	Close() error
 	RemoteAddr() net.Addr
 }
@@ -51,6 +53,7 @@ type Conn interface {
 type conn struct {
 	logger *zap.Logger
 	ctx    context.Context
+	cancel context.CancelFunc
 	id     string
 	ws     *websocket.Conn
 
@@ -62,11 +65,15 @@ type conn struct {
 	writeLock sync.Locker
 
 	withPing bool
+
+	subs *subscriptions
 }
 
 func newConn(ctx context.Context, logger *zap.Logger, ws *websocket.Conn, id string, writeTimeout time.Duration, withPing bool) Conn {
+	ctx, cancel := context.WithCancel(ctx)
 	return &conn{
 		ctx:          ctx,
+		cancel:       cancel,
 		logger:       logger.With(zap.String("who", "WSConn")),
 		id:           id,
 		ws:           ws,
@@ -75,6 +82,7 @@ func newConn(ctx context.Context, logger *zap.Logger, ws *websocket.Conn, id str
 		send:         make(chan []byte, chanSize),
 		writeLock:    &sync.Mutex{},
 		withPing:     withPing,
+		subs:         newSubscriptions(),
 	}
 }
 
@@ -93,18 +101,28 @@ func (c *conn) Close() error {
 	return c.ws.Close()
 }
 
-// ReadNext reads the next message
+// ReadNext reads the next message, returns nil once the read loop is done
 func (c *conn) ReadNext() []byte {
 	return <-c.read
 }
 
-// Send sends the given message
+// Send sends the given message without blocking,
+// a slow client that doesn't keep up with the messages is dropped
 func (c *conn) Send(msg []byte) {
-	if len(c.send) >= chanSize {
-		// don't send on full channel
-		return
+	select {
+	case c.send <- msg:
+	default:
+		if c.ctx.Err() == nil {
+			c.logger.Warn("dropping slow connection")
+			metricStreamDroppedConnections.Inc()
+			c.cancel()
+		}
 	}
-	c.send <- msg
+}
+
+// Match returns whether the given message matches the subscriptions of the connection
+func (c *conn) Match(sm *streamMessage) bool {
+	return c.subs.match(sm)
 }
 
 // WriteLoop a loop to activate writes on the socket
@@ -134,8 +152,8 @@ func (c *conn) WriteLoop() {
 			c.writeLock.Unlock()
 			if err != nil {
 				c.logger.Error("could not send close message", zap.Error(err))
-				return
 			}
+			return
 		case message := <-c.send:
 			c.writeLock.Lock()
 			n, err := c.sendMsg(message)
@@ -153,6 +171,8 @@ func (c *conn) WriteLoop() {
 // ReadLoop is a loop to read messages from the socket
 func (c *conn) ReadLoop() {
 	defer func() {
+		c.cancel()
+		close(c.read)
 		_ = c.ws.Close()
 	}()
 	c.ws.SetReadLimit(maxMessageSize)
@@ -183,8 +203,28 @@ func (c *conn) ReadLoop() {
 		}
 		if mt == websocket.TextMessage {
 			msg = bytes.TrimSpace(bytes.Replace(msg, newline, space, -1))
-			c.read <- msg
+			if len(msg) > 0 {
+				c.read <- msg
+			}
+		}
+	}
+}
+
+// SubscriptionsLoop processes the subscription messages of the client until the read loop is done
+func (c *conn) SubscriptionsLoop() {
+	for {
+		raw := c.ReadNext()
+		if raw == nil {
+			return
+		}
+		res := c.subs.handle(raw)
+		c.logger.Debug("handled subscription message", zap.String("type", string(res.Type)))
+		data, err := json.Marshal(&res)
+		if err != nil {
+			c.logger.Warn("could not marshal subscription response", zap.Error(err))
+			continue
 		}
+		c.Send(data)
 	}
 }
 
@@ -14,6 +14,10 @@ var (
 		Name: "ssv:exporter:stream_outbound_errors",
 		Help: "count the outbound messages failures on stream channel",
 	}, []string{"cid"})
+	metricStreamDroppedConnections = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ssv:exporter:stream_dropped_connections",
+		Help: "count the stream connections that were dropped as they didn't keep up with messages",
+	})
 )
 
 func reportStreamOutbound(cid string, err error) {
@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/bloxapp/ssv/exporter/storage"
 	registrystorage "github.com/bloxapp/ssv/registry/storage"
+	validatorstorage "github.com/bloxapp/ssv/validator/storage"
 )
 
 // Message represents an exporter message
@@ -25,6 +26,8 @@ type MessageFilter struct {
 	Role DutyRole `json:"role,omitempty"`
 	// PublicKey is optional, used for fetching decided messages or information about specific validator/operator
 	PublicKey string `json:"publicKey,omitempty"`
+	// OwnerAddress is optional, used for fetching information about specific owner account
+	OwnerAddress string `json:"ownerAddress,omitempty"`
 }
 
 // MessageType is the type of message being sent
@@ -37,8 +40,20 @@ const (
 	TypeOperator MessageType = "operator"
 	// TypeDecided is an enum for ibft type messages
 	TypeDecided MessageType = "decided"
+	// TypeAccount is an enum for owner account type messages
+	TypeAccount MessageType = "account"
+	// TypeSigners is an enum for signers (of decided instances) type messages
+	TypeSigners MessageType = "signers"
+	// TypeOperatorStats is an enum for operator stats (per epoch) type messages
+	TypeOperatorStats MessageType = "operator_stats"
+	// TypeCoverage is an enum for decided messages coverage (gaps) type messages
+	TypeCoverage MessageType = "coverage"
 	// TypeError is an enum for error type messages
 	TypeError MessageType = "error"
+	// TypeSubscribe is an enum for stream subscription messages
+	TypeSubscribe MessageType = "subscribe"
+	// TypeUnsubscribe is an enum for stream un-subscription messages
+	TypeUnsubscribe MessageType = "unsubscribe"
 )
 
 // DutyRole is the role of the duty
@@ -62,3 +77,8 @@ type ValidatorsMessage struct {
 type OperatorsMessage struct {
 	Data []registrystorage.OperatorInformation `json:"data,omitempty"`
 }
+
+// AccountsMessage represents message for owner accounts response
+type AccountsMessage struct {
+	Data []validatorstorage.OwnerAccount `json:"data,omitempty"`
+}
@@ -0,0 +1,284 @@
+package api
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// restPrefix is the prefix of all REST end points
+	restPrefix = "/v1/"
+	// defaultPageSize is the number of results in a page if no limit was requested
+	defaultPageSize = 100
+	// maxPageSize is the max number of results in a single page
+	maxPageSize = 1000
+)
+
+// HealthCheckHandler returns a list of issues regards the state of the node
+type HealthCheckHandler func() []string
+
+// RestResponse is the envelope of REST responses
+type RestResponse struct {
+	Data       interface{}     `json:"data"`
+	Pagination *RestPagination `json:"pagination,omitempty"`
+
+	count int
+}
+
+// RestPagination describes the returned page, Next is the start of the next page if there might be more results
+type RestPagination struct {
+	From  int64  `json:"from"`
+	To    int64  `json:"to"`
+	Count int    `json:"count"`
+	Next  *int64 `json:"next,omitempty"`
+}
+
+// RestError is the response in case of a failure
+type RestError struct {
+	Errors []string `json:"errors"`
+}
+
+// HealthResponse is the response of the health end point
+type HealthResponse struct {
+	Status string   `json:"status"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+// restError is an error that is mapped to an http status
+type restError struct {
+	status int
+	errs   []string
+}
+
+func (e *restError) Error() string {
+	return strings.Join(e.errs, ", ")
+}
+
+func badRequest(msg string) *restError {
+	return &restError{status: http.StatusBadRequest, errs: []string{"bad request - " + msg}}
+}
+
+// registerRestHandlers registers the REST end points:
+//
+//	GET /v1/health
+//	GET /v1/operators?from=&limit=
+//	GET /v1/operators/{publicKey}
+//	GET /v1/validators?from=&limit=
+//	GET /v1/validators/{publicKey}
+//	GET /v1/validators/{publicKey}/decided?role=&from=&to=
+//	GET /v1/schemas/{name}
+func (ws *wsServer) registerRestHandlers() {
+	ws.router.HandleFunc(restPrefix, ws.handleRest)
+}
+
+// handleRest routes REST requests, queries are served by the query handler so they share the logic of /query
+func (ws *wsServer) handleRest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeRestError(w, &restError{status: http.StatusMethodNotAllowed, errs: []string{"method not allowed"}})
+		return
+	}
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, restPrefix), "/"), "/")
+	switch parts[0] {
+	case "health":
+		ws.handleHealth(w)
+		return
+	case "schemas":
+		if len(parts) != 2 {
+			writeRestError(w, &restError{status: http.StatusNotFound, errs: []string{"not found"}})
+			return
+		}
+		handleSchema(w, parts[1])
+		return
+	}
+	msg, err := parseRestQuery(parts, r)
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	if ws.handler == nil {
+		writeRestError(w, &restError{status: http.StatusServiceUnavailable, errs: []string{"service unavailable"}})
+		return
+	}
+	ws.logger.Debug("handles rest request", zap.String("path", r.URL.Path), zap.String("type", string(msg.Type)))
+	nm := NetworkMessage{Msg: *msg}
+	ws.handler(&nm)
+	if err := restQueryError(&nm.Msg); err != nil {
+		writeRestError(w, err)
+		return
+	}
+	res := newRestResponse(&nm.Msg, len(parts) == 1 || msg.Type == TypeDecided)
+	// a single entity was requested
+	if len(parts) == 2 && res.count == 0 {
+		writeRestError(w, &restError{status: http.StatusNotFound, errs: []string{"could not find " + string(msg.Type)}})
+		return
+	}
+	writeRestResponse(w, http.StatusOK, res)
+}
+
+// handleHealth responds with the health of the node
+func (ws *wsServer) handleHealth(w http.ResponseWriter) {
+	var errs []string
+	if ws.healthCheck != nil {
+		errs = ws.healthCheck()
+	}
+	if len(errs) > 0 {
+		writeRestResponse(w, http.StatusServiceUnavailable, &HealthResponse{Status: "unhealthy", Errors: errs})
+		return
+	}
+	writeRestResponse(w, http.StatusOK, &HealthResponse{Status: "healthy"})
+}
+
+// parseRestQuery converts the given path and query params into a query message
+func parseRestQuery(parts []string, r *http.Request) (*Message, *restError) {
+	msg := &Message{}
+	switch {
+	case parts[0] == "operators" && len(parts) <= 2:
+		msg.Type = TypeOperator
+	case parts[0] == "validators" && len(parts) <= 2:
+		msg.Type = TypeValidator
+	case parts[0] == "validators" && len(parts) == 3 && parts[2] == "decided":
+		msg.Type = TypeDecided
+	default:
+		return nil, &restError{status: http.StatusNotFound, errs: []string{"not found"}}
+	}
+	if len(parts) > 1 {
+		msg.Filter.PublicKey = parts[1]
+		if msg.Type != TypeOperator {
+			pk, err := parseValidatorPublicKey(parts[1])
+			if err != nil {
+				return nil, err
+			}
+			msg.Filter.PublicKey = pk
+		}
+	}
+	if msg.Type == TypeDecided {
+		role, err := parseRole(r.URL.Query().Get("role"))
+		if err != nil {
+			return nil, err
+		}
+		msg.Filter.Role = role
+	}
+	// single entities are not paginated
+	if len(parts) == 2 {
+		return msg, nil
+	}
+	from, to, err := parsePagination(r)
+	if err != nil {
+		return nil, err
+	}
+	msg.Filter.From = from
+	msg.Filter.To = to
+	return msg, nil
+}
+
+// parsePagination returns the requested range, either by "from" and "to" or by "from" and "limit"
+func parsePagination(r *http.Request) (int64, int64, *restError) {
+	q := r.URL.Query()
+	from, err := parseInt(q.Get("from"), 0)
+	if err != nil || from < 0 {
+		return 0, 0, badRequest("invalid from")
+	}
+	limit, err := parseInt(q.Get("limit"), defaultPageSize)
+	if err != nil || limit <= 0 {
+		return 0, 0, badRequest("invalid limit")
+	}
+	if len(q.Get("to")) > 0 {
+		to, err := parseInt(q.Get("to"), 0)
+		if err != nil || to < from {
+			return 0, 0, badRequest("invalid to")
+		}
+		limit = to - from + 1
+	}
+	if limit > maxPageSize {
+		return 0, 0, badRequest("page size should not exceed " + strconv.Itoa(maxPageSize))
+	}
+	return from, from + limit - 1, nil
+}
+
+func parseInt(s string, defaultValue int64) (int64, error) {
+	if len(s) == 0 {
+		return defaultValue, nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// parseValidatorPublicKey validates the given hex encoded public key and returns it without 0x prefix
+func parseValidatorPublicKey(pk string) (string, *restError) {
+	pk = strings.TrimPrefix(strings.ToLower(pk), "0x")
+	if raw, err := hex.DecodeString(pk); err != nil || len(raw) != 48 {
+		return "", badRequest("invalid validator public key")
+	}
+	return pk, nil
+}
+
+// parseRole returns the given role, attester is the default role
+func parseRole(role string) (DutyRole, *restError) {
+	switch DutyRole(strings.ToUpper(role)) {
+	case "", RoleAttester:
+		return RoleAttester, nil
+	case RoleAggregator:
+		return RoleAggregator, nil
+	case RoleProposer:
+		return RoleProposer, nil
+	}
+	return "", badRequest("unknown role")
+}
+
+// restQueryError returns the error of a processed query, query handlers return errors as a list of strings
+func restQueryError(msg *Message) *restError {
+	errs, ok := msg.Data.([]string)
+	if !ok && msg.Type != TypeError {
+		return nil
+	}
+	if len(errs) == 0 {
+		errs = []string{"unknown error"}
+	}
+	status := http.StatusInternalServerError
+	for _, e := range errs {
+		if strings.HasPrefix(e, "bad request") {
+			status = http.StatusBadRequest
+		} else if strings.Contains(e, "could not find") {
+			status = http.StatusNotFound
+		}
+	}
+	return &restError{status: status, errs: errs}
+}
+
+// newRestResponse creates a response from the given processed query
+func newRestResponse(msg *Message, paginated bool) *RestResponse {
+	data := msg.Data
+	count := 0
+	if rv := reflect.ValueOf(data); rv.Kind() == reflect.Slice {
+		count = rv.Len()
+		if rv.IsNil() {
+			data = []interface{}{}
+		}
+	} else if data == nil {
+		data = []interface{}{}
+	}
+	res := &RestResponse{Data: data, count: count}
+	if paginated {
+		res.Pagination = &RestPagination{From: msg.Filter.From, To: msg.Filter.To, Count: count}
+		if int64(count) == msg.Filter.To-msg.Filter.From+1 {
+			next := msg.Filter.To + 1
+			res.Pagination.Next = &next
+		}
+	}
+	return res
+}
+
+func writeRestError(w http.ResponseWriter, err *restError) {
+	writeRestResponse(w, err.status, &RestError{Errors: err.errs})
+}
+
+func writeRestResponse(w http.ResponseWriter, status int, res interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+const testValidatorPK = "82e9b36feb8147d3f82c1a03ba246d4a63ac1ce0b1dabbb6991940a06401ab46fb4afbf971a3c145fdad2d4bddd30e12"
+
+func TestHandleRest(t *testing.T) {
+	var lastMsg Message
+	ws := NewWsServer(context.Background(), zaptest.NewLogger(t), func(nm *NetworkMessage) {
+		lastMsg = nm.Msg
+		switch nm.Msg.Type {
+		case TypeOperator:
+			if len(nm.Msg.Filter.PublicKey) > 0 {
+				if nm.Msg.Filter.PublicKey == "unknown" {
+					return
+				}
+				nm.Msg.Data = []registrystorage.OperatorInformation{{PublicKey: nm.Msg.Filter.PublicKey}}
+				return
+			}
+			var operators []registrystorage.OperatorInformation
+			for i := nm.Msg.Filter.From; i <= nm.Msg.Filter.To && i < 150; i++ {
+				operators = append(operators, registrystorage.OperatorInformation{Index: i})
+			}
+			nm.Msg.Data = operators
+		case TypeDecided:
+			nm.Msg.Data = []string{"internal error - could not find validator"}
+		default:
+			nm.Msg.Type = TypeError
+			nm.Msg.Data = []string{"internal error - could not get validators"}
+		}
+	}, http.NewServeMux(), false).(*wsServer)
+
+	get := func(path string) (int, map[string]interface{}) {
+		w := httptest.NewRecorder()
+		ws.handleRest(w, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		return w.Code, body
+	}
+
+	t.Run("default page", func(t *testing.T) {
+		status, body := get("/v1/operators")
+		require.Equal(t, http.StatusOK, status)
+		require.Len(t, body["data"], defaultPageSize)
+		pagination := body["pagination"].(map[string]interface{})
+		require.EqualValues(t, 0, pagination["from"])
+		require.EqualValues(t, defaultPageSize-1, pagination["to"])
+		require.EqualValues(t, defaultPageSize, pagination["next"])
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		status, body := get("/v1/operators?from=100&limit=100")
+		require.Equal(t, http.StatusOK, status)
+		require.Len(t, body["data"], 50)
+		pagination := body["pagination"].(map[string]interface{})
+		require.EqualValues(t, 50, pagination["count"])
+		require.Nil(t, pagination["next"])
+	})
+
+	t.Run("single operator", func(t *testing.T) {
+		status, body := get("/v1/operators/pk")
+		require.Equal(t, http.StatusOK, status)
+		require.Len(t, body["data"], 1)
+		require.Nil(t, body["pagination"])
+		require.Equal(t, "pk", lastMsg.Filter.PublicKey)
+
+		status, body = get("/v1/operators/unknown")
+		require.Equal(t, http.StatusNotFound, status)
+		require.Equal(t, []interface{}{"could not find operator"}, body["errors"])
+	})
+
+	t.Run("decided", func(t *testing.T) {
+		status, body := get("/v1/validators/0x" + strings.ToUpper(testValidatorPK) + "/decided?role=proposer&from=2&to=5")
+		require.Equal(t, http.StatusNotFound, status)
+		require.Equal(t, []interface{}{"internal error - could not find validator"}, body["errors"])
+		require.Equal(t, testValidatorPK, lastMsg.Filter.PublicKey)
+		require.Equal(t, RoleProposer, lastMsg.Filter.Role)
+		require.EqualValues(t, 2, lastMsg.Filter.From)
+		require.EqualValues(t, 5, lastMsg.Filter.To)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		status, body := get("/v1/validators")
+		require.Equal(t, http.StatusInternalServerError, status)
+		require.Equal(t, []interface{}{"internal error - could not get validators"}, body["errors"])
+	})
+
+	t.Run("bad requests", func(t *testing.T) {
+		paths := []string{
+			"/v1/operators?from=-1",
+			"/v1/operators?limit=0",
+			"/v1/operators?limit=1001",
+			"/v1/operators?from=5&to=4",
+			"/v1/validators/xyz",
+			"/v1/validators/" + testValidatorPK + "/decided?role=unknown",
+		}
+		for _, path := range paths {
+			status, _ := get(path)
+			require.Equal(t, http.StatusBadRequest, status, path)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		status, _ := get("/v1/unknown")
+		require.Equal(t, http.StatusNotFound, status)
+		status, _ = get("/v1/schemas/unknown")
+		require.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		status, body := get("/v1/schemas/decided")
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, jsonSchemaDraft, body["$schema"])
+		require.Contains(t, body["properties"], "pagination")
+	})
+
+	t.Run("health", func(t *testing.T) {
+		status, body := get("/v1/health")
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, "healthy", body["status"])
+
+		ws.UseHealthCheck(func() []string {
+			return []string{"not synced"}
+		})
+		status, body = get("/v1/health")
+		require.Equal(t, http.StatusServiceUnavailable, status)
+		require.Equal(t, "unhealthy", body["status"])
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ws.handleRest(w, httptest.NewRequest(http.MethodPost, "/v1/operators", nil))
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
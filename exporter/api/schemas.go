@@ -0,0 +1,146 @@
+package api
+
+import (
+	"net/http"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+type jsonSchema map[string]interface{}
+
+var (
+	stringSchema  = jsonSchema{"type": "string"}
+	integerSchema = jsonSchema{"type": "integer"}
+	booleanSchema = jsonSchema{"type": "boolean"}
+	// bytes are encoded as base64 strings
+	bytesSchema = jsonSchema{"type": "string", "contentEncoding": "base64"}
+
+	operatorSchema = jsonSchema{
+		"type":     "object",
+		"required": []string{"publicKey", "name", "ownerAddress", "index"},
+		"properties": jsonSchema{
+			"publicKey":    stringSchema,
+			"name":         stringSchema,
+			"ownerAddress": stringSchema,
+			"index":        integerSchema,
+			"fee":          integerSchema,
+			"score":        integerSchema,
+			"inactive":     booleanSchema,
+			"deleted":      booleanSchema,
+		},
+	}
+
+	validatorSchema = jsonSchema{
+		"type":     "object",
+		"required": []string{"index", "publicKey", "operators"},
+		"properties": jsonSchema{
+			"index":     integerSchema,
+			"publicKey": stringSchema,
+			"operators": jsonSchema{
+				"type": "array",
+				"items": jsonSchema{
+					"type": "object",
+					"properties": jsonSchema{
+						"nodeId":    integerSchema,
+						"publicKey": stringSchema,
+					},
+				},
+			},
+		},
+	}
+
+	decidedSchema = jsonSchema{
+		"type":     "object",
+		"required": []string{"message"},
+		"properties": jsonSchema{
+			"message": jsonSchema{
+				"type": "object",
+				"properties": jsonSchema{
+					"type":       integerSchema,
+					"round":      integerSchema,
+					"lambda":     bytesSchema,
+					"seq_number": integerSchema,
+					"value":      bytesSchema,
+				},
+			},
+			"signature":  bytesSchema,
+			"signer_ids": jsonSchema{"type": "array", "items": integerSchema},
+		},
+	}
+
+	paginationSchema = jsonSchema{
+		"type":     "object",
+		"required": []string{"from", "to", "count"},
+		"properties": jsonSchema{
+			"from":  integerSchema,
+			"to":    integerSchema,
+			"count": integerSchema,
+			"next":  integerSchema,
+		},
+	}
+
+	errorSchema = jsonSchema{
+		"type":       "object",
+		"required":   []string{"errors"},
+		"properties": jsonSchema{"errors": jsonSchema{"type": "array", "items": stringSchema}},
+	}
+
+	healthSchema = jsonSchema{
+		"type":     "object",
+		"required": []string{"status"},
+		"properties": jsonSchema{
+			"status": jsonSchema{"type": "string", "enum": []string{"healthy", "unhealthy"}},
+			"errors": jsonSchema{"type": "array", "items": stringSchema},
+		},
+	}
+
+	// schemas contains the JSON schemas of the REST responses by name
+	schemas = map[string]jsonSchema{
+		"operators":  listSchema("operators", operatorSchema),
+		"operator":   entitySchema("operator", operatorSchema),
+		"validators": listSchema("validators", validatorSchema),
+		"validator":  entitySchema("validator", validatorSchema),
+		"decided":    listSchema("decided", decidedSchema),
+		"health":     withMeta("health", healthSchema),
+		"error":      withMeta("error", errorSchema),
+	}
+)
+
+// listSchema wraps the given item schema with a paginated response envelope
+func listSchema(title string, item jsonSchema) jsonSchema {
+	return withMeta(title, jsonSchema{
+		"type":     "object",
+		"required": []string{"data", "pagination"},
+		"properties": jsonSchema{
+			"data":       jsonSchema{"type": "array", "items": item},
+			"pagination": paginationSchema,
+		},
+	})
+}
+
+// entitySchema wraps the given item schema with a response envelope of a single entity
+func entitySchema(title string, item jsonSchema) jsonSchema {
+	return withMeta(title, jsonSchema{
+		"type":       "object",
+		"required":   []string{"data"},
+		"properties": jsonSchema{"data": jsonSchema{"type": "array", "items": item, "maxItems": 1}},
+	})
+}
+
+func withMeta(title string, s jsonSchema) jsonSchema {
+	res := jsonSchema{"$schema": jsonSchemaDraft, "title": title}
+	for k, v := range s {
+		res[k] = v
+	}
+	return res
+}
+
+// handleSchema responds with the JSON schema of the given name
+func handleSchema(w http.ResponseWriter, name string) {
+	s, ok := schemas[name]
+	if !ok {
+		writeRestError(w, &restError{status: http.StatusNotFound, errs: []string{"unknown schema"}})
+		return
+	}
+	writeRestResponse(w, http.StatusOK, s)
+}
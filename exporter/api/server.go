@@ -19,6 +19,8 @@ type WebSocketServer interface {
 	Start(addr string) error
 	BroadcastFeed() *event.Feed
 	UseQueryHandler(handler QueryMessageHandler)
+	UseHealthCheck(handler HealthCheckHandler)
+	UseOperatorsLookup(lookup OperatorsLookup)
 }
 
 // wsServer is an implementation of WebSocketServer
@@ -27,7 +29,8 @@ type wsServer struct {
 
 	logger *zap.Logger
 
-	handler QueryMessageHandler
+	handler     QueryMessageHandler
+	healthCheck HealthCheckHandler
 
 	broadcaster Broadcaster
 
@@ -55,10 +58,21 @@ func (ws *wsServer) UseQueryHandler(handler QueryMessageHandler) {
 	ws.handler = handler
 }
 
+// UseHealthCheck sets the handler that is used by the health end point
+func (ws *wsServer) UseHealthCheck(handler HealthCheckHandler) {
+	ws.healthCheck = handler
+}
+
+// UseOperatorsLookup sets the lookup that is used by stream subscriptions of operators
+func (ws *wsServer) UseOperatorsLookup(lookup OperatorsLookup) {
+	ws.broadcaster.UseOperatorsLookup(lookup)
+}
+
 // Start starts the websocket server and the broadcaster
 func (ws *wsServer) Start(addr string) error {
 	ws.RegisterHandler("/query", ws.handleQuery)
 	ws.RegisterHandler("/stream", ws.handleStream)
+	ws.registerRestHandlers()
 
 	go func() {
 		if err := ws.broadcaster.FromFeed(ws.out); err != nil {
@@ -67,7 +81,7 @@ func (ws *wsServer) Start(addr string) error {
 	}()
 	ws.logger.Info("starting websocket server",
 		zap.String("addr", addr),
-		zap.Strings("endPoints", []string{"/query", "/stream"}))
+		zap.Strings("endPoints", []string{"/query", "/stream", restPrefix}))
 
 	err := http.ListenAndServe(addr, ws.router)
 	if err != nil {
@@ -141,7 +155,8 @@ func (ws *wsServer) handleQuery(conn *websocket.Conn) {
 	}
 }
 
-// handleStream registers the connection for broadcasting of stream messages
+// handleStream registers the connection for broadcasting of stream messages,
+// clients can filter the pushed messages by sending subscribe/unsubscribe messages
 func (ws *wsServer) handleStream(wsc *websocket.Conn) {
 	cid := ConnectionID(wsc)
 	logger := ws.logger.
@@ -159,6 +174,7 @@ func (ws *wsServer) handleStream(wsc *websocket.Conn) {
 	defer ws.broadcaster.Deregister(c)
 
 	go c.ReadLoop()
+	go c.SubscriptionsLoop()
 
 	c.WriteLoop()
 }
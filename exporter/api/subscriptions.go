@@ -0,0 +1,276 @@
+package api
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bloxapp/ssv/exporter/storage"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+)
+
+const (
+	// maxSubscriptions is the max number of subscriptions of a single stream connection
+	maxSubscriptions = 32
+)
+
+// OperatorsLookup returns the public keys of the operators of the given validator,
+// it is used to match decided messages to operator subscriptions
+type OperatorsLookup func(validatorPubKey string) []string
+
+// Subscription is a server-side filter of stream messages, an empty criteria matches any message.
+// a message is pushed to the client if it matches all the criteria of one of its subscriptions,
+// a criteria that is irrelevant for some message type (e.g. roles for validator messages) doesn't match it
+type Subscription struct {
+	// ID is assigned by the server, used to unsubscribe
+	ID string `json:"id,omitempty"`
+	// Types of the messages
+	Types []MessageType `json:"types,omitempty"`
+	// PublicKeys of validators, relevant for validator and decided messages
+	PublicKeys []string `json:"publicKeys,omitempty"`
+	// Operators public keys, relevant for operator, validator and decided messages
+	Operators []string `json:"operators,omitempty"`
+	// Roles of decided messages
+	Roles []DutyRole `json:"roles,omitempty"`
+}
+
+// subscriptionMessage is a subscribe/unsubscribe request of a stream client
+type subscriptionMessage struct {
+	Type MessageType   `json:"type"`
+	Data *Subscription `json:"data,omitempty"`
+}
+
+// streamMessage is a message that is being broadcast-ed, lazily resolving the data that is needed for matching
+type streamMessage struct {
+	msg *Message
+
+	lookup    OperatorsLookup
+	once      sync.Once
+	operators []string
+}
+
+// validatorOperators returns the operators of the validator of a decided message
+func (sm *streamMessage) validatorOperators() []string {
+	sm.once.Do(func() {
+		if sm.lookup != nil {
+			sm.operators = sm.lookup(sm.msg.Filter.PublicKey)
+		}
+	})
+	return sm.operators
+}
+
+// subscriptions manages the subscriptions of a stream connection
+type subscriptions struct {
+	lock   sync.RWMutex
+	subs   map[string]*Subscription
+	nextID int
+	// filtered is set once the connection subscribes, from that point only subscribed messages are pushed
+	filtered bool
+}
+
+func newSubscriptions() *subscriptions {
+	return &subscriptions{
+		subs: make(map[string]*Subscription),
+	}
+}
+
+// add validates the given subscription and adds it
+func (s *subscriptions) add(sub *Subscription) (*Subscription, *restError) {
+	if sub == nil {
+		return nil, badRequest("missing subscription")
+	}
+	normalized, err := normalizeSubscription(sub)
+	if err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.subs) >= maxSubscriptions {
+		return nil, badRequest("too many subscriptions")
+	}
+	s.nextID++
+	normalized.ID = strconv.Itoa(s.nextID)
+	s.subs[normalized.ID] = normalized
+	s.filtered = true
+	return normalized, nil
+}
+
+// remove removes the subscription with the given id, or all the subscriptions if an id was not provided
+func (s *subscriptions) remove(id string) *restError {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(id) == 0 {
+		s.subs = make(map[string]*Subscription)
+		return nil
+	}
+	if _, ok := s.subs[id]; !ok {
+		return badRequest("unknown subscription")
+	}
+	delete(s.subs, id)
+	return nil
+}
+
+// match returns whether the given message should be pushed, a connection that never subscribed gets all messages
+// while a connection that removed all of its subscriptions gets none
+func (s *subscriptions) match(sm *streamMessage) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if !s.filtered {
+		return true
+	}
+	for _, sub := range s.subs {
+		if sub.match(sm) {
+			return true
+		}
+	}
+	return false
+}
+
+// handle processes a subscription message of the client and returns the response
+func (s *subscriptions) handle(raw []byte) Message {
+	var sm subscriptionMessage
+	if err := json.Unmarshal(raw, &sm); err != nil {
+		return Message{Type: TypeError, Data: []string{"bad request - could not parse message"}}
+	}
+	switch sm.Type {
+	case TypeSubscribe:
+		sub, err := s.add(sm.Data)
+		if err != nil {
+			return Message{Type: TypeError, Data: err.errs}
+		}
+		return Message{Type: TypeSubscribe, Data: sub}
+	case TypeUnsubscribe:
+		id := ""
+		if sm.Data != nil {
+			id = sm.Data.ID
+		}
+		if err := s.remove(id); err != nil {
+			return Message{Type: TypeError, Data: err.errs}
+		}
+		return Message{Type: TypeUnsubscribe, Data: &Subscription{ID: id}}
+	}
+	return Message{Type: TypeError, Data: []string{"bad request - unknown message type"}}
+}
+
+func (sub *Subscription) match(sm *streamMessage) bool {
+	msg := sm.msg
+	if len(sub.Types) > 0 && !containsType(sub.Types, msg.Type) {
+		return false
+	}
+	if len(sub.PublicKeys) > 0 && !containsAny(sub.PublicKeys, messageValidators(msg)...) {
+		return false
+	}
+	if len(sub.Roles) > 0 && (msg.Type != TypeDecided || !containsRole(sub.Roles, msg.Filter.Role)) {
+		return false
+	}
+	if len(sub.Operators) > 0 {
+		operators := messageOperators(msg)
+		if msg.Type == TypeDecided {
+			operators = sm.validatorOperators()
+		}
+		if !containsAny(sub.Operators, operators...) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeSubscription validates the given subscription and returns a normalized copy
+func normalizeSubscription(sub *Subscription) (*Subscription, *restError) {
+	res := &Subscription{Operators: sub.Operators}
+	for _, t := range sub.Types {
+		switch t {
+		case TypeValidator, TypeOperator, TypeDecided, TypeAccount:
+			res.Types = append(res.Types, t)
+		default:
+			return nil, badRequest("unknown message type")
+		}
+	}
+	for _, pk := range sub.PublicKeys {
+		pk, err := parseValidatorPublicKey(pk)
+		if err != nil {
+			return nil, err
+		}
+		res.PublicKeys = append(res.PublicKeys, pk)
+	}
+	for _, role := range sub.Roles {
+		// an empty role is the default role
+		if len(role) == 0 {
+			return nil, badRequest("unknown role")
+		}
+		role, err := parseRole(string(role))
+		if err != nil {
+			return nil, err
+		}
+		res.Roles = append(res.Roles, role)
+	}
+	return res, nil
+}
+
+// messageValidators returns the public keys of the validators of the given message
+func messageValidators(msg *Message) []string {
+	switch msg.Type {
+	case TypeDecided:
+		return []string{strings.ToLower(msg.Filter.PublicKey)}
+	case TypeValidator:
+		if validators, ok := msg.Data.([]storage.ValidatorInformation); ok {
+			var pks []string
+			for _, v := range validators {
+				pks = append(pks, strings.ToLower(v.PublicKey))
+			}
+			return pks
+		}
+	}
+	return nil
+}
+
+// messageOperators returns the public keys of the operators of the given registry message
+func messageOperators(msg *Message) []string {
+	var pks []string
+	switch data := msg.Data.(type) {
+	case []registrystorage.OperatorInformation:
+		for _, o := range data {
+			pks = append(pks, o.PublicKey)
+		}
+	case []storage.ValidatorInformation:
+		for _, v := range data {
+			for _, o := range v.Operators {
+				pks = append(pks, o.PublicKey)
+			}
+		}
+	}
+	return pks
+}
+
+func containsAny(values []string, candidates ...string) bool {
+	for _, c := range candidates {
+		for _, v := range values {
+			if v == c {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsType(types []MessageType, t MessageType) bool {
+	for _, tt := range types {
+		if tt == t {
+			return true
+		}
+	}
+	return false
+}
+
+func containsRole(roles []DutyRole, role DutyRole) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
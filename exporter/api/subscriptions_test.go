@@ -0,0 +1,104 @@
+package api
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/bloxapp/ssv/exporter/storage"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptions_Handle(t *testing.T) {
+	subs := newSubscriptions()
+	handle := func(raw string) Message {
+		return subs.handle([]byte(raw))
+	}
+	// a connection that never subscribed gets all messages
+	require.True(t, subs.match(&streamMessage{msg: &Message{Type: TypeDecided}}))
+
+	res := handle(`{"type":"subscribe","data":{"types":["decided"],"publicKeys":["0x` + strings.ToUpper(testValidatorPK) + `"],"roles":["attester"]}}`)
+	require.Equal(t, TypeSubscribe, res.Type)
+	sub := res.Data.(*Subscription)
+	require.Equal(t, "1", sub.ID)
+	require.Equal(t, []string{testValidatorPK}, sub.PublicKeys)
+	require.Equal(t, []DutyRole{RoleAttester}, sub.Roles)
+
+	// the response is encoded with the subscription id
+	raw, err := json.Marshal(&res)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"id":"1"`)
+
+	bad := []string{
+		`{"type":"subscribe"}`,
+		`{"type":"subscribe","data":{"types":["unknown"]}}`,
+		`{"type":"subscribe","data":{"publicKeys":["xyz"]}}`,
+		`{"type":"subscribe","data":{"roles":[""]}}`,
+		`{"type":"unsubscribe","data":{"id":"2"}}`,
+		`{"type":"query"}`,
+		`not json`,
+	}
+	for _, msg := range bad {
+		res := handle(msg)
+		require.Equal(t, TypeError, res.Type, msg)
+		require.True(t, strings.HasPrefix(res.Data.([]string)[0], "bad request"), msg)
+	}
+
+	res = handle(`{"type":"unsubscribe","data":{"id":"1"}}`)
+	require.Equal(t, TypeUnsubscribe, res.Type)
+	require.Len(t, subs.subs, 0)
+	// no messages are pushed once all the subscriptions were removed
+	require.False(t, subs.match(&streamMessage{msg: &Message{Type: TypeDecided}}))
+
+	for i := 0; i < maxSubscriptions; i++ {
+		require.Equal(t, TypeSubscribe, handle(`{"type":"subscribe","data":{}}`).Type)
+	}
+	require.Equal(t, TypeError, handle(`{"type":"subscribe","data":{}}`).Type)
+	// unsubscribe from all
+	require.Equal(t, TypeUnsubscribe, handle(`{"type":"unsubscribe"}`).Type)
+	require.Len(t, subs.subs, 0)
+}
+
+func TestSubscription_Match(t *testing.T) {
+	validatorMsg := &Message{Type: TypeValidator, Data: []storage.ValidatorInformation{{
+		PublicKey: testValidatorPK,
+		Operators: []storage.OperatorNodeLink{{ID: 1, PublicKey: "operator-1"}},
+	}}}
+	operatorMsg := &Message{Type: TypeOperator, Data: []registrystorage.OperatorInformation{{PublicKey: "operator-2"}}}
+	decidedMsg := &Message{Type: TypeDecided, Filter: MessageFilter{PublicKey: testValidatorPK, Role: RoleAttester}}
+	accountMsg := &Message{Type: TypeAccount}
+	lookup := func(validatorPubKey string) []string {
+		if validatorPubKey == testValidatorPK {
+			return []string{"operator-1"}
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		sub     Subscription
+		matches []*Message
+	}{
+		{"empty", Subscription{}, []*Message{validatorMsg, operatorMsg, decidedMsg, accountMsg}},
+		{"types", Subscription{Types: []MessageType{TypeOperator, TypeAccount}}, []*Message{operatorMsg, accountMsg}},
+		{"validator", Subscription{PublicKeys: []string{testValidatorPK}}, []*Message{validatorMsg, decidedMsg}},
+		{"operator", Subscription{Operators: []string{"operator-1"}}, []*Message{validatorMsg, decidedMsg}},
+		{"operator registry", Subscription{Operators: []string{"operator-2"}}, []*Message{operatorMsg}},
+		{"role", Subscription{Roles: []DutyRole{RoleAttester}}, []*Message{decidedMsg}},
+		{"other role", Subscription{Roles: []DutyRole{RoleProposer}}, nil},
+		{"validator and type", Subscription{PublicKeys: []string{testValidatorPK}, Types: []MessageType{TypeValidator}}, []*Message{validatorMsg}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, msg := range []*Message{validatorMsg, operatorMsg, decidedMsg, accountMsg} {
+				expected := false
+				for _, m := range test.matches {
+					expected = expected || m == msg
+				}
+				require.Equal(t, expected, test.sub.match(&streamMessage{msg: msg, lookup: lookup}), msg.Type)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package ibft
 
 import (
 	"encoding/hex"
+	exporterstorage "github.com/bloxapp/ssv/exporter/storage"
 	ibftinstance "github.com/bloxapp/ssv/ibft/instance"
 	"github.com/bloxapp/ssv/ibft/pipeline/auth"
 	"github.com/bloxapp/ssv/ibft/proto"
@@ -20,16 +21,19 @@ type CommitReaderOptions struct {
 	Network          network.Network
 	ValidatorStorage validatorstorage.ICollection
 	IbftStorage      collections.Iibft
+	SignersStorage   exporterstorage.SignersCollection
 	Out              *event.Feed
 }
 
 // commitReader responsible for reading all commit messages
-// it will try to aggregate existing decided message to make sure all participating operators are listed
+// it will try to aggregate existing decided message to make sure all participating operators are listed,
+// operators that committed after the instance was decided are recorded as late signers
 type commitReader struct {
 	logger           *zap.Logger
 	network          network.Network
 	validatorStorage validatorstorage.ICollection
 	ibftStorage      collections.Iibft
+	signersStorage   exporterstorage.SignersCollection
 	out              *event.Feed
 }
 
@@ -40,6 +44,7 @@ func NewCommitReader(opts CommitReaderOptions) Reader {
 		network:          opts.Network,
 		validatorStorage: opts.ValidatorStorage,
 		ibftStorage:      opts.IbftStorage,
+		signersStorage:   opts.SignersStorage,
 		out:              opts.Out,
 	}
 	return r
@@ -103,6 +108,12 @@ func (cr *commitReader) onCommitMessage(msg *proto.SignedMessage) error {
 	}
 	if updated != nil {
 		logger.Debug("decided message was updated")
+		if cr.signersStorage != nil {
+			if err := cr.signersStorage.SaveLateSigners(msg.Message.Lambda, msg.Message.SeqNumber,
+				committeeIDs(share), msg.SignerIds); err != nil {
+				return errors.Wrap(err, "could not save late signers")
+			}
+		}
 		go cr.out.Send(newDecidedAPIMsg(updated, pkHex))
 	}
 	return nil
@@ -3,6 +3,7 @@ package ibft
 import (
 	"github.com/bloxapp/ssv/beacon"
 	"github.com/bloxapp/ssv/exporter/api"
+	exporterstorage "github.com/bloxapp/ssv/exporter/storage"
 	"github.com/bloxapp/ssv/ibft/proto"
 	ibftsync "github.com/bloxapp/ssv/ibft/sync"
 	ssvstorage "github.com/bloxapp/ssv/storage"
@@ -117,6 +118,11 @@ func TestCommitReader_onCommitMessage(t *testing.T) {
 				require.Nil(t, err)
 				require.True(t, found)
 				require.Equal(t, 4, len(updated.SignerIds))
+				records, err := cr.signersStorage.GetSignersRecords([]byte(identifier), 1, 1)
+				require.NoError(t, err)
+				require.Equal(t, map[uint64]*exporterstorage.SignersRecord{
+					1: {Committee: []uint64{1, 2, 3, 4}, LateSigners: []uint64{4}},
+				}, records)
 			},
 		},
 		{
@@ -212,6 +218,7 @@ func setupReaderForTest(t *testing.T) Reader {
 		Network:          nil,
 		ValidatorStorage: validatorStorage,
 		IbftStorage:      &ibftStorage,
+		SignersStorage:   exporterstorage.NewExporterStorage(db, logger),
 		Out:              new(event.Feed),
 	})
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/bloxapp/ssv/beacon"
 	"github.com/bloxapp/ssv/exporter/api"
+	exporterstorage "github.com/bloxapp/ssv/exporter/storage"
 	"github.com/bloxapp/ssv/ibft"
 	ibftctl "github.com/bloxapp/ssv/ibft/controller"
 	"github.com/bloxapp/ssv/ibft/pipeline"
@@ -21,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/async/event"
 	"go.uber.org/zap"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -33,6 +35,8 @@ type DecidedReaderOptions struct {
 	Network        network.Network
 	Config         *proto.InstanceConfig
 	ValidatorShare *storage.Share
+	// SignersStorage is used to persist the committee of new decided instances
+	SignersStorage exporterstorage.SignersCollection
 
 	Out *event.Feed
 }
@@ -45,6 +49,7 @@ type decidedReader struct {
 
 	config         *proto.InstanceConfig
 	validatorShare *storage.Share
+	signersStorage exporterstorage.SignersCollection
 
 	out *event.Feed
 
@@ -65,6 +70,7 @@ func newDecidedReader(opts DecidedReaderOptions) Reader {
 		network:        opts.Network,
 		config:         opts.Config,
 		validatorShare: opts.ValidatorShare,
+		signersStorage: opts.SignersStorage,
 		out:            opts.Out,
 		identifier: []byte(format.IdentifierFormat(opts.ValidatorShare.PublicKey.Serialize(),
 			beacon.RoleTypeAttester.String())),
@@ -177,6 +183,12 @@ func (r *decidedReader) handleNewDecidedMessage(msg *proto.SignedMessage) (bool,
 		return false, errors.Wrap(err, "could not save decided")
 	}
 	logger.Debug("decided saved")
+	if r.signersStorage != nil {
+		if err := r.signersStorage.SaveCommittee(msg.Message.Lambda, msg.Message.SeqNumber,
+			committeeIDs(r.validatorShare)); err != nil {
+			logger.Warn("could not save committee", zap.Error(err))
+		}
+	}
 	ibft.ReportDecided(r.validatorShare.PublicKey.SerializeToHexStr(), msg)
 	go r.out.Send(newDecidedAPIMsg(msg, r.validatorShare.PublicKey.SerializeToHexStr()))
 	return true, r.checkHighestDecided(msg)
@@ -267,6 +279,18 @@ func validateMsg(msg *proto.SignedMessage, identifier []byte) error {
 	return p.Run(msg)
 }
 
+// committeeIDs returns the sorted node ids of the committee of the given share
+func committeeIDs(share *storage.Share) []uint64 {
+	ids := make([]uint64, 0, len(share.Committee))
+	for id := range share.Committee {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
 func newDecidedAPIMsg(msg *proto.SignedMessage, pk string) api.Message {
 	return api.Message{
 		Type: api.TypeDecided,
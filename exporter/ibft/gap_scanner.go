@@ -0,0 +1,489 @@
+package ibft
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/ibft/proto"
+	ibftsync "github.com/bloxapp/ssv/ibft/sync"
+	"github.com/bloxapp/ssv/network"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/collections"
+	"github.com/bloxapp/ssv/utils/format"
+	"github.com/bloxapp/ssv/validator/storage"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultGapScanInterval is the default interval between scans
+	defaultGapScanInterval = 30 * time.Minute
+	// gapScanBatchSize is the max number of decided messages that are read from storage at once
+	gapScanBatchSize = 512
+	// maxReportedGaps is the max number of gaps that are kept in the coverage of a validator
+	maxReportedGaps = 32
+)
+
+// GapScannerOptions defines the required parameters to create a gap scanner
+type GapScannerOptions struct {
+	Logger           *zap.Logger
+	Storage          collections.Iibft
+	Network          network.Network
+	ValidatorStorage storage.ICollection
+	// DB is used to persist the scan state of each identifier, so contiguous sequences are not scanned again
+	DB basedb.IDb
+	// ShouldScan returns whether the validator with the given public key (hex) should be scanned
+	ShouldScan func(pubKey string) bool
+	// Interval between scans
+	Interval time.Duration
+}
+
+// Gap is a range of missing sequence numbers
+type Gap struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// Coverage is the state of the stored decided messages of a validator, as of the last scan
+type Coverage struct {
+	PublicKey string `json:"publicKey"`
+	// HighestSeq is the highest known decided sequence
+	HighestSeq uint64 `json:"highestSeq"`
+	// Stored is the number of stored decided messages up to the highest sequence
+	Stored uint64 `json:"stored"`
+	// Missing is the number of sequences that are still missing after backfill
+	Missing uint64 `json:"missing"`
+	// HistoryStart is the lowest sequence that is kept by peers, lower sequences were pruned and are not counted
+	HistoryStart uint64 `json:"historyStart,omitempty"`
+	// Backfilled is the number of decided messages that were fetched from peers in the last scan
+	Backfilled uint64 `json:"backfilled"`
+	// Gaps are the first ranges of missing sequences
+	Gaps []Gap `json:"gaps,omitempty"`
+	// ScannedAt is the unix time of the last scan
+	ScannedAt int64 `json:"scannedAt"`
+}
+
+// GapScanner scans stored decided messages for missing sequences and backfills them from peers
+type GapScanner interface {
+	// Start scans periodically until the given context is done
+	Start(ctx context.Context)
+	// Scan scans and backfills all the relevant validators
+	Scan() error
+	// Coverage returns the coverage of the given validator, or of all validators if public key was not provided
+	Coverage(pubKey string) []Coverage
+}
+
+type gapScanner struct {
+	logger           *zap.Logger
+	storage          collections.Iibft
+	network          network.Network
+	validatorStorage storage.ICollection
+	db               basedb.IDb
+	shouldScan       func(pubKey string) bool
+	interval         time.Duration
+
+	// scanLock prevents concurrent scans
+	scanLock     sync.Mutex
+	coverageLock sync.RWMutex
+	coverage     map[string]Coverage
+}
+
+// NewGapScanner creates a new instance of GapScanner
+func NewGapScanner(opts GapScannerOptions) GapScanner {
+	s := gapScanner{
+		logger:           opts.Logger.With(zap.String("component", "exporter/gapScanner")),
+		storage:          opts.Storage,
+		network:          opts.Network,
+		validatorStorage: opts.ValidatorStorage,
+		db:               opts.DB,
+		shouldScan:       opts.ShouldScan,
+		interval:         opts.Interval,
+		coverage:         make(map[string]Coverage),
+	}
+	if s.interval == 0 {
+		s.interval = defaultGapScanInterval
+	}
+	return &s
+}
+
+// Start scans periodically until the given context is done,
+// the first scan is done after an interval to let the decided readers complete their initial sync
+func (s *gapScanner) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := s.Scan(); err != nil {
+			s.logger.Warn("could not scan decided messages", zap.Error(err))
+		}
+	}
+}
+
+// Scan scans and backfills the decided messages of all relevant validators
+func (s *gapScanner) Scan() error {
+	s.scanLock.Lock()
+	defer s.scanLock.Unlock()
+
+	shares, err := s.validatorStorage.GetAllValidatorShares()
+	if err != nil {
+		return errors.Wrap(err, "could not get validators shares")
+	}
+	var relevant []*storage.Share
+	for _, share := range shares {
+		if share.HasMetadata() && (s.shouldScan == nil || s.shouldScan(share.PublicKey.SerializeToHexStr())) {
+			relevant = append(relevant, share)
+		}
+	}
+	s.removeIrrelevant(relevant)
+	start := time.Now()
+	metricsGapScanProgress.Set(0)
+	for i, share := range relevant {
+		pubKey := share.PublicKey.SerializeToHexStr()
+		cov, err := s.scanValidator(share)
+		if err != nil {
+			s.logger.Warn("could not scan validator", zap.String("pubKey", pubKey), zap.Error(err))
+		} else {
+			s.setCoverage(cov)
+		}
+		metricsGapScanProgress.Set(float64(i+1) / float64(len(relevant)))
+	}
+	metricsGapScanProgress.Set(1)
+	s.logger.Debug("scanned decided messages", zap.Int("validators", len(relevant)),
+		zap.Duration("took", time.Since(start)))
+	return nil
+}
+
+// scanValidator finds the gaps of the given validator and tries to fill them,
+// only sequences above the persisted watermark of the validator are scanned
+func (s *gapScanner) scanValidator(share *storage.Share) (*Coverage, error) {
+	pubKey := share.PublicKey.SerializeToHexStr()
+	identifier := []byte(format.IdentifierFormat(share.PublicKey.Serialize(), beacon.RoleTypeAttester.String()))
+	cov := &Coverage{PublicKey: pubKey, ScannedAt: time.Now().Unix()}
+	highest, found, err := s.storage.GetHighestDecidedInstance(identifier)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read highest decided")
+	}
+	if !found {
+		return cov, nil
+	}
+	cov.HighestSeq = highest.Message.SeqNumber
+	state, err := s.getScanState(identifier)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read scan state")
+	}
+	gaps, err := s.findGaps(identifier, state.Watermark, cov.HighestSeq)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not find gaps")
+	}
+	gaps = trimGaps(gaps, state.HistoryStart)
+	if len(gaps) > 0 {
+		var prunedBelow uint64
+		cov.Backfilled, prunedBelow = s.backfill(share, identifier, gaps)
+		if prunedBelow > state.HistoryStart {
+			state.HistoryStart = prunedBelow
+		}
+		if cov.Backfilled > 0 {
+			if gaps, err = s.findGaps(identifier, state.Watermark, cov.HighestSeq); err != nil {
+				return nil, errors.Wrap(err, "could not find gaps")
+			}
+		}
+		gaps = trimGaps(gaps, state.HistoryStart)
+	}
+	// sequences are contiguous up to the first gap
+	state.Watermark = cov.HighestSeq + 1
+	if len(gaps) > 0 {
+		state.Watermark = gaps[0].From
+	}
+	if err := s.saveScanState(identifier, state); err != nil {
+		return nil, errors.Wrap(err, "could not save scan state")
+	}
+	for _, gap := range gaps {
+		cov.Missing += gap.To - gap.From + 1
+	}
+	if len(gaps) > maxReportedGaps {
+		gaps = gaps[:maxReportedGaps]
+	}
+	cov.Gaps = gaps
+	cov.HistoryStart = state.HistoryStart
+	if total := cov.HighestSeq + 1; total > state.HistoryStart+cov.Missing {
+		cov.Stored = total - state.HistoryStart - cov.Missing
+	}
+	return cov, nil
+}
+
+// findGaps walks the stored decided messages of the given identifier from the given sequence up to the highest,
+// and returns the ranges of missing sequences
+func (s *gapScanner) findGaps(identifier []byte, from, highest uint64) ([]Gap, error) {
+	var gaps []Gap
+	// next is the next expected sequence
+	next := from
+	for ; from <= highest; from += gapScanBatchSize {
+		to := from + gapScanBatchSize - 1
+		if to > highest {
+			to = highest
+		}
+		msgs, err := s.storage.GetDecidedInRange(identifier, from, to)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read decided messages")
+		}
+		sort.Slice(msgs, func(i, j int) bool {
+			return msgs[i].Message.SeqNumber < msgs[j].Message.SeqNumber
+		})
+		for _, msg := range msgs {
+			seq := msg.Message.SeqNumber
+			if seq < next {
+				continue
+			}
+			if seq > next {
+				gaps = append(gaps, Gap{From: next, To: seq - 1})
+			}
+			next = seq + 1
+		}
+	}
+	if next <= highest {
+		gaps = append(gaps, Gap{From: next, To: highest})
+	}
+	return gaps, nil
+}
+
+// trimGaps removes the sequences below the given history start from the given gaps
+func trimGaps(gaps []Gap, historyStart uint64) []Gap {
+	res := make([]Gap, 0, len(gaps))
+	for _, gap := range gaps {
+		if gap.To < historyStart {
+			continue
+		}
+		if gap.From < historyStart {
+			gap.From = historyStart
+		}
+		res = append(res, gap)
+	}
+	return res
+}
+
+// backfill fetches the given gaps in batches, rotating the peers of the validator's topic.
+// a batch is requested from the next peer if the previous one failed.
+// returns the number of saved messages and the sequence below which peers pruned the history (0 if not pruned)
+func (s *gapScanner) backfill(share *storage.Share, identifier []byte, gaps []Gap) (uint64, uint64) {
+	logger := s.logger.With(zap.String("pubKey", share.PublicKey.SerializeToHexStr()))
+	peers, err := ibftsync.GetPeers(s.network, share.PublicKey.Serialize(), share.CommitteeSize())
+	if err != nil || len(peers) == 0 {
+		logger.Debug("could not find peers for backfill", zap.Error(err))
+		return 0, 0
+	}
+	maxBatch := s.network.MaxBatch()
+	if maxBatch == 0 {
+		maxBatch = 1
+	}
+	var saved, historyStart uint64
+	p := 0
+	for _, gap := range gaps {
+		for from := gap.From; from <= gap.To; from += maxBatch {
+			to := from + maxBatch - 1
+			if to > gap.To {
+				to = gap.To
+			}
+			if to < historyStart {
+				// pruned by peers
+				continue
+			}
+			n, prunedBelow, err := s.fetchRange(share, identifier, peers, p, from, to)
+			if prunedBelow > historyStart {
+				logger.Debug("decided history was pruned by peers", zap.Uint64("prunedBelow", prunedBelow))
+				historyStart = prunedBelow
+			}
+			if err != nil {
+				metricsBackfillFailures.Inc()
+				logger.Debug("could not backfill range", zap.Uint64("from", from),
+					zap.Uint64("to", to), zap.Error(err))
+			}
+			saved += n
+			p = (p + 1) % len(peers)
+		}
+	}
+	return saved, historyStart
+}
+
+// fetchRange requests the given range from the peers, starting from the given index.
+// sequences that are missing or invalid in some response are requested from the next peer.
+// sequences that were pruned by peers are not considered a failure,
+// the highest sequence below which peers pruned the history is returned
+func (s *gapScanner) fetchRange(share *storage.Share, identifier []byte, peers []string, start int, from, to uint64) (uint64, uint64, error) {
+	pending := make(map[uint64]bool)
+	for seq := from; seq <= to; seq++ {
+		pending[seq] = true
+	}
+	var saved, prunedBelow uint64
+	var lastErr error
+	for i := 0; i < len(peers) && len(pending) > 0; i++ {
+		peer := peers[(start+i)%len(peers)]
+		if prunedBelow > from {
+			from = prunedBelow
+		}
+		if from > to {
+			break
+		}
+		res, err := s.network.GetDecidedByRange(peer, &network.SyncMessage{
+			Lambda: identifier,
+			Params: []uint64{from, to},
+			Type:   network.Sync_GetInstanceRange,
+		})
+		if err != nil {
+			lastErr = errors.Wrap(err, "could not get decided range")
+			continue
+		}
+		if res.Error == network.DecidedPrunedError && len(res.Params) == 1 {
+			if res.Params[0] > prunedBelow {
+				prunedBelow = res.Params[0]
+				// request the kept part of the range from the same peer
+				i--
+			}
+			continue
+		}
+		if len(res.Error) > 0 {
+			lastErr = errors.New(res.Error)
+			continue
+		}
+		var valid []*proto.SignedMessage
+		for _, msg := range res.SignedMessages {
+			if err := validateMsg(msg, identifier); err != nil {
+				continue
+			}
+			if !pending[msg.Message.SeqNumber] {
+				continue
+			}
+			if err := validateDecidedMsg(msg, share); err != nil {
+				continue
+			}
+			valid = append(valid, msg)
+		}
+		if len(valid) == 0 {
+			continue
+		}
+		if err := s.storage.SaveDecidedMessages(valid); err != nil {
+			return saved, prunedBelow, errors.Wrap(err, "could not save decided messages")
+		}
+		for _, msg := range valid {
+			delete(pending, msg.Message.SeqNumber)
+		}
+		saved += uint64(len(valid))
+		metricsBackfilledDecided.Add(float64(len(valid)))
+	}
+	for seq := range pending {
+		if seq < prunedBelow {
+			delete(pending, seq)
+		}
+	}
+	if len(pending) > 0 {
+		if lastErr == nil {
+			lastErr = errors.New("no valid decided messages from peers")
+		}
+		return saved, prunedBelow, errors.Wrapf(lastErr, "%d sequences are still missing", len(pending))
+	}
+	return saved, prunedBelow, nil
+}
+
+// gapScanPrefix holds the scan state of each identifier
+func gapScanPrefix() []byte {
+	return []byte("exporter-ibft/gap_scan/")
+}
+
+// scanState is the persisted scan state of an identifier
+type scanState struct {
+	// Watermark is the sequence up to which (exclusive) the stored decided messages are contiguous
+	Watermark uint64 `json:"watermark"`
+	// HistoryStart is the lowest sequence that is kept by peers
+	HistoryStart uint64 `json:"historyStart"`
+}
+
+// getScanState returns the persisted scan state of the given identifier, or an empty state if not found
+func (s *gapScanner) getScanState(identifier []byte) (*scanState, error) {
+	state := &scanState{}
+	if s.db == nil {
+		return state, nil
+	}
+	obj, found, err := s.db.Get(gapScanPrefix(), identifier)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return state, nil
+	}
+	if err := json.Unmarshal(obj.Value, state); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal scan state")
+	}
+	return state, nil
+}
+
+// saveScanState persists the scan state of the given identifier
+func (s *gapScanner) saveScanState(identifier []byte, state *scanState) error {
+	if s.db == nil {
+		return nil
+	}
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal scan state")
+	}
+	return s.db.Set(gapScanPrefix(), identifier, raw)
+}
+
+func (s *gapScanner) setCoverage(cov *Coverage) {
+	s.coverageLock.Lock()
+	defer s.coverageLock.Unlock()
+
+	s.coverage[cov.PublicKey] = *cov
+	metricsDecidedMissing.WithLabelValues(cov.PublicKey).Set(float64(cov.Missing))
+	ratio := float64(1)
+	if total := cov.Stored + cov.Missing; total > 0 {
+		ratio = float64(cov.Stored) / float64(total)
+	}
+	metricsDecidedCoverage.WithLabelValues(cov.PublicKey).Set(ratio)
+}
+
+// removeIrrelevant removes the coverage and the metrics of validators that are no longer scanned, e.g. removed validators
+func (s *gapScanner) removeIrrelevant(relevant []*storage.Share) {
+	s.coverageLock.Lock()
+	defer s.coverageLock.Unlock()
+
+	isRelevant := make(map[string]bool, len(relevant))
+	for _, share := range relevant {
+		isRelevant[share.PublicKey.SerializeToHexStr()] = true
+	}
+	for pubKey := range s.coverage {
+		if isRelevant[pubKey] {
+			continue
+		}
+		delete(s.coverage, pubKey)
+		metricsDecidedMissing.DeleteLabelValues(pubKey)
+		metricsDecidedCoverage.DeleteLabelValues(pubKey)
+	}
+}
+
+// Coverage returns the coverage of the given validator, or of all validators (sorted by public key)
+func (s *gapScanner) Coverage(pubKey string) []Coverage {
+	s.coverageLock.RLock()
+	defer s.coverageLock.RUnlock()
+
+	if len(pubKey) > 0 {
+		if cov, ok := s.coverage[pubKey]; ok {
+			return []Coverage{cov}
+		}
+		return []Coverage{}
+	}
+	res := make([]Coverage, 0, len(s.coverage))
+	for _, cov := range s.coverage {
+		res = append(res, cov)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].PublicKey < res[j].PublicKey
+	})
+	return res
+}
@@ -0,0 +1,202 @@
+package ibft
+
+import (
+	"testing"
+
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/ibft/proto"
+	ibftsync "github.com/bloxapp/ssv/ibft/sync"
+	"github.com/bloxapp/ssv/network"
+	ssvstorage "github.com/bloxapp/ssv/storage"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/collections"
+	"github.com/bloxapp/ssv/utils/format"
+	validatorstorage "github.com/bloxapp/ssv/validator/storage"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGapScanner_Scan(t *testing.T) {
+	sks, committee := ibftsync.GenerateNodes(4)
+	validatorSk := &bls.SecretKey{}
+	validatorSk.SetByCSPRNG()
+	pk := validatorSk.GetPublicKey()
+	identifier := []byte(format.IdentifierFormat(pk.Serialize(), beacon.RoleTypeAttester.String()))
+
+	db, err := ssvstorage.GetStorageFactory(basedb.Options{
+		Type:   "badger-memory",
+		Logger: zap.L(),
+	})
+	require.NoError(t, err)
+	defer db.Close()
+	validatorStorage := validatorstorage.NewCollection(validatorstorage.CollectionOptions{DB: db, Logger: zap.L()})
+	require.NoError(t, validatorStorage.SaveValidatorShare(&validatorstorage.Share{
+		NodeID:    1,
+		PublicKey: pk,
+		Committee: committee,
+		Metadata:  &beacon.ValidatorMetadata{},
+	}))
+	ibftStorage := collections.NewIbft(db, zap.L(), "attestation")
+
+	var decided []*proto.SignedMessage
+	for seq := uint64(0); seq <= 10; seq++ {
+		decided = append(decided, ibftsync.MultiSignMsg(t, []uint64{1, 2, 3}, sks, &proto.Message{
+			Type:      proto.RoundState_Commit,
+			Round:     1,
+			Lambda:    identifier,
+			SeqNumber: seq,
+			Value:     []byte("value"),
+		}))
+	}
+	// 2-4 and 7 are missing, 9 is missing in all peers
+	for _, seq := range []uint64{0, 1, 5, 6, 8, 10} {
+		require.NoError(t, ibftStorage.SaveDecided(decided[seq]))
+	}
+	require.NoError(t, ibftStorage.SaveHighestDecidedInstance(decided[10]))
+
+	// an invalid message of the first peer should be ignored
+	invalid := *decided[3]
+	invalid.Signature = []byte("dummy")
+	peerDecided := append(append([]*proto.SignedMessage{}, decided[:9]...), decided[10])
+	net := ibftsync.NewTestNetwork(t, []string{"p1", "p2", "p3"}, 2, nil, nil,
+		map[string][]*proto.SignedMessage{
+			"p1": {decided[2], &invalid},
+			"p2": peerDecided,
+		}, nil, nil, func(s string) network.SyncStream {
+			return ibftsync.NewTestStream(s)
+		})
+
+	scanner := NewGapScanner(GapScannerOptions{
+		Logger:           zap.L(),
+		Storage:          &ibftStorage,
+		Network:          net,
+		ValidatorStorage: validatorStorage,
+		DB:               db,
+	})
+	require.NoError(t, scanner.Scan())
+
+	cov := scanner.Coverage(pk.SerializeToHexStr())
+	require.Len(t, cov, 1)
+	require.Equal(t, uint64(10), cov[0].HighestSeq)
+	require.Equal(t, uint64(4), cov[0].Backfilled)
+	require.Equal(t, uint64(1), cov[0].Missing)
+	require.Equal(t, uint64(10), cov[0].Stored)
+	require.Equal(t, []Gap{{From: 9, To: 9}}, cov[0].Gaps)
+
+	for _, seq := range []uint64{2, 3, 4, 7} {
+		msg, found, err := ibftStorage.GetDecided(identifier, seq)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, decided[seq].Signature, msg.Signature)
+	}
+
+	require.Len(t, scanner.Coverage(""), 1)
+	require.Len(t, scanner.Coverage("unknown"), 0)
+
+	// sequences are contiguous up to the missing one
+	state, err := scanner.(*gapScanner).getScanState(identifier)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), state.Watermark)
+
+	// removed validators are not reported
+	require.NoError(t, validatorStorage.DeleteValidatorShare(pk.Serialize()))
+	require.NoError(t, scanner.Scan())
+	require.Len(t, scanner.Coverage(""), 0)
+}
+
+func TestGapScanner_ScanPruned(t *testing.T) {
+	sks, committee := ibftsync.GenerateNodes(4)
+	validatorSk := &bls.SecretKey{}
+	validatorSk.SetByCSPRNG()
+	pk := validatorSk.GetPublicKey()
+	identifier := []byte(format.IdentifierFormat(pk.Serialize(), beacon.RoleTypeAttester.String()))
+
+	db, err := ssvstorage.GetStorageFactory(basedb.Options{
+		Type:   "badger-memory",
+		Logger: zap.L(),
+	})
+	require.NoError(t, err)
+	defer db.Close()
+	validatorStorage := validatorstorage.NewCollection(validatorstorage.CollectionOptions{DB: db, Logger: zap.L()})
+	require.NoError(t, validatorStorage.SaveValidatorShare(&validatorstorage.Share{
+		NodeID:    1,
+		PublicKey: pk,
+		Committee: committee,
+		Metadata:  &beacon.ValidatorMetadata{},
+	}))
+	ibftStorage := collections.NewIbft(db, zap.L(), "attestation")
+
+	var decided []*proto.SignedMessage
+	for seq := uint64(0); seq <= 10; seq++ {
+		decided = append(decided, ibftsync.MultiSignMsg(t, []uint64{1, 2, 3}, sks, &proto.Message{
+			Type:      proto.RoundState_Commit,
+			Round:     1,
+			Lambda:    identifier,
+			SeqNumber: seq,
+			Value:     []byte("value"),
+		}))
+	}
+	// 0-5 are missing, peers pruned the history below 3
+	for _, seq := range []uint64{6, 7, 8, 9, 10} {
+		require.NoError(t, ibftStorage.SaveDecided(decided[seq]))
+	}
+	require.NoError(t, ibftStorage.SaveHighestDecidedInstance(decided[10]))
+
+	net := ibftsync.NewTestNetwork(t, []string{"p1", "p2"}, 2, nil, nil,
+		map[string][]*proto.SignedMessage{
+			"p1": decided[3:],
+			"p2": decided[3:],
+		}, nil, nil, func(s string) network.SyncStream {
+			return ibftsync.NewTestStream(s)
+		})
+	net.SetPrunedBelow("p1", 3)
+	net.SetPrunedBelow("p2", 3)
+
+	scanner := NewGapScanner(GapScannerOptions{
+		Logger:           zap.L(),
+		Storage:          &ibftStorage,
+		Network:          net,
+		ValidatorStorage: validatorStorage,
+		DB:               db,
+	})
+	require.NoError(t, scanner.Scan())
+
+	cov := scanner.Coverage(pk.SerializeToHexStr())
+	require.Len(t, cov, 1)
+	require.Equal(t, uint64(3), cov[0].Backfilled)
+	require.Equal(t, uint64(3), cov[0].HistoryStart)
+	require.Equal(t, uint64(0), cov[0].Missing)
+	require.Equal(t, uint64(8), cov[0].Stored)
+	require.Len(t, cov[0].Gaps, 0)
+
+	state, err := scanner.(*gapScanner).getScanState(identifier)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), state.Watermark)
+	require.Equal(t, uint64(3), state.HistoryStart)
+}
+
+func TestGapScanner_findGaps(t *testing.T) {
+	ibftStorage := ibftsync.TestingIbftStorage(t)
+	identifier := []byte("lambda")
+	s := &gapScanner{storage: &ibftStorage}
+	for _, seq := range []uint64{2, 3, 600, 1100} {
+		require.NoError(t, ibftStorage.SaveDecided(&proto.SignedMessage{
+			Message: &proto.Message{Lambda: identifier, SeqNumber: seq},
+		}))
+	}
+	gaps, err := s.findGaps(identifier, 0, 1200)
+	require.NoError(t, err)
+	require.Equal(t, []Gap{{0, 1}, {4, 599}, {601, 1099}, {1101, 1200}}, gaps)
+
+	gaps, err = s.findGaps(identifier, 0, 3)
+	require.NoError(t, err)
+	require.Equal(t, []Gap{{0, 1}}, gaps)
+
+	// scanning from a watermark
+	gaps, err = s.findGaps(identifier, 3, 1200)
+	require.NoError(t, err)
+	require.Equal(t, []Gap{{4, 599}, {601, 1099}, {1101, 1200}}, gaps)
+
+	require.Equal(t, []Gap{{3, 599}, {601, 1099}}, trimGaps([]Gap{{0, 1}, {2, 599}, {601, 1099}}, 3))
+}
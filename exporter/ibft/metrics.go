@@ -0,0 +1,49 @@
+package ibft
+
+import (
+	"log"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	metricsDecidedMissing = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ssv:exporter:decided_missing",
+		Help: "The number of missing decided sequences of a validator, as of the last gaps scan",
+	}, []string{"pubKey"})
+	metricsDecidedCoverage = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ssv:exporter:decided_coverage",
+		Help: "The ratio of stored decided sequences of a validator, as of the last gaps scan",
+	}, []string{"pubKey"})
+	metricsBackfilledDecided = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ssv:exporter:backfilled_decided",
+		Help: "Count decided messages that were fetched from peers to fill gaps",
+	})
+	metricsBackfillFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ssv:exporter:backfill_failures",
+		Help: "Count ranges of missing decided messages that could not be fetched from peers",
+	})
+	metricsGapScanProgress = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "ssv:exporter:gaps_scan_progress",
+		Help: "The ratio of validators that were scanned in the current gaps scan",
+	})
+)
+
+func init() {
+	if err := prometheus.Register(metricsDecidedMissing); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsDecidedCoverage); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsBackfilledDecided); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsBackfillFailures); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsGapScanProgress); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+}
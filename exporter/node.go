@@ -6,6 +6,7 @@ import (
 	"github.com/bloxapp/eth2-key-manager/core"
 	"github.com/bloxapp/ssv/beacon"
 	"github.com/bloxapp/ssv/eth1"
+	"github.com/bloxapp/ssv/exporter/analytics"
 	"github.com/bloxapp/ssv/exporter/api"
 	"github.com/bloxapp/ssv/exporter/ibft"
 	"github.com/bloxapp/ssv/exporter/storage"
@@ -13,6 +14,8 @@ import (
 	"github.com/bloxapp/ssv/ibft/proto"
 	"github.com/bloxapp/ssv/monitoring/metrics"
 	"github.com/bloxapp/ssv/network"
+	networkForks "github.com/bloxapp/ssv/network/forks"
+	"github.com/bloxapp/ssv/network/validation"
 	"github.com/bloxapp/ssv/storage/basedb"
 	"github.com/bloxapp/ssv/storage/collections"
 	"github.com/bloxapp/ssv/utils/tasks"
@@ -20,6 +23,7 @@ import (
 	validatorstorage "github.com/bloxapp/ssv/validator/storage"
 	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/time/slots"
 	"go.uber.org/zap"
 	"strconv"
 	"sync"
@@ -48,7 +52,8 @@ type Options struct {
 	Eth1Client eth1.Client
 	Beacon     beacon.Beacon
 
-	Network network.Network
+	Network     network.Network
+	NetworkFork networkForks.Fork
 
 	DB basedb.IDb
 
@@ -57,6 +62,7 @@ type Options struct {
 	IbftSyncEnabled                 bool
 	CleanRegistryData               bool
 	ValidatorMetaDataUpdateInterval time.Duration
+	DecidedGapsScanInterval         time.Duration
 
 	UseMainTopic bool
 
@@ -72,11 +78,15 @@ type exporter struct {
 	ibftStorage      collections.Iibft
 	logger           *zap.Logger
 	network          network.Network
+	networkFork      networkForks.Fork
+	ethNetwork       *core.Network
 	eth1Client       eth1.Client
 	beacon           beacon.Beacon
 
 	ws           api.WebSocketServer
 	commitReader ibft.Reader
+	analytics    analytics.Analytics
+	gapScanner   ibft.GapScanner
 
 	readersMut     sync.RWMutex
 	decidedReaders map[string]ibft.Reader
@@ -110,13 +120,16 @@ func New(opts Options) Exporter {
 	if opts.NumOfInstances == 0 {
 		opts.NumOfInstances = 1
 	}
+	exporterStorage := storage.NewExporterStorage(opts.DB, opts.Logger)
 	e := exporter{
 		ctx:                  opts.Ctx,
-		storage:              storage.NewExporterStorage(opts.DB, opts.Logger),
+		storage:              exporterStorage,
 		ibftStorage:          &ibftStorage,
 		validatorStorage:     validatorStorage,
 		logger:               opts.Logger.With(zap.String("component", "exporter/node")),
 		network:              opts.Network,
+		networkFork:          opts.NetworkFork,
+		ethNetwork:           opts.ETHNetwork,
 		eth1Client:           opts.Eth1Client,
 		beacon:               opts.Beacon,
 		decidedReadersQueue:  tasks.NewExecutionQueue(readerQueuesInterval),
@@ -134,6 +147,7 @@ func New(opts Options) Exporter {
 			Network:          opts.Network,
 			ValidatorStorage: validatorStorage,
 			IbftStorage:      &ibftStorage,
+			SignersStorage:   exporterStorage,
 			Out:              opts.WS.BroadcastFeed(),
 		}),
 		wsAPIPort:                       opts.WsAPIPort,
@@ -145,10 +159,49 @@ func New(opts Options) Exporter {
 		instanceID:     opts.InstanceID,
 	}
 
+	analyticsOpts := analytics.Options{
+		Logger:      opts.Logger,
+		DB:          opts.DB,
+		IbftStorage: &ibftStorage,
+		Validators:  e.storage,
+	}
+	if opts.ETHNetwork != nil {
+		// aggregating once in an epoch
+		analyticsOpts.SlotsPerEpoch = opts.ETHNetwork.SlotsPerEpoch()
+		analyticsOpts.Interval = opts.ETHNetwork.SlotDurationSec() * time.Duration(opts.ETHNetwork.SlotsPerEpoch())
+		analyticsOpts.CurrentEpoch = func() uint64 {
+			return uint64(opts.ETHNetwork.EstimatedCurrentEpoch())
+		}
+	}
+	e.analytics = analytics.New(analyticsOpts)
+
+	e.gapScanner = ibft.NewGapScanner(ibft.GapScannerOptions{
+		Logger:           opts.Logger,
+		Storage:          &ibftStorage,
+		Network:          opts.Network,
+		ValidatorStorage: validatorStorage,
+		DB:               opts.DB,
+		ShouldScan:       e.shouldProcessValidator,
+		Interval:         opts.DecidedGapsScanInterval,
+	})
+
 	if err := e.init(opts); err != nil {
 		e.logger.Panic("failed to init", zap.Error(err))
 	}
 
+	// validate incoming messages of validators topics
+	validation.UseMsgValidator(opts.Network, validation.NewMsgValidator(validation.Options{
+		Logger: opts.Logger,
+		Shares: validatorStorage.GetValidatorShare,
+		HighestDecided: func(lambda []byte) (uint64, bool, error) {
+			highest, found, err := ibftStorage.GetHighestDecidedInstance(lambda)
+			if err != nil || !found {
+				return 0, found, err
+			}
+			return highest.Message.SeqNumber, true, nil
+		},
+	}))
+
 	return &e
 }
 
@@ -169,6 +222,7 @@ func (exp *exporter) init(opts Options) error {
 func (exp *exporter) Start() error {
 	exp.logger.Info("starting node")
 
+	go exp.listenForCurrentSlot()
 	go exp.metaDataReadersQueue.Start()
 	if err := exp.warmupValidatorsMetaData(); err != nil {
 		exp.logger.Error("failed to warmup validators metadata", zap.Error(err))
@@ -183,6 +237,8 @@ func (exp *exporter) Start() error {
 	}
 
 	exp.ws.UseQueryHandler(exp.handleQueryRequests)
+	exp.ws.UseHealthCheck(exp.HealthCheck)
+	exp.ws.UseOperatorsLookup(exp.lookupValidatorOperators)
 
 	go exp.triggerAllValidators()
 
@@ -198,9 +254,40 @@ func (exp *exporter) Start() error {
 
 	go exp.reportOperators()
 
+	go exp.analytics.Start(exp.ctx)
+
+	go exp.gapScanner.Start(exp.ctx)
+
 	return exp.ws.Start(fmt.Sprintf(":%d", exp.wsAPIPort))
 }
 
+// listenForCurrentSlot updates the network fork with the current slot
+func (exp *exporter) listenForCurrentSlot() {
+	if exp.networkFork == nil || exp.ethNetwork == nil {
+		return
+	}
+	genesisTime := time.Unix(int64(exp.ethNetwork.MinGenesisTime()), 0)
+	slotTicker := slots.NewSlotTicker(genesisTime, uint64(exp.ethNetwork.SlotDurationSec().Seconds()))
+	defer slotTicker.Done()
+	for {
+		select {
+		case <-exp.ctx.Done():
+			return
+		case slot := <-slotTicker.C():
+			exp.networkFork.SlotTick(uint64(slot))
+		}
+	}
+}
+
+// lookupValidatorOperators returns the operators of the given validator, used by stream subscriptions
+func (exp *exporter) lookupValidatorOperators(validatorPubKey string) []string {
+	operators, err := getValidatorOperators(exp.storage, validatorPubKey)
+	if err != nil {
+		exp.logger.Debug("could not lookup validator operators", zap.String("pubKey", validatorPubKey), zap.Error(err))
+	}
+	return operators
+}
+
 // HealthCheck returns a list of issues regards the state of the exporter node
 func (exp *exporter) HealthCheck() []string {
 	return metrics.ProcessAgents(exp.healthAgents())
@@ -240,6 +327,14 @@ func (exp *exporter) handleQueryRequests(nm *api.NetworkMessage) {
 		handleValidatorsQuery(exp.logger, exp.storage, nm)
 	case api.TypeDecided:
 		handleDecidedQuery(exp.logger, exp.storage, exp.ibftStorage, nm)
+	case api.TypeSigners:
+		handleSignersQuery(exp.logger, exp.storage, exp.ibftStorage, nm)
+	case api.TypeAccount:
+		handleAccountQuery(exp.logger, exp.validatorStorage, nm)
+	case api.TypeOperatorStats:
+		handleOperatorStatsQuery(exp.logger, exp.analytics, nm)
+	case api.TypeCoverage:
+		handleCoverageQuery(exp.logger, exp.gapScanner, nm)
 	case api.TypeError:
 		handleErrorQuery(exp.logger, nm)
 	default:
@@ -359,6 +454,7 @@ func (exp *exporter) getOrCreateDecidedReader(validatorShare *validatorstorage.S
 			Network:        exp.network,
 			Config:         proto.DefaultConsensusParams(),
 			ValidatorShare: validatorShare,
+			SignersStorage: exp.storage,
 			Out:            exp.ws.BroadcastFeed(),
 		})
 	}
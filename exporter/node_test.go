@@ -103,17 +103,18 @@ func TestExporter_ListenToEth1Events(t *testing.T) {
 
 	feed := new(event.Feed)
 
+	// subscribing before events are sent, otherwise they might get lost
+	errCn := exp.listenToEth1Events(feed)
 	go func() {
-		errCn := exp.listenToEth1Events(feed)
 		for err := range errCn {
 			require.NoError(t, err)
 		}
 	}()
 
 	var wg sync.WaitGroup
+	cnOut := make(chan api.Message)
+	sub := exp.ws.BroadcastFeed().Subscribe(cnOut)
 	go func() {
-		cnOut := make(chan api.Message)
-		sub := exp.ws.BroadcastFeed().Subscribe(cnOut)
 		defer sub.Unsubscribe()
 
 		for msg := range cnOut {
@@ -3,12 +3,18 @@ package exporter
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 
+	"github.com/bloxapp/ssv/exporter/analytics"
 	"github.com/bloxapp/ssv/exporter/api"
+	"github.com/bloxapp/ssv/exporter/ibft"
 	"github.com/bloxapp/ssv/exporter/storage"
+	"github.com/bloxapp/ssv/ibft/proto"
 	registrystorage "github.com/bloxapp/ssv/registry/storage"
 	"github.com/bloxapp/ssv/storage/collections"
 	"github.com/bloxapp/ssv/utils/format"
+	validatorstorage "github.com/bloxapp/ssv/validator/storage"
+	"github.com/ethereum/go-ethereum/common"
 	"go.uber.org/zap"
 )
 
@@ -45,7 +51,10 @@ func handleValidatorsQuery(logger *zap.Logger, s storage.ValidatorsCollection, n
 		Filter: nm.Msg.Filter,
 	}
 	validators, err := getValidators(s, nm.Msg.Filter)
-	if err != nil {
+	if err == errValidatorNotFound {
+		logger.Warn("validator not found")
+		res.Data = []string{"internal error - could not find validator"}
+	} else if err != nil {
 		logger.Warn("failed to get validators", zap.Error(err))
 		res.Data = []string{"internal error - could not get validators"}
 	} else {
@@ -54,6 +63,29 @@ func handleValidatorsQuery(logger *zap.Logger, s storage.ValidatorsCollection, n
 	nm.Msg = res
 }
 
+func handleAccountQuery(logger *zap.Logger, s validatorstorage.ICollection, nm *api.NetworkMessage) {
+	logger.Debug("handles account request",
+		zap.String("ownerAddress", nm.Msg.Filter.OwnerAddress))
+	res := api.Message{
+		Type:   nm.Msg.Type,
+		Filter: nm.Msg.Filter,
+	}
+	if !common.IsHexAddress(nm.Msg.Filter.OwnerAddress) {
+		res.Data = []string{"bad request - invalid owner address"}
+		nm.Msg = res
+		return
+	}
+	ownerAddress := common.HexToAddress(nm.Msg.Filter.OwnerAddress).Hex()
+	status, err := s.GetOwnerAccountStatus(ownerAddress)
+	if err != nil {
+		logger.Warn("failed to get owner account", zap.Error(err))
+		res.Data = []string{"internal error - could not get owner account"}
+	} else {
+		res.Data = []validatorstorage.OwnerAccount{{OwnerAddress: ownerAddress, Status: status}}
+	}
+	nm.Msg = res
+}
+
 func handleDecidedQuery(logger *zap.Logger, validatorStorage storage.ValidatorsCollection, ibftStorage collections.Iibft, nm *api.NetworkMessage) {
 	logger.Debug("handles decided request",
 		zap.Int64("from", nm.Msg.Filter.From),
@@ -64,32 +96,101 @@ func handleDecidedQuery(logger *zap.Logger, validatorStorage storage.ValidatorsC
 		Type:   nm.Msg.Type,
 		Filter: nm.Msg.Filter,
 	}
-	v, found, err := validatorStorage.GetValidatorInformation(nm.Msg.Filter.PublicKey)
+	if _, _, msgs, errs := readDecided(logger, validatorStorage, ibftStorage, nm.Msg.Filter); len(errs) > 0 {
+		res.Data = errs
+	} else {
+		res.Data = msgs
+	}
+	nm.Msg = res
+}
+
+func handleSignersQuery(logger *zap.Logger, s storage.Storage, ibftStorage collections.Iibft, nm *api.NetworkMessage) {
+	logger.Debug("handles signers request",
+		zap.Int64("from", nm.Msg.Filter.From),
+		zap.Int64("to", nm.Msg.Filter.To),
+		zap.String("pk", nm.Msg.Filter.PublicKey),
+		zap.String("role", string(nm.Msg.Filter.Role)))
+	res := api.Message{
+		Type:   nm.Msg.Type,
+		Filter: nm.Msg.Filter,
+	}
+	v, identifier, msgs, errs := readDecided(logger, s, ibftStorage, nm.Msg.Filter)
+	if len(errs) > 0 {
+		res.Data = errs
+		nm.Msg = res
+		return
+	}
+	records, err := s.GetSignersRecords(identifier, uint64(nm.Msg.Filter.From), uint64(nm.Msg.Filter.To))
+	if err != nil {
+		logger.Warn("failed to get signers records", zap.Error(err))
+		res.Data = []string{"internal error - could not get signers records"}
+	} else {
+		res.Data = toInstancesSigners(v, msgs, records)
+	}
+	nm.Msg = res
+}
+
+// readDecided reads the decided messages of the validator and role in the given filter,
+// failures are returned as a list of errors
+func readDecided(logger *zap.Logger, validatorStorage storage.ValidatorsCollection, ibftStorage collections.Iibft,
+	filter api.MessageFilter) (*storage.ValidatorInformation, []byte, []*proto.SignedMessage, []string) {
+	v, found, err := validatorStorage.GetValidatorInformation(filter.PublicKey)
 	if err != nil {
 		logger.Warn("failed to get validators", zap.Error(err))
-		res.Data = []string{"internal error - could not get validator"}
-	} else if !found {
+		return nil, nil, nil, []string{"internal error - could not get validator"}
+	}
+	if !found {
 		logger.Warn("validator not found")
-		res.Data = []string{"internal error - could not find validator"}
+		return nil, nil, nil, []string{"internal error - could not find validator"}
+	}
+	pkRaw, err := hex.DecodeString(v.PublicKey)
+	if err != nil {
+		logger.Warn("failed to decode validator public key", zap.Error(err))
+		return nil, nil, nil, []string{"internal error - could not read validator key"}
+	}
+	identifier := []byte(format.IdentifierFormat(pkRaw, string(filter.Role)))
+	msgs, err := ibftStorage.GetDecidedInRange(identifier, uint64(filter.From), uint64(filter.To))
+	if err != nil {
+		logger.Warn("failed to get decided messages", zap.Error(err))
+		return nil, nil, nil, []string{"internal error - could not get decided messages"}
+	}
+	return v, identifier, msgs, nil
+}
+
+func handleOperatorStatsQuery(logger *zap.Logger, a analytics.Analytics, nm *api.NetworkMessage) {
+	logger.Debug("handles operator stats request",
+		zap.Int64("from", nm.Msg.Filter.From),
+		zap.Int64("to", nm.Msg.Filter.To),
+		zap.String("pk", nm.Msg.Filter.PublicKey))
+	res := api.Message{
+		Type:   nm.Msg.Type,
+		Filter: nm.Msg.Filter,
+	}
+	if len(nm.Msg.Filter.PublicKey) == 0 {
+		res.Data = []string{"bad request - missing operator public key"}
+	} else if nm.Msg.Filter.From < 0 || nm.Msg.Filter.To < nm.Msg.Filter.From {
+		res.Data = []string{"bad request - invalid epochs range"}
 	} else {
-		pkRaw, err := hex.DecodeString(v.PublicKey)
+		stats, err := a.GetOperatorStats(nm.Msg.Filter.PublicKey, uint64(nm.Msg.Filter.From), uint64(nm.Msg.Filter.To))
 		if err != nil {
-			logger.Warn("failed to decode validator public key", zap.Error(err))
-			res.Data = []string{"internal error - could not read validator key"}
+			logger.Warn("failed to get operator stats", zap.Error(err))
+			res.Data = []string{"internal error - could not get operator stats"}
 		} else {
-			identifier := format.IdentifierFormat(pkRaw, string(nm.Msg.Filter.Role))
-			msgs, err := ibftStorage.GetDecidedInRange([]byte(identifier), uint64(nm.Msg.Filter.From), uint64(nm.Msg.Filter.To))
-			if err != nil {
-				logger.Warn("failed to get decided messages", zap.Error(err))
-				res.Data = []string{"internal error - could not get decided messages"}
-			} else {
-				res.Data = msgs
-			}
+			res.Data = stats
 		}
 	}
 	nm.Msg = res
 }
 
+func handleCoverageQuery(logger *zap.Logger, scanner ibft.GapScanner, nm *api.NetworkMessage) {
+	logger.Debug("handles coverage request", zap.String("pk", nm.Msg.Filter.PublicKey))
+	nm.Msg = api.Message{
+		Type:   nm.Msg.Type,
+		Filter: nm.Msg.Filter,
+		Data:   scanner.Coverage(strings.ToLower(nm.Msg.Filter.PublicKey)),
+	}
+}
+
 func handleErrorQuery(logger *zap.Logger, nm *api.NetworkMessage) {
 	logger.Warn("handles error message")
 	if _, ok := nm.Msg.Data.([]string); !ok {
@@ -4,7 +4,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/exporter/analytics"
 	"github.com/bloxapp/ssv/exporter/api"
+	"github.com/bloxapp/ssv/exporter/ibft"
 	"github.com/bloxapp/ssv/exporter/storage"
 	"github.com/bloxapp/ssv/ibft/proto"
 	"github.com/bloxapp/ssv/ibft/sync"
@@ -13,6 +15,7 @@ import (
 	"github.com/bloxapp/ssv/storage/basedb"
 	"github.com/bloxapp/ssv/storage/collections"
 	"github.com/bloxapp/ssv/utils/format"
+	validatorstorage "github.com/bloxapp/ssv/validator/storage"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/pkg/errors"
@@ -236,6 +239,22 @@ func TestHandleValidatorsQuery(t *testing.T) {
 		require.Equal(t, int64(2), results[0].Index)
 		require.Equal(t, "03030303", results[0].PublicKey)
 	})
+
+	t.Run("query unknown pubKey", func(t *testing.T) {
+		nm := api.NetworkMessage{
+			Msg: api.Message{
+				Type:   api.TypeValidator,
+				Filter: api.MessageFilter{PublicKey: "04040404"},
+			},
+			Err:  nil,
+			Conn: nil,
+		}
+		handleValidatorsQuery(l, s, &nm)
+		require.Equal(t, api.TypeValidator, nm.Msg.Type)
+		errs, ok := nm.Msg.Data.([]string)
+		require.True(t, ok)
+		require.Equal(t, "internal error - could not find validator", errs[0])
+	})
 }
 
 func TestHandleDecidedQuery(t *testing.T) {
@@ -285,6 +304,58 @@ func TestHandleDecidedQuery(t *testing.T) {
 	})
 }
 
+func TestHandleSignersQuery(t *testing.T) {
+	db, l, done := newDBAndLoggerForTest()
+	defer done()
+	exporterStorage, ibftStorage := newStorageForTest(db, l)
+	_ = bls.Init(bls.BLS12_381)
+
+	sks, _ := sync.GenerateNodes(4)
+	pk := sks[1].GetPublicKey()
+	identifier := []byte(format.IdentifierFormat(pk.Serialize(), beacon.RoleTypeAttester.String()))
+	require.NoError(t, exporterStorage.SaveValidatorInformation(&storage.ValidatorInformation{
+		PublicKey: pk.SerializeToHexStr(),
+		Operators: []storage.OperatorNodeLink{{ID: 4}, {ID: 2}, {ID: 3}, {ID: 1}},
+	}))
+	for seq, signers := range [][]uint64{{1, 2, 3}, {1, 2, 3, 4}, {1, 2, 5}} {
+		require.NoError(t, ibftStorage.SaveDecided(&proto.SignedMessage{
+			Message:   &proto.Message{Type: proto.RoundState_Commit, Round: 2, Lambda: identifier, SeqNumber: uint64(seq)},
+			SignerIds: signers,
+		}))
+	}
+	// operator 4 committed after seq 1 was decided
+	require.NoError(t, exporterStorage.SaveLateSigners(identifier, 1, []uint64{1, 2, 3, 4}, []uint64{4}))
+	require.NoError(t, exporterStorage.SaveLateSigners(identifier, 1, []uint64{1, 2, 3, 4}, []uint64{4}))
+	// seq 2 was decided by a former committee, the record is not overridden
+	require.NoError(t, exporterStorage.SaveCommittee(identifier, 2, []uint64{1, 2, 3, 5}))
+	require.NoError(t, exporterStorage.SaveCommittee(identifier, 2, []uint64{1, 2, 3, 4}))
+
+	t.Run("valid range", func(t *testing.T) {
+		nm := newDecidedAPIMsg(pk.SerializeToHexStr(), 0, 5)
+		nm.Msg.Type = api.TypeSigners
+		handleSignersQuery(l, exporterStorage, ibftStorage, nm)
+		records, ok := nm.Msg.Data.([]storage.InstanceSigners)
+		require.True(t, ok)
+		require.Equal(t, []storage.InstanceSigners{
+			{SeqNumber: 0, Round: 2, Committee: []uint64{1, 2, 3, 4}, Signers: []uint64{1, 2, 3},
+				LateSigners: []uint64{}, MissingSigners: []uint64{4}},
+			{SeqNumber: 1, Round: 2, Committee: []uint64{1, 2, 3, 4}, Signers: []uint64{1, 2, 3},
+				LateSigners: []uint64{4}, MissingSigners: []uint64{}},
+			{SeqNumber: 2, Round: 2, Committee: []uint64{1, 2, 3, 5}, Signers: []uint64{1, 2, 5},
+				LateSigners: []uint64{}, MissingSigners: []uint64{3}},
+		}, records)
+	})
+
+	t.Run("non-exist validator", func(t *testing.T) {
+		nm := newDecidedAPIMsg("xxx", 0, 5)
+		nm.Msg.Type = api.TypeSigners
+		handleSignersQuery(l, exporterStorage, ibftStorage, nm)
+		errs, ok := nm.Msg.Data.([]string)
+		require.True(t, ok)
+		require.Equal(t, "internal error - could not find validator", errs[0])
+	})
+}
+
 func newDecidedAPIMsg(pk string, from, to int64) *api.NetworkMessage {
 	return &api.NetworkMessage{
 		Msg: api.Message{
@@ -301,6 +372,114 @@ func newDecidedAPIMsg(pk string, from, to int64) *api.NetworkMessage {
 	}
 }
 
+func TestHandleAccountQuery(t *testing.T) {
+	db, l, done := newDBAndLoggerForTest()
+	defer done()
+	s := validatorstorage.NewCollection(validatorstorage.CollectionOptions{DB: db, Logger: l})
+	const owner = "0xa5cfD290965372553Efd5fDaeB91C335207b76E2"
+	require.NoError(t, s.SaveOwnerAccount(&validatorstorage.OwnerAccount{
+		OwnerAddress: owner,
+		Status:       validatorstorage.AccountLiquidated,
+	}))
+
+	query := func(ownerAddress string) api.Message {
+		nm := api.NetworkMessage{
+			Msg: api.Message{
+				Type:   api.TypeAccount,
+				Filter: api.MessageFilter{OwnerAddress: ownerAddress},
+			},
+		}
+		handleAccountQuery(l, s, &nm)
+		require.Equal(t, api.TypeAccount, nm.Msg.Type)
+		return nm.Msg
+	}
+
+	t.Run("liquidated account", func(t *testing.T) {
+		results, ok := query(strings.ToLower(owner)).Data.([]validatorstorage.OwnerAccount)
+		require.True(t, ok)
+		require.Len(t, results, 1)
+		require.Equal(t, owner, results[0].OwnerAddress)
+		require.Equal(t, validatorstorage.AccountLiquidated, results[0].Status)
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		results, ok := query("0x0000000000000000000000000000000000000001").Data.([]validatorstorage.OwnerAccount)
+		require.True(t, ok)
+		require.Len(t, results, 1)
+		require.Equal(t, validatorstorage.AccountActive, results[0].Status)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		errs, ok := query("xxx").Data.([]string)
+		require.True(t, ok)
+		require.Equal(t, "bad request - invalid owner address", errs[0])
+	})
+}
+
+func TestHandleOperatorStatsQuery(t *testing.T) {
+	db, l, done := newDBAndLoggerForTest()
+	defer done()
+	ibftStorage := collections.NewIbft(db, l, "attestation")
+	a := analytics.New(analytics.Options{
+		Logger:      l,
+		DB:          db,
+		IbftStorage: &ibftStorage,
+		Validators:  storage.NewExporterStorage(db, l),
+	})
+
+	query := func(filter api.MessageFilter) api.Message {
+		nm := api.NetworkMessage{
+			Msg: api.Message{
+				Type:   api.TypeOperatorStats,
+				Filter: filter,
+			},
+		}
+		handleOperatorStatsQuery(l, a, &nm)
+		require.Equal(t, api.TypeOperatorStats, nm.Msg.Type)
+		return nm.Msg
+	}
+
+	t.Run("unknown operator", func(t *testing.T) {
+		results, ok := query(api.MessageFilter{PublicKey: "op", From: 0, To: 10}).Data.([]analytics.OperatorStats)
+		require.True(t, ok)
+		require.Len(t, results, 0)
+	})
+
+	t.Run("bad requests", func(t *testing.T) {
+		errs, ok := query(api.MessageFilter{From: 0, To: 10}).Data.([]string)
+		require.True(t, ok)
+		require.Equal(t, "bad request - missing operator public key", errs[0])
+
+		errs, ok = query(api.MessageFilter{PublicKey: "op", From: 10, To: 0}).Data.([]string)
+		require.True(t, ok)
+		require.Equal(t, "bad request - invalid epochs range", errs[0])
+	})
+}
+
+func TestHandleCoverageQuery(t *testing.T) {
+	db, l, done := newDBAndLoggerForTest()
+	defer done()
+	ibftStorage := collections.NewIbft(db, l, "attestation")
+	scanner := ibft.NewGapScanner(ibft.GapScannerOptions{
+		Logger:           l,
+		Storage:          &ibftStorage,
+		ValidatorStorage: validatorstorage.NewCollection(validatorstorage.CollectionOptions{DB: db, Logger: l}),
+	})
+	require.NoError(t, scanner.Scan())
+
+	nm := api.NetworkMessage{
+		Msg: api.Message{
+			Type:   api.TypeCoverage,
+			Filter: api.MessageFilter{PublicKey: "82e9b36feb8147d3f82c1a03ba246d4a63ac1ce0b1dabbb6991940a06401ab46fb4afbf971a3c145fdad2d4bddd30e12"},
+		},
+	}
+	handleCoverageQuery(l, scanner, &nm)
+	require.Equal(t, api.TypeCoverage, nm.Msg.Type)
+	results, ok := nm.Msg.Data.([]ibft.Coverage)
+	require.True(t, ok)
+	require.Len(t, results, 0)
+}
+
 func newDBAndLoggerForTest() (basedb.IDb, *zap.Logger, func()) {
 	logger := zap.L()
 	db, err := ssvstorage.GetStorageFactory(basedb.Options{
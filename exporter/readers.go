@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"github.com/bloxapp/ssv/exporter/api"
 	"github.com/bloxapp/ssv/exporter/storage"
+	"github.com/bloxapp/ssv/ibft/proto"
 	registrystorage "github.com/bloxapp/ssv/registry/storage"
 	"github.com/pkg/errors"
 	"sort"
 )
 
+// errValidatorNotFound is returned when the requested validator doesn't exist
+var errValidatorNotFound = errors.New("could not find validator")
+
 // operatorIndexSorter sorts operators by Index
 type operatorIndexSorter []registrystorage.OperatorInformation
 
@@ -52,7 +56,7 @@ func getValidators(s storage.ValidatorsCollection, filter api.MessageFilter) ([]
 	if len(filter.PublicKey) > 0 {
 		validator, found, err := s.GetValidatorInformation(filter.PublicKey)
 		if !found {
-			return nil, errors.New("could not find validator")
+			return nil, errValidatorNotFound
 		}
 		if err != nil {
 			return nil, errors.Wrap(err, "could not read validator")
@@ -68,3 +72,70 @@ func getValidators(s storage.ValidatorsCollection, filter api.MessageFilter) ([]
 	sort.Sort(validatorIndexSorter(validators))
 	return validators, nil
 }
+
+// getValidatorOperators returns the public keys of the operators of the given validator
+func getValidatorOperators(s storage.ValidatorsCollection, validatorPubKey string) ([]string, error) {
+	validator, found, err := s.GetValidatorInformation(validatorPubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read validator")
+	}
+	if !found {
+		return nil, nil
+	}
+	var operators []string
+	for _, o := range validator.Operators {
+		operators = append(operators, o.PublicKey)
+	}
+	return operators, nil
+}
+
+// toInstancesSigners creates the signers of the given decided messages according to their persisted records,
+// the current operators of the validator are used as the committee of instances without a record (e.g. synced history)
+func toInstancesSigners(v *storage.ValidatorInformation, msgs []*proto.SignedMessage, records map[uint64]*storage.SignersRecord) []storage.InstanceSigners {
+	currentCommittee := make([]uint64, 0, len(v.Operators))
+	for _, o := range v.Operators {
+		currentCommittee = append(currentCommittee, o.ID)
+	}
+	sort.Slice(currentCommittee, func(i, j int) bool {
+		return currentCommittee[i] < currentCommittee[j]
+	})
+	res := make([]storage.InstanceSigners, 0, len(msgs))
+	for _, msg := range msgs {
+		committee := currentCommittee
+		var late []uint64
+		if r, ok := records[msg.Message.SeqNumber]; ok {
+			if len(r.Committee) > 0 {
+				committee = r.Committee
+			}
+			late = r.LateSigners
+		}
+		record := storage.InstanceSigners{
+			SeqNumber:      msg.Message.SeqNumber,
+			Round:          msg.Message.Round,
+			Committee:      committee,
+			Signers:        []uint64{},
+			LateSigners:    []uint64{},
+			MissingSigners: []uint64{},
+		}
+		signed := make(map[uint64]bool, len(msg.SignerIds))
+		for _, id := range msg.SignerIds {
+			signed[id] = true
+		}
+		isLate := make(map[uint64]bool, len(late))
+		for _, id := range late {
+			isLate[id] = true
+		}
+		for _, id := range committee {
+			switch {
+			case isLate[id]:
+				record.LateSigners = append(record.LateSigners, id)
+			case signed[id]:
+				record.Signers = append(record.Signers, id)
+			default:
+				record.MissingSigners = append(record.MissingSigners, id)
+			}
+		}
+		res = append(res, record)
+	}
+	return res
+}
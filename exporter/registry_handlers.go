@@ -9,6 +9,8 @@ import (
 	"github.com/bloxapp/ssv/exporter/storage"
 	registrystorage "github.com/bloxapp/ssv/registry/storage"
 	"github.com/bloxapp/ssv/validator"
+	validatorstorage "github.com/bloxapp/ssv/validator/storage"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/async/event"
@@ -43,6 +45,10 @@ func (exp *exporter) handleEth1Event(e eth1.Event) error {
 		err = exp.handleValidatorAddedEvent(validatorAddedEvent)
 	} else if operatorAddedEvent, ok := e.Data.(abiparser.OperatorAddedEvent); ok {
 		err = exp.handleOperatorAddedEvent(operatorAddedEvent)
+	} else if accountLiquidatedEvent, ok := e.Data.(abiparser.AccountLiquidatedEvent); ok {
+		err = exp.handleAccountStatusEvent(accountLiquidatedEvent.OwnerAddress, validatorstorage.AccountLiquidated)
+	} else if accountEnabledEvent, ok := e.Data.(abiparser.AccountEnabledEvent); ok {
+		err = exp.handleAccountStatusEvent(accountEnabledEvent.OwnerAddress, validatorstorage.AccountActive)
 	}
 	return err
 }
@@ -121,6 +127,31 @@ func (exp *exporter) handleOperatorAddedEvent(event abiparser.OperatorAddedEvent
 	return nil
 }
 
+// handleAccountStatusEvent saves the status of the given owner account
+func (exp *exporter) handleAccountStatusEvent(ownerAddress common.Address, status validatorstorage.AccountStatus) error {
+	logger := exp.logger.With(zap.String("ownerAddress", ownerAddress.Hex()),
+		zap.String("status", status.String()))
+	account := validatorstorage.OwnerAccount{
+		OwnerAddress: ownerAddress.Hex(),
+		Status:       status,
+	}
+	if err := exp.validatorStorage.SaveOwnerAccount(&account); err != nil {
+		return errors.Wrap(err, "failed to save owner account")
+	}
+	logger.Debug("owner account status was updated")
+
+	go func() {
+		n := exp.ws.BroadcastFeed().Send(api.Message{
+			Type:   api.TypeAccount,
+			Filter: api.MessageFilter{OwnerAddress: account.OwnerAddress},
+			Data:   []validatorstorage.OwnerAccount{account},
+		})
+		logger.Debug("msg was sent on outbound feed", zap.Int("num of subscribers", n))
+	}()
+
+	return nil
+}
+
 // toValidatorInformation converts raw event to ValidatorInformation
 func toValidatorInformation(validatorAddedEvent abiparser.ValidatorAddedEvent) (*storage.ValidatorInformation, error) {
 	pubKey := &bls.PublicKey{}
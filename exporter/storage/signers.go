@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/pkg/errors"
+)
+
+// lateSignersPrefix is not part of the registry prefix as the data is not recoverable from the contract,
+// it holds the signers records of decided instances
+func lateSignersPrefix() []byte {
+	return []byte("exporter-ibft/late_signers/")
+}
+
+// InstanceSigners is the record of the expected committee versus the actual signers of a decided instance
+type InstanceSigners struct {
+	SeqNumber uint64 `json:"seqNumber"`
+	Round     uint64 `json:"round"`
+	// Committee contains the node ids of the validator's operators
+	Committee []uint64 `json:"committee"`
+	// Signers committed before the instance was decided
+	Signers []uint64 `json:"signers"`
+	// LateSigners committed after the instance was decided
+	LateSigners []uint64 `json:"lateSigners"`
+	// MissingSigners didn't commit
+	MissingSigners []uint64 `json:"missingSigners"`
+}
+
+// SignersRecord is the persisted record of a decided instance, written once the instance was decided
+type SignersRecord struct {
+	// Committee contains the node ids of the validator's operators at the time of the instance
+	Committee []uint64 `json:"committee"`
+	// LateSigners committed after the instance was decided
+	LateSigners []uint64 `json:"lateSigners"`
+}
+
+// SignersCollection is the interface for managing the committee and the late signers of decided instances
+type SignersCollection interface {
+	SaveCommittee(identifier []byte, seqNumber uint64, committee []uint64) error
+	SaveLateSigners(identifier []byte, seqNumber uint64, committee []uint64, signers []uint64) error
+	GetSignersRecords(identifier []byte, from, to uint64) (map[uint64]*SignersRecord, error)
+}
+
+// SaveCommittee saves the expected committee of the given instance, an existing committee is kept
+func (s *storage) SaveCommittee(identifier []byte, seqNumber uint64, committee []uint64) error {
+	return s.updateSignersRecord(identifier, seqNumber, func(record *SignersRecord) {
+		if len(record.Committee) == 0 {
+			record.Committee = committee
+		}
+	})
+}
+
+// SaveLateSigners adds the given signers to the late signers of the given instance,
+// the given committee is saved if the record has no committee yet
+func (s *storage) SaveLateSigners(identifier []byte, seqNumber uint64, committee []uint64, signers []uint64) error {
+	return s.updateSignersRecord(identifier, seqNumber, func(record *SignersRecord) {
+		if len(record.Committee) == 0 {
+			record.Committee = committee
+		}
+		for _, signer := range signers {
+			if !containsSigner(record.LateSigners, signer) {
+				record.LateSigners = append(record.LateSigners, signer)
+			}
+		}
+	})
+}
+
+// updateSignersRecord reads the record of the given instance, applies the given update and saves it
+func (s *storage) updateSignersRecord(identifier []byte, seqNumber uint64, update func(record *SignersRecord)) error {
+	s.lateSignersLock.Lock()
+	defer s.lateSignersLock.Unlock()
+
+	key := lateSignersKey(identifier, seqNumber)
+	record := &SignersRecord{}
+	obj, found, err := s.db.Get(lateSignersPrefix(), key)
+	if err != nil {
+		return errors.Wrap(err, "could not read signers record")
+	}
+	if found {
+		if err := json.Unmarshal(obj.Value, record); err != nil {
+			return errors.Wrap(err, "could not unmarshal signers record")
+		}
+	}
+	update(record)
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal signers record")
+	}
+	return s.db.Set(lateSignersPrefix(), key, raw)
+}
+
+// GetSignersRecords returns the signers records of the given range of instances by sequence number
+func (s *storage) GetSignersRecords(identifier []byte, from, to uint64) (map[uint64]*SignersRecord, error) {
+	s.lateSignersLock.RLock()
+	defer s.lateSignersLock.RUnlock()
+
+	var keys [][]byte
+	for seq := from; seq <= to; seq++ {
+		keys = append(keys, lateSignersKey(identifier, seq))
+	}
+	res := make(map[uint64]*SignersRecord)
+	err := s.db.GetMany(lateSignersPrefix(), keys, func(obj basedb.Obj) error {
+		record := &SignersRecord{}
+		if err := json.Unmarshal(obj.Value, record); err != nil {
+			return errors.Wrap(err, "could not unmarshal signers record")
+		}
+		if len(obj.Key) < 8 {
+			return errors.New("invalid signers record key")
+		}
+		res[binary.BigEndian.Uint64(obj.Key[len(obj.Key)-8:])] = record
+		return nil
+	})
+	return res, err
+}
+
+func lateSignersKey(identifier []byte, seqNumber uint64) []byte {
+	seq := make([]byte, 8)
+	binary.BigEndian.PutUint64(seq, seqNumber)
+	return bytes.Join([][]byte{identifier, seq}, []byte("/"))
+}
+
+func containsSigner(signers []uint64, signer uint64) bool {
+	for _, s := range signers {
+		if s == signer {
+			return true
+		}
+	}
+	return false
+}
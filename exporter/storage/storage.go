@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"sync"
 
 	"github.com/bloxapp/ssv/eth1"
@@ -18,6 +19,7 @@ type Storage interface {
 	eth1.SyncOffsetStorage
 	registrystorage.OperatorsCollection
 	ValidatorsCollection
+	SignersCollection
 	basedb.RegistryStore
 }
 
@@ -25,7 +27,8 @@ type storage struct {
 	db     basedb.IDb
 	logger *zap.Logger
 
-	validatorsLock sync.RWMutex
+	validatorsLock  sync.RWMutex
+	lateSignersLock sync.RWMutex
 
 	operatorStore registrystorage.OperatorsCollection
 }
@@ -38,6 +41,10 @@ func (s *storage) SaveOperatorInformation(operatorInformation *registrystorage.O
 	return s.operatorStore.SaveOperatorInformation(operatorInformation)
 }
 
+func (s *storage) UpdateOperatorInformation(operatorInformation *registrystorage.OperatorInformation) error {
+	return s.operatorStore.UpdateOperatorInformation(operatorInformation)
+}
+
 func (s *storage) ListOperators(from int64, to int64) ([]registrystorage.OperatorInformation, error) {
 	return s.operatorStore.ListOperators(from, to)
 }
@@ -46,6 +53,10 @@ func (s *storage) GetOperatorsPrefix() []byte {
 	return s.operatorStore.GetOperatorsPrefix()
 }
 
+func (s *storage) BuildOperatorsIndex() (int, error) {
+	return s.operatorStore.BuildOperatorsIndex()
+}
+
 // NewExporterStorage creates a new instance of Storage
 func NewExporterStorage(db basedb.IDb, logger *zap.Logger) Storage {
 	return &storage{
@@ -60,9 +71,9 @@ func (s *storage) CleanRegistryData() error {
 	return s.db.RemoveAllByCollection(storagePrefix())
 }
 
-// nextIndex returns the next index for operator
+// nextIndex returns the next index for the given collection, the index is excluded as it has a different prefix
 func (s *storage) nextIndex(prefix []byte) (int64, error) {
-	n, err := s.db.CountByCollection(append(storagePrefix(), prefix...))
+	n, err := s.db.CountByCollection(bytes.Join([][]byte{storagePrefix(), prefix, []byte("/")}, nil))
 	if err != nil {
 		return 0, err
 	}
@@ -2,7 +2,10 @@ package storage
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/json"
+	"math"
+
 	"github.com/bloxapp/ssv/storage/basedb"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -12,6 +15,11 @@ func validatorsPrefix() []byte {
 	return []byte("validators")
 }
 
+// validatorsIndexPrefix is the collection of validators public keys by index, used to list validators in order
+func validatorsIndexPrefix() []byte {
+	return bytes.Join([][]byte{storagePrefix(), []byte("validators_index/")}, nil)
+}
+
 // ValidatorInformation represents a validator
 type ValidatorInformation struct {
 	Index     int64              `json:"index"`
@@ -24,6 +32,7 @@ type ValidatorsCollection interface {
 	GetValidatorInformation(validatorPubKey string) (*ValidatorInformation, bool, error)
 	SaveValidatorInformation(validatorInformation *ValidatorInformation) error
 	ListValidators(from int64, to int64) ([]ValidatorInformation, error)
+	BuildValidatorsIndex() (int, error)
 }
 
 // OperatorNodeLink links a validator to an operator
@@ -32,26 +41,52 @@ type OperatorNodeLink struct {
 	PublicKey string `json:"publicKey"`
 }
 
-// ListValidators returns information of all the known validators
-// when 'to' equals zero, all validators will be returned
+// ListValidators returns information of the known validators in the given range of indices, sorted by index
 func (s *storage) ListValidators(from int64, to int64) ([]ValidatorInformation, error) {
 	s.validatorsLock.RLock()
 	defer s.validatorsLock.RUnlock()
 
 	var validators []ValidatorInformation
-	err := s.db.GetAll(append(storagePrefix(), validatorsPrefix()...), func(i int, obj basedb.Obj) error {
+	keys, err := s.listValidatorKeys(from, to)
+	if err != nil || len(keys) == 0 {
+		return validators, err
+	}
+	err = s.db.GetMany(storagePrefix(), keys, func(obj basedb.Obj) error {
 		var vi ValidatorInformation
 		if err := json.Unmarshal(obj.Value, &vi); err != nil {
 			return err
 		}
-		if vi.Index >= from && vi.Index <= to {
-			validators = append(validators, vi)
-		}
+		validators = append(validators, vi)
 		return nil
 	})
 	return validators, err
 }
 
+// listValidatorKeys returns the keys of the validators in the given range of indices, by iterating the index
+func (s *storage) listValidatorKeys(from int64, to int64) ([][]byte, error) {
+	if from < 0 {
+		from = 0
+	}
+	if to < from {
+		return nil, nil
+	}
+	opts := basedb.IteratorOptions{From: validatorIndexKey(from)}
+	if to < math.MaxInt64 {
+		opts.To = validatorIndexKey(to + 1)
+	}
+	it := s.db.NewIterator(validatorsIndexPrefix(), opts)
+	defer it.Close()
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		obj, err := it.Item()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, validatorKey(string(obj.Value)))
+	}
+	return keys, nil
+}
+
 // GetValidatorInformation returns information of the given validator by public key
 func (s *storage) GetValidatorInformation(validatorPubKey string) (*ValidatorInformation, bool, error) {
 	s.validatorsLock.RLock()
@@ -109,7 +144,41 @@ func (s *storage) saveValidatorNotSafe(val *ValidatorInformation) error {
 	if err != nil {
 		return errors.Wrap(err, "could not marshal validator information")
 	}
-	return s.db.Set(storagePrefix(), validatorKey(val.PublicKey), raw)
+	return s.db.Update(func(txn basedb.Txn) error {
+		if err := txn.Set(storagePrefix(), validatorKey(val.PublicKey), raw); err != nil {
+			return err
+		}
+		return txn.Set(validatorsIndexPrefix(), validatorIndexKey(val.Index), []byte(val.PublicKey))
+	})
+}
+
+// BuildValidatorsIndex builds the index of the stored validators according to the index of each validator,
+// returns the number of indexed validators
+func (s *storage) BuildValidatorsIndex() (int, error) {
+	s.validatorsLock.Lock()
+	defer s.validatorsLock.Unlock()
+
+	var validators []ValidatorInformation
+	err := s.db.GetAll(bytes.Join([][]byte{storagePrefix(), validatorsPrefix(), []byte("/")}, nil), func(i int, obj basedb.Obj) error {
+		var vi ValidatorInformation
+		if err := json.Unmarshal(obj.Value, &vi); err != nil {
+			return errors.Wrap(err, "could not unmarshal validator information")
+		}
+		validators = append(validators, vi)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	err = s.db.Update(func(txn basedb.Txn) error {
+		for _, vi := range validators {
+			if err := txn.Set(validatorsIndexPrefix(), validatorIndexKey(vi.Index), []byte(vi.PublicKey)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return len(validators), err
 }
 
 func validatorKey(pubKey string) []byte {
@@ -118,3 +187,9 @@ func validatorKey(pubKey string) []byte {
 		[]byte(pubKey),
 	}, []byte("/"))
 }
+
+func validatorIndexKey(index int64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(index))
+	return k
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"github.com/bloxapp/ssv/utils/rsaencryption"
 	"github.com/stretchr/testify/require"
+	"math"
 	"testing"
 )
 
@@ -118,4 +119,43 @@ func TestStorage_ListValidators(t *testing.T) {
 	validators, err := storage.ListValidators(0, 0)
 	require.NoError(t, err)
 	require.Equal(t, 1, len(validators))
+
+	validators, err = storage.ListValidators(2, math.MaxInt64)
+	require.NoError(t, err)
+	require.Len(t, validators, n-2)
+	for i, validator := range validators {
+		require.Equal(t, int64(i+2), validator.Index)
+	}
+}
+
+func TestStorage_BuildValidatorsIndex(t *testing.T) {
+	s, done := newStorageForTest()
+	require.NotNil(t, s)
+	defer done()
+
+	n := 3
+	for i := 0; i < n; i++ {
+		pk, _, err := rsaencryption.GenerateKeys()
+		require.NoError(t, err)
+		validator := ValidatorInformation{
+			PublicKey: hex.EncodeToString(pk),
+			Operators: []OperatorNodeLink{},
+		}
+		require.NoError(t, s.SaveValidatorInformation(&validator))
+	}
+	// simulate validators that were saved without an index
+	require.NoError(t, s.(*storage).db.RemoveAllByCollection(validatorsIndexPrefix()))
+	validators, err := s.ListValidators(0, math.MaxInt64)
+	require.NoError(t, err)
+	require.Len(t, validators, 0)
+
+	indexed, err := s.BuildValidatorsIndex()
+	require.NoError(t, err)
+	require.Equal(t, n, indexed)
+	validators, err = s.ListValidators(0, math.MaxInt64)
+	require.NoError(t, err)
+	require.Len(t, validators, n)
+	for i, validator := range validators {
+		require.Equal(t, int64(i), validator.Index)
+	}
 }
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -44,6 +45,10 @@ type Controller struct {
 	syncingLock         *semaphore.Weighted
 
 	syncRateLimit time.Duration
+
+	// ctx is canceled once the controller was closed
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // New is the constructor of Controller
@@ -61,6 +66,7 @@ func New(
 	syncRateLimit time.Duration,
 ) ibft.Controller {
 	logger = logger.With(zap.String("role", role.String()))
+	ctx, cancel := context.WithCancel(context.Background())
 	ret := &Controller{
 		ibftStorage:    storage,
 		logger:         logger,
@@ -80,6 +86,9 @@ func New(
 		syncingLock:         semaphore.NewWeighted(1),
 
 		syncRateLimit: syncRateLimit,
+
+		ctx:    ctx,
+		cancel: cancel,
 	}
 
 	ret.setFork(fork)
@@ -149,6 +158,15 @@ func (i *Controller) StartInstance(opts ibft.ControllerStartInstanceOptions) (re
 	return res, err
 }
 
+// Close stops the background processes of the controller and the running instance (if any)
+func (i *Controller) Close() {
+	i.cancel()
+	if currentInstance := i.currentInstance; currentInstance != nil {
+		currentInstance.Stop()
+	}
+	i.logger.Debug("iBFT controller was closed")
+}
+
 // GetIBFTCommittee returns a map of the iBFT committee where the key is the member's id.
 func (i *Controller) GetIBFTCommittee() map[uint64]*proto.Node {
 	return i.ValidatorShare.Committee
@@ -111,6 +111,20 @@ func (s *testStorage) GetHighestDecidedInstance(identifier []byte) (*proto.Signe
 	return s.highestDecided, true, nil
 }
 
+// PruneDecided implementation
+func (s *testStorage) PruneDecided(identifier []byte, below uint64) (int, int64, error) {
+	return 0, 0, nil
+}
+
+// GetPrunedBelow implementation
+func (s *testStorage) GetPrunedBelow(identifier []byte) (uint64, error) {
+	return 0, nil
+}
+
+func (s *testStorage) CleanDecided(identifier []byte) error {
+	return nil
+}
+
 func TestDecidedRequiresSync(t *testing.T) {
 	secretKeys, _ := GenerateNodes(4)
 	tests := []struct {
@@ -55,13 +55,21 @@ func (i *Controller) listenToSyncMessages() {
 	syncChan, done := i.network.ReceivedSyncMsgChan()
 	go func() {
 		defer done()
-		for msg := range syncChan {
-			if msg.Msg != nil && i.equalIdentifier(msg.Msg.Lambda) {
-				i.msgQueue.AddMessage(&network.Message{
-					SyncMessage: msg.Msg,
-					StreamID:    msg.StreamID,
-					Type:        network.NetworkMsg_SyncType,
-				})
+		for {
+			select {
+			case <-i.ctx.Done():
+				return
+			case msg, ok := <-syncChan:
+				if !ok {
+					return
+				}
+				if msg.Msg != nil && i.equalIdentifier(msg.Msg.Lambda) {
+					i.msgQueue.AddMessage(&network.Message{
+						SyncMessage: msg.Msg,
+						StreamID:    msg.StreamID,
+						Type:        network.NetworkMsg_SyncType,
+					})
+				}
 			}
 		}
 	}()
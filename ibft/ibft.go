@@ -41,6 +41,9 @@ type Controller interface {
 
 	// GetIdentifier returns ibft identifier made of public key and role (type)
 	GetIdentifier() []byte
+
+	// Close stops the background processes and the running instance (if any)
+	Close()
 }
 
 // Instance represents an iBFT instance (a single sequence number)
@@ -2,7 +2,9 @@ package ibft
 
 import (
 	"context"
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/eth2-key-manager/core"
 	"github.com/bloxapp/ssv/beacon"
 	"github.com/bloxapp/ssv/ibft/instance/eventqueue"
 	msgcontinmem "github.com/bloxapp/ssv/ibft/instance/msgcont/inmem"
@@ -31,6 +33,14 @@ func (s *testSigner) AddShare(shareKey *bls.SecretKey) error {
 	return nil
 }
 
+func (s *testSigner) RemoveShare(pubKey string) error {
+	return nil
+}
+
+func (s *testSigner) SlashingProtector() core.SlashingProtector {
+	return nil
+}
+
 func (s *testSigner) SignIBFTMessage(message *proto.Message, pk []byte) ([]byte, error) {
 	return nil, nil
 }
@@ -39,6 +49,22 @@ func (s *testSigner) SignAttestation(data *spec.AttestationData, duty *beacon.Du
 	return nil, nil, nil
 }
 
+func (s *testSigner) SignRandaoReveal(epoch spec.Epoch, pk []byte) ([]byte, []byte, error) {
+	return nil, nil, nil
+}
+
+func (s *testSigner) SignBeaconBlock(block *eth2spec.VersionedBeaconBlock, duty *beacon.Duty, pk []byte) (*eth2spec.VersionedSignedBeaconBlock, []byte, error) {
+	return nil, nil, nil
+}
+
+func (s *testSigner) SignSlot(slot spec.Slot, pk []byte) ([]byte, []byte, error) {
+	return nil, nil, nil
+}
+
+func (s *testSigner) SignAggregateAndProof(msg *spec.AggregateAndProof, duty *beacon.Duty, pk []byte) (*spec.SignedAggregateAndProof, []byte, error) {
+	return nil, nil, nil
+}
+
 func TestChangeRoundTimer(t *testing.T) {
 	secretKeys, nodes := GenerateNodes(4)
 	instance := &Instance{
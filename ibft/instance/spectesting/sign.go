@@ -2,7 +2,9 @@ package spectesting
 
 import (
 	"encoding/hex"
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/eth2-key-manager/core"
 	"github.com/bloxapp/ssv/beacon"
 	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/pkg/errors"
@@ -42,6 +44,15 @@ func (km *testKM) AddShare(shareKey *bls.SecretKey) error {
 	return nil
 }
 
+func (km *testKM) RemoveShare(pubKey string) error {
+	delete(km.keys, pubKey)
+	return nil
+}
+
+func (km *testKM) SlashingProtector() core.SlashingProtector {
+	return nil
+}
+
 func (km *testKM) getKey(key *bls.PublicKey) *bls.SecretKey {
 	return km.keys[key.SerializeToHexStr()]
 }
@@ -60,3 +71,19 @@ func (km *testKM) SignIBFTMessage(message *proto.Message, pk []byte) ([]byte, er
 func (km *testKM) SignAttestation(data *spec.AttestationData, duty *beacon.Duty, pk []byte) (*spec.Attestation, []byte, error) {
 	return nil, nil, nil
 }
+
+func (km *testKM) SignRandaoReveal(epoch spec.Epoch, pk []byte) ([]byte, []byte, error) {
+	return nil, nil, nil
+}
+
+func (km *testKM) SignBeaconBlock(block *eth2spec.VersionedBeaconBlock, duty *beacon.Duty, pk []byte) (*eth2spec.VersionedSignedBeaconBlock, []byte, error) {
+	return nil, nil, nil
+}
+
+func (km *testKM) SignSlot(slot spec.Slot, pk []byte) ([]byte, []byte, error) {
+	return nil, nil, nil
+}
+
+func (km *testKM) SignAggregateAndProof(msg *spec.AggregateAndProof, duty *beacon.Duty, pk []byte) (*spec.SignedAggregateAndProof, []byte, error) {
+	return nil, nil, nil
+}
@@ -3,8 +3,10 @@ package main
 import (
 	"context"
 	"encoding/hex"
+	"github.com/bloxapp/eth2-key-manager/core"
 	"time"
 
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/bloxapp/ssv/beacon"
 	"github.com/bloxapp/ssv/ibft"
@@ -84,6 +86,15 @@ func (km *testSigner) AddShare(shareKey *bls.SecretKey) error {
 	return nil
 }
 
+func (km *testSigner) RemoveShare(pubKey string) error {
+	delete(km.keys, pubKey)
+	return nil
+}
+
+func (km *testSigner) SlashingProtector() core.SlashingProtector {
+	return nil
+}
+
 func (km *testSigner) getKey(key *bls.PublicKey) *bls.SecretKey {
 	return km.keys[key.SerializeToHexStr()]
 }
@@ -103,6 +114,22 @@ func (km *testSigner) SignAttestation(data *spec.AttestationData, duty *beacon.D
 	return nil, nil, nil
 }
 
+func (km *testSigner) SignRandaoReveal(epoch spec.Epoch, pk []byte) ([]byte, []byte, error) {
+	return nil, nil, nil
+}
+
+func (km *testSigner) SignBeaconBlock(block *eth2spec.VersionedBeaconBlock, duty *beacon.Duty, pk []byte) (*eth2spec.VersionedSignedBeaconBlock, []byte, error) {
+	return nil, nil, nil
+}
+
+func (km *testSigner) SignSlot(slot spec.Slot, pk []byte) ([]byte, []byte, error) {
+	return nil, nil, nil
+}
+
+func (km *testSigner) SignAggregateAndProof(msg *spec.AggregateAndProof, duty *beacon.Duty, pk []byte) (*spec.SignedAggregateAndProof, []byte, error) {
+	return nil, nil, nil
+}
+
 func db() collections.Iibft {
 	db, err := storage.GetStorageFactory(basedb.Options{
 		Type:   "badger-memory",
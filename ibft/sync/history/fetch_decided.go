@@ -42,6 +42,21 @@ func (s *Sync) fetchValidateAndSaveInstances(fromPeer string, startSeq uint64, e
 			continue
 		}
 
+		// the peer pruned the requested sequences, continuing from the lowest sequence it keeps
+		if res.Error == network.DecidedPrunedError && len(res.Params) == 1 && res.Params[0] > start {
+			if res.Params[0] > endSeq {
+				return highestSaved, n, errors.Errorf("peer pruned the requested range, lowest sequence is %d", res.Params[0])
+			}
+			s.logger.Info("peer pruned decided history, skipping to its lowest sequence",
+				zap.String("peer", fromPeer), zap.Uint64("from", start), zap.Uint64("lowest", res.Params[0]))
+			// the skipped sequences are recorded as pruned, so this node won't serve the incomplete range to its peers
+			if _, _, err := s.ibftStorage.PruneDecided(s.identifier, res.Params[0]); err != nil {
+				return highestSaved, n, errors.Wrap(err, "could not record skipped sequences as pruned")
+			}
+			start = res.Params[0]
+			continue
+		}
+
 		// organize signed msgs into a map where the key is the sequence number
 		// This is for verifying all expected sequence numbers where returned from peer
 		foundSeqs := make(map[uint64]*proto.SignedMessage)
@@ -135,3 +135,36 @@ func TestFetchDecided(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchDecided_Pruned(t *testing.T) {
+	sks, _ := sync.GenerateNodes(4)
+	identifier := []byte("lambda")
+	decided := sync.DecidedArr(t, 10, sks, identifier)
+	storage := sync.TestingIbftStorage(t)
+	net := sync.NewTestNetwork(t, []string{"2"}, 3, nil, nil,
+		map[string][]*proto.SignedMessage{"2": decided[6:]}, nil, nil, func(s string) network.SyncStream {
+			return nil
+		})
+	net.SetPrunedBelow("2", 6)
+	s := New(zap.L(), []byte{1, 2, 3, 4}, 4, identifier, net, &storage, func(msg *proto.SignedMessage) error {
+		return nil
+	})
+
+	highest, n, err := s.fetchValidateAndSaveInstances("2", 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+	require.EqualValues(t, 10, highest.Message.SeqNumber)
+	_, found, err := storage.GetDecided(identifier, 5)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = storage.GetDecided(identifier, 6)
+	require.NoError(t, err)
+	require.True(t, found)
+	// the skipped sequences are served as pruned
+	prunedBelow, err := storage.GetPrunedBelow(identifier)
+	require.NoError(t, err)
+	require.EqualValues(t, 6, prunedBelow)
+
+	_, _, err = s.fetchValidateAndSaveInstances("2", 0, 4)
+	require.EqualError(t, err, "peer pruned the requested range, lowest sequence is 6")
+}
@@ -16,6 +16,10 @@ func (s *ReqHandler) handleGetDecidedReq(msg *network.SyncChanObj) {
 
 	if err := s.validateGetDecidedReq(msg); err != nil {
 		retMsg.Error = errors.Wrap(err, "invalid get decided request").Error()
+	} else if prunedBelow := s.prunedBelow(); msg.Msg.Params[0] < prunedBelow {
+		// the requester should continue from the lowest sequence that is kept
+		retMsg.Error = network.DecidedPrunedError
+		retMsg.Params = []uint64{prunedBelow}
 	} else {
 		// enforce max page size
 		startSeq := msg.Msg.Params[0]
@@ -39,6 +43,16 @@ func (s *ReqHandler) handleGetDecidedReq(msg *network.SyncChanObj) {
 	}
 }
 
+// prunedBelow returns the sequence number below which decided messages were pruned
+func (s *ReqHandler) prunedBelow() uint64 {
+	prunedBelow, err := s.storage.GetPrunedBelow(s.identifier)
+	if err != nil {
+		s.logger.Warn("could not get pruned sequence", zap.Error(err))
+		return 0
+	}
+	return prunedBelow
+}
+
 func (s *ReqHandler) validateGetDecidedReq(msg *network.SyncChanObj) error {
 	if msg.Msg == nil {
 		return errors.New("sync msg invalid: sync msg nil")
@@ -177,3 +177,45 @@ func TestTestNetwork_GetDecidedByRange(t *testing.T) {
 		})
 	}
 }
+
+func TestReqHandler_GetDecidedPruned(t *testing.T) {
+	sks, _ := sync.GenerateNodes(4)
+	ibftStorage := sync.TestingIbftStorage(t)
+	for _, d := range sync.DecidedArr(t, 20, sks, []byte("lambda")) {
+		require.NoError(t, ibftStorage.SaveDecided(d))
+	}
+	_, _, err := ibftStorage.PruneDecided([]byte("lambda"), 10)
+	require.NoError(t, err)
+
+	s := sync.NewTestStream("")
+	handler := ReqHandler{
+		paginationMaxSize: 100,
+		identifier:        []byte("lambda"),
+		network: sync.NewTestNetwork(t, nil, 100, nil, nil, nil, nil, nil, func(streamID string) network.SyncStream {
+			return s
+		}),
+		storage: &ibftStorage,
+		logger:  zap.L(),
+	}
+	request := func(from, to uint64) *network.SyncMessage {
+		handler.handleGetDecidedReq(&network.SyncChanObj{
+			Msg: &network.SyncMessage{
+				Params: []uint64{from, to},
+				Lambda: []byte("lambda"),
+			},
+			StreamID: s.ID(),
+		})
+		res := &network.Message{}
+		require.NoError(t, json.Unmarshal(<-s.C, res))
+		return res.SyncMessage
+	}
+
+	res := request(5, 15)
+	require.Equal(t, network.DecidedPrunedError, res.Error)
+	require.Equal(t, []uint64{10}, res.Params)
+	require.Len(t, res.SignedMessages, 0)
+
+	res = request(10, 15)
+	require.Len(t, res.Error, 0)
+	require.Len(t, res.SignedMessages, 6)
+}
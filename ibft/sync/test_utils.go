@@ -95,6 +95,7 @@ type TestNetwork struct {
 	peers                  []string
 	retError               error
 	streamProvider         func(string) network.SyncStream
+	prunedBelow            map[string]uint64
 }
 
 // NewTestNetwork returns a new test network instance
@@ -121,6 +122,14 @@ func NewTestNetwork(
 	}
 }
 
+// SetPrunedBelow simulates a peer that pruned the decided messages below the given sequence
+func (n *TestNetwork) SetPrunedBelow(peer string, seq uint64) {
+	if n.prunedBelow == nil {
+		n.prunedBelow = make(map[string]uint64)
+	}
+	n.prunedBelow[peer] = seq
+}
+
 // Broadcast impl
 func (n *TestNetwork) Broadcast(topicName []byte, msg *proto.SignedMessage) error {
 	return nil
@@ -141,6 +150,16 @@ func (n *TestNetwork) ReceivedSignatureChan() (<-chan *proto.SignedMessage, func
 	return nil, func() {}
 }
 
+// BroadcastPreConsensusSignature impl
+func (n *TestNetwork) BroadcastPreConsensusSignature(topicName []byte, msg *proto.SignedMessage) error {
+	return nil
+}
+
+// ReceivedPreConsensusSignatureChan impl
+func (n *TestNetwork) ReceivedPreConsensusSignatureChan() (<-chan *proto.SignedMessage, func()) {
+	return nil, func() {}
+}
+
 // BroadcastDecided impl
 func (n *TestNetwork) BroadcastDecided(topicName []byte, msg *proto.SignedMessage) error {
 	return nil
@@ -202,6 +221,16 @@ func (n *TestNetwork) GetDecidedByRange(peerStr string, msg *network.SyncMessage
 		return nil, n.retError
 	}
 
+	if prunedBelow, found := n.prunedBelow[peerStr]; found && msg.Params[0] < prunedBelow {
+		return &network.SyncMessage{
+			FromPeerID: peerStr,
+			Lambda:     msg.Lambda,
+			Params:     []uint64{prunedBelow},
+			Error:      network.DecidedPrunedError,
+			Type:       network.Sync_GetInstanceRange,
+		}, nil
+	}
+
 	if arr, found := n.decidedArr[peerStr]; found {
 		if !bytes.Equal(msg.Lambda, arr[0].Message.Lambda) {
 			return nil, errors.New("could not find highest")
@@ -262,6 +291,11 @@ func (n *TestNetwork) SubscribeToValidatorNetwork(validatorPk *bls.PublicKey) er
 	return nil
 }
 
+// UnsubscribeFromValidatorNetwork implementation
+func (n *TestNetwork) UnsubscribeFromValidatorNetwork(validatorPk *bls.PublicKey) error {
+	return nil
+}
+
 // AllPeers returns all connected peers for a validator PK
 func (n *TestNetwork) AllPeers(validatorPk []byte) ([]string, error) {
 	return n.peers, nil
@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/bloxapp/ssv/storage/collections"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// migrationDecidedKeys re-writes decided messages with keys that are ordered by sequence number
+var migrationDecidedKeys = Migration{
+	Name: "migration_6_decided_keys",
+	Run: func(ctx context.Context, opt Options, key []byte) error {
+		n, err := collections.MigrateDecidedKeys(opt.Db)
+		if err != nil {
+			return errors.Wrap(err, "could not migrate decided keys")
+		}
+		opt.Logger.Debug("decided keys were migrated", zap.Int("count", n))
+		return opt.Db.Set(migrationsPrefix, key, migrationCompleted)
+	},
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// migrationRegistryIndexes builds the indexes of the stored operators and validators from the index of each record
+var migrationRegistryIndexes = Migration{
+	Name: "migration_7_registry_indexes",
+	Run: func(ctx context.Context, opt Options, key []byte) error {
+		exporterStorage := opt.exporterStorage()
+		n, err := exporterStorage.BuildOperatorsIndex()
+		if err != nil {
+			return errors.Wrap(err, "could not build exporter operators index")
+		}
+		opt.Logger.Debug("exporter operators were indexed", zap.Int("count", n))
+		if n, err = exporterStorage.BuildValidatorsIndex(); err != nil {
+			return errors.Wrap(err, "could not build exporter validators index")
+		}
+		opt.Logger.Debug("exporter validators were indexed", zap.Int("count", n))
+		if n, err = opt.nodeStorage().BuildOperatorsIndex(); err != nil {
+			return errors.Wrap(err, "could not build operators index")
+		}
+		opt.Logger.Debug("operators were indexed", zap.Int("count", n))
+		return opt.Db.Set(migrationsPrefix, key, migrationCompleted)
+	},
+}
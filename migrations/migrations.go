@@ -23,6 +23,8 @@ var (
 		migrationCleanOperatorNodeRegistryData,
 		migrationCleanExporterRegistryData,
 		migrationCleanValidatorRegistryData,
+		migrationDecidedKeys,
+		migrationRegistryIndexes,
 	}
 )
 
@@ -31,6 +33,16 @@ func Run(ctx context.Context, opt Options) error {
 	return defaultMigrations.Run(ctx, opt)
 }
 
+// Version returns the migrations version of the given db, see Migrations.Version.
+func Version(db basedb.IDb) (int, error) {
+	return defaultMigrations.Version(db)
+}
+
+// CheckVersion returns an error if the given migrations version is not supported, see Migrations.CheckVersion.
+func CheckVersion(version int) error {
+	return defaultMigrations.CheckVersion(version)
+}
+
 // MigrationFunc is a function that performs a migration.
 type MigrationFunc func(ctx context.Context, opt Options, key []byte) error
 
@@ -97,3 +109,36 @@ func (m Migrations) Run(ctx context.Context, opt Options) error {
 
 	return nil
 }
+
+// Version returns the number of migrations that were applied to the given db.
+// An error is returned if the db has migrations that are unknown, i.e. it was migrated by a newer version of the node.
+func (m Migrations) Version(db basedb.IDb) (int, error) {
+	known := make(map[string]bool, len(m))
+	for _, migration := range m {
+		known[migration.Name] = true
+	}
+	version := 0
+	err := db.GetAll(migrationsPrefix, func(i int, obj basedb.Obj) error {
+		if !bytes.Equal(obj.Value, migrationCompleted) {
+			return nil
+		}
+		if !known[string(obj.Key)] {
+			return errors.Errorf("unknown migration %q", string(obj.Key))
+		}
+		version++
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not read migrations")
+	}
+	return version, nil
+}
+
+// CheckVersion returns an error if a db of the given migrations version can't be used,
+// i.e. it has more migrations than the known ones.
+func (m Migrations) CheckVersion(version int) error {
+	if version > len(m) {
+		return errors.Errorf("migrations version %d is newer than the supported version %d", version, len(m))
+	}
+	return nil
+}
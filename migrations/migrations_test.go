@@ -139,6 +139,32 @@ func Test_DeprecatedMigrationFakeApplied(t *testing.T) {
 	require.True(t, found)
 }
 
+func Test_Version(t *testing.T) {
+	ctx := context.Background()
+	opt, err := setupOptions(ctx, t)
+	require.NoError(t, err)
+
+	migrations := Migrations{
+		fakeMigration("first", nil),
+		fakeMigration("second", nil),
+	}
+	version, err := migrations.Version(opt.Db)
+	require.NoError(t, err)
+	require.Equal(t, 0, version)
+
+	require.NoError(t, migrations.Run(ctx, opt))
+	version, err = migrations.Version(opt.Db)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+	require.NoError(t, migrations.CheckVersion(version))
+
+	// a db that was migrated by a newer node
+	require.NoError(t, Migrations{fakeMigration("third", nil)}.Run(ctx, opt))
+	_, err = migrations.Version(opt.Db)
+	require.EqualError(t, err, "could not read migrations: unknown migration \"third\"")
+	require.Error(t, migrations.CheckVersion(3))
+}
+
 func fakeMigration(name string, returnErr error) Migration {
 	return Migration{
 		Name: name,
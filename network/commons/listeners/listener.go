@@ -14,6 +14,7 @@ const (
 type Listener struct {
 	msgCh     chan *proto.SignedMessage
 	sigCh     chan *proto.SignedMessage
+	preSigCh  chan *proto.SignedMessage
 	decidedCh chan *proto.SignedMessage
 	syncCh    chan *network.SyncChanObj
 
@@ -31,6 +32,11 @@ func (l *Listener) SigChan() chan *proto.SignedMessage {
 	return l.sigCh
 }
 
+// PreConsensusSigChan returns the underlying pre consensus signature channel
+func (l *Listener) PreConsensusSigChan() chan *proto.SignedMessage {
+	return l.preSigCh
+}
+
 // DecidedChan returns the underlying decided channel
 func (l *Listener) DecidedChan() chan *proto.SignedMessage {
 	return l.decidedCh
@@ -54,6 +60,11 @@ func NewListener(msgType network.NetworkMsg) *Listener {
 			sigCh:   make(chan *proto.SignedMessage, MsgChanSize),
 			msgType: network.NetworkMsg_SignatureType,
 		}
+	case network.NetworkMsg_PreConsensusSignatureType:
+		return &Listener{
+			preSigCh: make(chan *proto.SignedMessage, MsgChanSize),
+			msgType:  network.NetworkMsg_PreConsensusSignatureType,
+		}
 	case network.NetworkMsg_DecidedType:
 		return &Listener{
 			decidedCh: make(chan *proto.SignedMessage, MsgChanSize),
@@ -17,3 +17,18 @@ type encoding interface {
 	EncodeNetworkMsg(msg *network.Message) ([]byte, error)
 	DecodeNetworkMsg(data []byte) (*network.Message, error)
 }
+
+// SubnetsFork is implemented by forks that map validators into a fixed number of subnets,
+// where each subnet is a single pubsub topic shared by many validators
+type SubnetsFork interface {
+	Fork
+	// SubnetsCount returns the number of subnets
+	SubnetsCount() int
+	// ValidatorSubnet returns the subnet of the given validator public key
+	ValidatorSubnet(pk []byte) int
+	// SubnetTopicID returns the topic of the given subnet
+	SubnetTopicID(subnet int) string
+	// ValidatorTopicIDs returns the topics that the given validator should be subscribed to,
+	// which might be more than one during the transition to subnets
+	ValidatorTopicIDs(pk []byte) []string
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"github.com/bloxapp/ssv/network"
+	protobuf "github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+// jsonPrefix is the first byte of messages that were encoded by v0
+const jsonPrefix = '{'
+
+// EncodeNetworkMsg - version 1, encodes the message as snappy compressed protobuf once the fork slot was reached
+func (v1 *ForkV1) EncodeNetworkMsg(msg *network.Message) ([]byte, error) {
+	if !v1.isForked() {
+		return v1.genesis.EncodeNetworkMsg(msg)
+	}
+	data, err := protobuf.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal message")
+	}
+	return snappy.Encode(nil, data), nil
+}
+
+// DecodeNetworkMsg - version 1, decodes both v0 and v1 messages
+func (v1 *ForkV1) DecodeNetworkMsg(data []byte) (*network.Message, error) {
+	if len(data) > 0 && data[0] == jsonPrefix {
+		// a snappy block might start with the same byte, therefore falling back to v1 decoding
+		if msg, err := v1.genesis.DecodeNetworkMsg(data); err == nil {
+			return msg, nil
+		}
+	}
+	raw, err := snappy.Decode(nil, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress message")
+	}
+	msg := &network.Message{}
+	if err := protobuf.Unmarshal(raw, msg); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal message")
+	}
+	return msg, nil
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/bloxapp/ssv/ibft/proto"
+	"github.com/bloxapp/ssv/network"
+	v0 "github.com/bloxapp/ssv/network/forks/v0"
+	protobuf "github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+func testMessage() *network.Message {
+	return &network.Message{
+		SignedMessage: &proto.SignedMessage{
+			Message: &proto.Message{
+				Type:      proto.RoundState_Commit,
+				Round:     2,
+				Lambda:    []byte("lambda"),
+				SeqNumber: 10,
+				Value:     []byte("value"),
+			},
+			Signature: []byte("signature"),
+			SignerIds: []uint64{1, 2, 3},
+		},
+		Type: network.NetworkMsg_DecidedType,
+	}
+}
+
+func TestForkV1_Encoding(t *testing.T) {
+	fork := New(100)
+
+	t.Run("before fork slot", func(t *testing.T) {
+		fork.SlotTick(99)
+		msg := testMessage()
+		data, err := fork.EncodeNetworkMsg(msg)
+		require.NoError(t, err)
+		expected, err := v0.New().EncodeNetworkMsg(msg)
+		require.NoError(t, err)
+		require.Equal(t, expected, data)
+
+		decoded, err := fork.DecodeNetworkMsg(data)
+		require.NoError(t, err)
+		require.True(t, protobuf.Equal(msg.SignedMessage, decoded.SignedMessage))
+	})
+
+	t.Run("after fork slot", func(t *testing.T) {
+		fork.SlotTick(100)
+		msg := testMessage()
+		data, err := fork.EncodeNetworkMsg(msg)
+		require.NoError(t, err)
+		raw, err := snappy.Decode(nil, data)
+		require.NoError(t, err)
+		require.NotEqual(t, byte(jsonPrefix), data[0])
+
+		decoded, err := fork.DecodeNetworkMsg(data)
+		require.NoError(t, err)
+		require.True(t, protobuf.Equal(msg.SignedMessage, decoded.SignedMessage))
+		require.Equal(t, msg.Type, decoded.Type)
+		require.Nil(t, decoded.SyncMessage)
+
+		// the embedded message is encoded as the generated protobuf message
+		smData, err := protobuf.Marshal(msg.SignedMessage)
+		require.NoError(t, err)
+		require.Contains(t, string(raw), string(smData))
+	})
+
+	t.Run("sync message", func(t *testing.T) {
+		fork.SlotTick(101)
+		msg := &network.Message{
+			SyncMessage: &network.SyncMessage{
+				SignedMessages: []*proto.SignedMessage{testMessage().SignedMessage},
+				FromPeerID:     "peer",
+				Params:         []uint64{1, 5},
+				Lambda:         []byte("lambda"),
+				Type:           network.Sync_GetInstanceRange,
+			},
+			Type:     network.NetworkMsg_SyncType,
+			StreamID: "stream",
+		}
+		data, err := fork.EncodeNetworkMsg(msg)
+		require.NoError(t, err)
+		decoded, err := fork.DecodeNetworkMsg(data)
+		require.NoError(t, err)
+		require.True(t, protobuf.Equal(msg.SyncMessage, decoded.SyncMessage))
+		require.Equal(t, msg.Type, decoded.Type)
+		require.Equal(t, msg.StreamID, decoded.StreamID)
+	})
+
+	t.Run("decodes v0 messages after fork slot", func(t *testing.T) {
+		fork.SlotTick(200)
+		msg := testMessage()
+		data, err := v0.New().EncodeNetworkMsg(msg)
+		require.NoError(t, err)
+		decoded, err := fork.DecodeNetworkMsg(data)
+		require.NoError(t, err)
+		require.True(t, protobuf.Equal(msg.SignedMessage, decoded.SignedMessage))
+		require.Equal(t, msg.Type, decoded.Type)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		_, err := fork.DecodeNetworkMsg([]byte("{invalid"))
+		require.Error(t, err)
+		_, err = fork.DecodeNetworkMsg(snappy.Encode(nil, []byte{0xff}))
+		require.Error(t, err)
+	})
+}
+
+func TestForkV1_UnknownFields(t *testing.T) {
+	buf := protobuf.NewBuffer(nil)
+	require.NoError(t, buf.EncodeVarint(fieldKey(3, protobuf.WireVarint)))
+	require.NoError(t, buf.EncodeVarint(uint64(network.NetworkMsg_SignatureType)))
+	require.NoError(t, buf.EncodeVarint(fieldKey(10, protobuf.WireBytes)))
+	require.NoError(t, buf.EncodeStringBytes("future field"))
+	require.NoError(t, buf.EncodeVarint(fieldKey(11, protobuf.WireVarint)))
+	require.NoError(t, buf.EncodeVarint(7))
+
+	decoded, err := New(0).DecodeNetworkMsg(snappy.Encode(nil, buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, network.NetworkMsg_SignatureType, decoded.Type)
+}
+
+// fieldKey returns the protobuf key of the given field number and wire type
+func fieldKey(field int, wireType int) uint64 {
+	return uint64(field)<<3 | uint64(wireType)
+}
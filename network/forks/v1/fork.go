@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"sync/atomic"
+
+	"github.com/bloxapp/ssv/network/forks"
+	v0 "github.com/bloxapp/ssv/network/forks/v0"
+)
+
+// ForkV1 encodes network messages as snappy compressed protobuf.
+// the fork is activated on the given slot, before that messages are encoded as in v0,
+// while both encodings are decoded so peers can switch independently during the transition
+type ForkV1 struct {
+	genesis     forks.Fork
+	forkSlot    uint64
+	currentSlot uint64
+}
+
+// New returns an instance of ForkV1 that is activated on the given slot
+func New(forkSlot uint64) forks.Fork {
+	return &ForkV1{
+		genesis:  v0.New(),
+		forkSlot: forkSlot,
+	}
+}
+
+// SlotTick implementation
+func (v1 *ForkV1) SlotTick(slot uint64) {
+	atomic.StoreUint64(&v1.currentSlot, slot)
+	v1.genesis.SlotTick(slot)
+}
+
+// isForked returns true once the fork slot was reached
+func (v1 *ForkV1) isForked() bool {
+	return atomic.LoadUint64(&v1.currentSlot) >= v1.forkSlot
+}
@@ -0,0 +1,6 @@
+package v1
+
+// ValidatorTopicID - topics are not changed in version 1
+func (v1 *ForkV1) ValidatorTopicID(pkByts []byte) string {
+	return v1.genesis.ValidatorTopicID(pkByts)
+}
@@ -0,0 +1,51 @@
+package v2
+
+import (
+	"sync/atomic"
+
+	"github.com/bloxapp/ssv/network/forks"
+	v1 "github.com/bloxapp/ssv/network/forks/v1"
+)
+
+// TransitionSlots is the number of slots before and after the fork slot, in which validators are subscribed
+// to both their own topic and their subnet topic, so peers with slightly different clocks can switch independently
+const TransitionSlots = 64
+
+// ForkV2 maps validators into a fixed number of subnets instead of a topic per validator,
+// messages are encoded as in v1.
+// the fork is activated on the given slot, before that messages are published on the topic of each validator (v1)
+type ForkV2 struct {
+	forks.Fork
+	forkSlot    uint64
+	currentSlot uint64
+}
+
+// New returns an instance of ForkV2, the encoding of v1 is activated on the given encoding fork slot
+// and subnets are activated on the given subnets fork slot
+func New(encodingForkSlot, subnetsForkSlot uint64) forks.SubnetsFork {
+	return &ForkV2{
+		Fork:     v1.New(encodingForkSlot),
+		forkSlot: subnetsForkSlot,
+	}
+}
+
+// SlotTick implementation
+func (v2 *ForkV2) SlotTick(slot uint64) {
+	atomic.StoreUint64(&v2.currentSlot, slot)
+	v2.Fork.SlotTick(slot)
+}
+
+// isForked returns true once the fork slot was reached
+func (v2 *ForkV2) isForked() bool {
+	return atomic.LoadUint64(&v2.currentSlot) >= v2.forkSlot
+}
+
+// inTransition returns true if the current slot is within the transition slots around the fork slot,
+// there is no transition if subnets are active from genesis
+func (v2 *ForkV2) inTransition() bool {
+	if v2.forkSlot == 0 {
+		return false
+	}
+	slot := atomic.LoadUint64(&v2.currentSlot)
+	return slot+TransitionSlots >= v2.forkSlot && slot < v2.forkSlot+TransitionSlots
+}
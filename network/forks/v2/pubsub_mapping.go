@@ -0,0 +1,44 @@
+package v2
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// SubnetsCount is the number of subnets that validators are mapped into
+const SubnetsCount = 128
+
+// ValidatorTopicID - version 2, returns the subnet topic of the given validator once the fork was activated,
+// or the topic of the validator (v1) before that
+func (v2 *ForkV2) ValidatorTopicID(pkByts []byte) string {
+	if !v2.isForked() {
+		return v2.Fork.ValidatorTopicID(pkByts)
+	}
+	return v2.SubnetTopicID(v2.ValidatorSubnet(pkByts))
+}
+
+// ValidatorTopicIDs returns the topics that the given validator should be subscribed to,
+// during the transition both the topic of the validator and its subnet topic are returned
+func (v2 *ForkV2) ValidatorTopicIDs(pkByts []byte) []string {
+	if !v2.inTransition() {
+		return []string{v2.ValidatorTopicID(pkByts)}
+	}
+	return []string{v2.Fork.ValidatorTopicID(pkByts), v2.SubnetTopicID(v2.ValidatorSubnet(pkByts))}
+}
+
+// SubnetTopicID returns the topic of the given subnet
+func (v2 *ForkV2) SubnetTopicID(subnet int) string {
+	return fmt.Sprintf("subnet.%d", subnet)
+}
+
+// SubnetsCount returns the number of subnets
+func (v2 *ForkV2) SubnetsCount() int {
+	return SubnetsCount
+}
+
+// ValidatorSubnet returns the subnet of the given validator, based on the hash of its public key
+func (v2 *ForkV2) ValidatorSubnet(pkByts []byte) int {
+	h := sha256.Sum256(pkByts)
+	return int(binary.BigEndian.Uint64(h[:8]) % SubnetsCount)
+}
@@ -0,0 +1,62 @@
+package v2
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/bloxapp/ssv/utils/threshold"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkV2_ValidatorTopicID(t *testing.T) {
+	threshold.Init()
+	fork := New(0, 0)
+
+	subnets := make(map[int]bool)
+	for i := 0; i < 1000; i++ {
+		sk := bls.SecretKey{}
+		sk.SetByCSPRNG()
+		pk := sk.GetPublicKey().Serialize()
+
+		subnet := fork.ValidatorSubnet(pk)
+		require.True(t, subnet >= 0 && subnet < SubnetsCount)
+		require.Equal(t, subnet, fork.ValidatorSubnet(pk))
+		require.Equal(t, fmt.Sprintf("subnet.%d", subnet), fork.ValidatorTopicID(pk))
+		require.Equal(t, []string{fork.ValidatorTopicID(pk)}, fork.ValidatorTopicIDs(pk))
+		subnets[subnet] = true
+	}
+	// validators are spread across the subnets
+	require.Greater(t, len(subnets), SubnetsCount/2)
+}
+
+func TestForkV2_Transition(t *testing.T) {
+	threshold.Init()
+	fork := New(0, 1000)
+	sk := bls.SecretKey{}
+	sk.SetByCSPRNG()
+	pk := sk.GetPublicKey().Serialize()
+	validatorTopic := hex.EncodeToString(pk)
+	subnetTopic := fmt.Sprintf("subnet.%d", fork.ValidatorSubnet(pk))
+
+	tests := []struct {
+		slot           uint64
+		topic          string
+		expectedTopics []string
+	}{
+		{0, validatorTopic, []string{validatorTopic}},
+		{1000 - TransitionSlots - 1, validatorTopic, []string{validatorTopic}},
+		{1000 - TransitionSlots, validatorTopic, []string{validatorTopic, subnetTopic}},
+		{1000, subnetTopic, []string{validatorTopic, subnetTopic}},
+		{1000 + TransitionSlots - 1, subnetTopic, []string{validatorTopic, subnetTopic}},
+		{1000 + TransitionSlots, subnetTopic, []string{subnetTopic}},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("slot %d", test.slot), func(t *testing.T) {
+			fork.SlotTick(test.slot)
+			require.Equal(t, test.topic, fork.ValidatorTopicID(pk))
+			require.Equal(t, test.expectedTopics, fork.ValidatorTopicIDs(pk))
+		})
+	}
+}
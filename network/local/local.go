@@ -3,6 +3,7 @@ package local
 import (
 	"fmt"
 	"github.com/bloxapp/ssv/network"
+	"github.com/bloxapp/ssv/network/validation"
 	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
@@ -16,12 +17,14 @@ type Local struct {
 	localPeerID        peer.ID
 	msgC               []chan *proto.SignedMessage
 	sigC               []chan *proto.SignedMessage
+	preSigC            []chan *proto.SignedMessage
 	decidedC           []chan *proto.SignedMessage
 	syncC              []chan *network.SyncChanObj
 	syncPeers          map[string]chan *network.SyncChanObj
 	createChannelMutex *sync.Mutex
 	streamsMut         *sync.Mutex
 	streams            map[string]network.SyncStream
+	msgValidator       validation.MsgValidator
 }
 
 // NewLocalNetwork creates a new instance of a local network
@@ -29,6 +32,7 @@ func NewLocalNetwork() *Local {
 	return &Local{
 		msgC:               make([]chan *proto.SignedMessage, 0),
 		sigC:               make([]chan *proto.SignedMessage, 0),
+		preSigC:            make([]chan *proto.SignedMessage, 0),
 		decidedC:           make([]chan *proto.SignedMessage, 0),
 		syncC:              make([]chan *network.SyncChanObj, 0),
 		syncPeers:          make(map[string]chan *network.SyncChanObj),
@@ -45,12 +49,14 @@ func (n *Local) CopyWithLocalNodeID(id peer.ID) *Local {
 		localPeerID:        id,
 		msgC:               n.msgC,
 		sigC:               n.sigC,
+		preSigC:            n.preSigC,
 		decidedC:           n.decidedC,
 		syncC:              n.syncC,
 		syncPeers:          n.syncPeers,
 		createChannelMutex: n.createChannelMutex,
 		streamsMut:         n.streamsMut,
 		streams:            n.streams,
+		msgValidator:       n.msgValidator,
 	}
 }
 
@@ -74,6 +80,9 @@ func (n *Local) ReceivedSignatureChan() (<-chan *proto.SignedMessage, func()) {
 
 // Broadcast implements network.Local interface
 func (n *Local) Broadcast(topicName []byte, signed *proto.SignedMessage) error {
+	if !n.isValid(network.NetworkMsg_IBFTType, signed) {
+		return nil
+	}
 	go func() {
 		for _, c := range n.msgC {
 			c <- signed
@@ -85,6 +94,9 @@ func (n *Local) Broadcast(topicName []byte, signed *proto.SignedMessage) error {
 
 // BroadcastSignature broadcasts the given signature for the given lambda
 func (n *Local) BroadcastSignature(topicName []byte, msg *proto.SignedMessage) error {
+	if !n.isValid(network.NetworkMsg_SignatureType, msg) {
+		return nil
+	}
 	n.createChannelMutex.Lock()
 	go func() {
 		for _, c := range n.sigC {
@@ -95,8 +107,35 @@ func (n *Local) BroadcastSignature(topicName []byte, msg *proto.SignedMessage) e
 	return nil
 }
 
+// ReceivedPreConsensusSignatureChan returns the channel with pre consensus signatures
+func (n *Local) ReceivedPreConsensusSignatureChan() (<-chan *proto.SignedMessage, func()) {
+	n.createChannelMutex.Lock()
+	defer n.createChannelMutex.Unlock()
+	c := make(chan *proto.SignedMessage)
+	n.preSigC = append(n.preSigC, c)
+	return c, func() {}
+}
+
+// BroadcastPreConsensusSignature broadcasts the given pre consensus signature for the given lambda
+func (n *Local) BroadcastPreConsensusSignature(topicName []byte, msg *proto.SignedMessage) error {
+	if !n.isValid(network.NetworkMsg_PreConsensusSignatureType, msg) {
+		return nil
+	}
+	n.createChannelMutex.Lock()
+	go func() {
+		for _, c := range n.preSigC {
+			c <- msg
+		}
+		n.createChannelMutex.Unlock()
+	}()
+	return nil
+}
+
 // BroadcastDecided broadcasts a decided instance with collected signatures
 func (n *Local) BroadcastDecided(topicName []byte, msg *proto.SignedMessage) error {
+	if !n.isValid(network.NetworkMsg_DecidedType, msg) {
+		return nil
+	}
 	n.createChannelMutex.Lock()
 	go func() {
 		for _, c := range n.decidedC {
@@ -187,6 +226,11 @@ func (n *Local) SubscribeToValidatorNetwork(validatorPk *bls.PublicKey) error {
 	return nil
 }
 
+// UnsubscribeFromValidatorNetwork implementation
+func (n *Local) UnsubscribeFromValidatorNetwork(validatorPk *bls.PublicKey) error {
+	return nil
+}
+
 // AllPeers returns all connected peers for a validator PK
 func (n *Local) AllPeers(validatorPk []byte) ([]string, error) {
 	ret := make([]string, 0)
@@ -214,3 +258,17 @@ func (n *Local) SubscribeToMainTopic() error {
 // NotifyOperatorID implementation
 func (n *Local) NotifyOperatorID(oid string) {
 }
+
+// UseMsgValidator sets the validator of broadcasted messages
+func (n *Local) UseMsgValidator(mv validation.MsgValidator) {
+	n.msgValidator = mv
+}
+
+// isValid runs the message validator on the given message, messages that were not accepted are dropped as in p2p network
+func (n *Local) isValid(msgType network.NetworkMsg, msg *proto.SignedMessage) bool {
+	if n.msgValidator == nil {
+		return true
+	}
+	res, _ := n.msgValidator.ValidateMessage(&network.Message{SignedMessage: msg, Type: msgType})
+	return res == validation.Accept
+}
@@ -3,6 +3,8 @@ package local
 import (
 	"github.com/bloxapp/ssv/ibft/proto"
 	"github.com/bloxapp/ssv/network"
+	"github.com/bloxapp/ssv/network/validation"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"sync"
 	"testing"
@@ -170,3 +172,34 @@ func TestGetAllPeers(t *testing.T) {
 	require.NoError(t, err)
 	require.ElementsMatch(t, []string{"0", "1", "2", "3"}, res)
 }
+
+// rejectSignersValidator rejects messages of the given signer
+type rejectSignersValidator uint64
+
+func (r rejectSignersValidator) ValidateMessage(msg *network.Message) (validation.Result, error) {
+	if msg.SignedMessage.SignerIds[0] == uint64(r) {
+		return validation.Reject, errors.New("rejected signer")
+	}
+	return validation.Accept, nil
+}
+
+func TestMsgValidation(t *testing.T) {
+	net := NewLocalNetwork()
+	require.True(t, validation.UseMsgValidator(net, rejectSignersValidator(2)))
+	c, _ := net.ReceivedMsgChan()
+
+	require.NoError(t, net.Broadcast([]byte{1}, &proto.SignedMessage{SignerIds: []uint64{2}}))
+	require.NoError(t, net.Broadcast([]byte{1}, &proto.SignedMessage{SignerIds: []uint64{1}}))
+
+	select {
+	case msg := <-c:
+		require.EqualValues(t, []uint64{1}, msg.SignerIds)
+	case <-time.After(time.Second):
+		require.FailNow(t, "valid message was not received")
+	}
+	select {
+	case msg := <-c:
+		require.FailNow(t, "rejected message was received", "signers: %v", msg.SignerIds)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
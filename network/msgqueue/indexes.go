@@ -51,6 +51,28 @@ func sigMessageIndex() IndexFunc {
 	}
 }
 
+// PreConsensusSigIndexKey is the SSV node pre consensus signature collection index key
+func PreConsensusSigIndexKey(lambda []byte, seqNumber uint64) string {
+	return fmt.Sprintf("pre_consensus_sig_lambda_%s_seqNumber_%d", hex.EncodeToString(lambda), seqNumber)
+}
+func preConsensusSigMessageIndex() IndexFunc {
+	return func(msg *network.Message) []string {
+		if msg.Type != network.NetworkMsg_PreConsensusSignatureType {
+			return []string{}
+		}
+		if msg.SignedMessage == nil || msg.SignedMessage.Message == nil {
+			return []string{}
+		}
+		if msg.SignedMessage.Message.Lambda == nil {
+			return []string{}
+		}
+
+		return []string{
+			PreConsensusSigIndexKey(msg.SignedMessage.Message.Lambda, msg.SignedMessage.Message.SeqNumber),
+		}
+	}
+}
+
 // DecidedIndexKey is the ibft decisions index key
 func DecidedIndexKey(lambda []byte) string {
 	return fmt.Sprintf("decided_lambda_%s", hex.EncodeToString(lambda))
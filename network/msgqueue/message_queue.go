@@ -37,6 +37,7 @@ func New() *MessageQueue {
 		indexFuncs: []IndexFunc{
 			iBFTMessageIndex(),
 			sigMessageIndex(),
+			preConsensusSigMessageIndex(),
 			decidedMessageIndex(),
 			syncMessageIndex(),
 		},
@@ -167,3 +168,12 @@ func (q *MessageQueue) PurgeIndexedMessages(index string) {
 
 	q.queue.SetDefault(index, make([]messageContainer, 0))
 }
+
+// Clean removes all the messages from the queue
+func (q *MessageQueue) Clean() {
+	q.msgMutex.Lock()
+	defer q.msgMutex.Unlock()
+
+	q.queue.Flush()
+	q.allMessages.Flush()
+}
@@ -118,3 +118,15 @@ func newNetMsg(lambda []byte, round, seq uint64, t network.NetworkMsg) *network.
 		Type: t,
 	}
 }
+
+func TestMessageQueue_Clean(t *testing.T) {
+	msgQ := New()
+	msgQ.AddMessage(newNetMsg([]byte{1, 2, 3, 4}, 1, 1, network.NetworkMsg_IBFTType))
+	msgQ.AddMessage(newNetMsg([]byte{1, 2, 3, 4}, 1, 1, network.NetworkMsg_SignatureType))
+	require.Equal(t, 1, msgQ.MsgCount("lambda_01020304_seqNumber_1"))
+
+	msgQ.Clean()
+	require.Equal(t, 0, msgQ.MsgCount("lambda_01020304_seqNumber_1"))
+	require.Equal(t, 0, msgQ.MsgCount("sig_lambda_01020304_seqNumber_1"))
+	require.Equal(t, 0, msgQ.allMessages.ItemCount())
+}
@@ -7,13 +7,9 @@ import (
 	"time"
 )
 
-// Message is a container for network messages.
-type Message struct {
-	SignedMessage *proto.SignedMessage
-	SyncMessage   *SyncMessage
-	Type          NetworkMsg
-	StreamID      string
-}
+// DecidedPrunedError is the error of a decided range response when the requested sequences were pruned by the peer,
+// the params of the response hold the lowest sequence number that the peer keeps
+const DecidedPrunedError = "DecidedPrunedError"
 
 // SyncChanObj is a wrapper object for streaming of sync messages
 type SyncChanObj struct {
@@ -53,12 +49,16 @@ type Reader interface {
 	ReceivedMsgChan() (<-chan *proto.SignedMessage, func())
 	// ReceivedSignatureChan returns the channel with signatures
 	ReceivedSignatureChan() (<-chan *proto.SignedMessage, func())
+	// ReceivedPreConsensusSignatureChan returns the channel with pre consensus signatures
+	ReceivedPreConsensusSignatureChan() (<-chan *proto.SignedMessage, func())
 	// ReceivedDecidedChan returns the channel for decided messages
 	ReceivedDecidedChan() (<-chan *proto.SignedMessage, func())
 	// ReceivedSyncMsgChan returns the channel for sync messages
 	ReceivedSyncMsgChan() (<-chan *SyncChanObj, func())
 	// SubscribeToValidatorNetwork subscribes and listens to validator's network
 	SubscribeToValidatorNetwork(validatorPk *bls.PublicKey) error
+	// UnsubscribeFromValidatorNetwork stops listening to validator's network
+	UnsubscribeFromValidatorNetwork(validatorPk *bls.PublicKey) error
 	// AllPeers returns all connected peers for a validator PK
 	AllPeers(validatorPk []byte) ([]string, error)
 	// SubscribeToMainTopic subscribes to main topic
@@ -73,6 +73,8 @@ type Broadcaster interface {
 	Broadcast(topicName []byte, msg *proto.SignedMessage) error
 	// BroadcastSignature broadcasts the given signature for the given lambda
 	BroadcastSignature(topicName []byte, msg *proto.SignedMessage) error
+	// BroadcastPreConsensusSignature broadcasts the given pre consensus signature for the given lambda
+	BroadcastPreConsensusSignature(topicName []byte, msg *proto.SignedMessage) error
 	// BroadcastDecided broadcasts a decided instance with collected signatures
 	BroadcastDecided(topicName []byte, msg *proto.SignedMessage) error
 	// MaxBatch returns the maximum batch size for network responses
@@ -32,6 +32,8 @@ const (
 	NetworkMsg_SignatureType NetworkMsg = 2
 	// SyncType is an SSV iBFT specific message that a node uses to sync up with other nodes
 	NetworkMsg_SyncType NetworkMsg = 3
+	// PreConsensusSignatureType is an SSV node specific message for broadcasting pre consensus signatures on eth2 duties
+	NetworkMsg_PreConsensusSignatureType NetworkMsg = 4
 )
 
 var NetworkMsg_name = map[int32]string{
@@ -39,13 +41,15 @@ var NetworkMsg_name = map[int32]string{
 	1: "DecidedType",
 	2: "SignatureType",
 	3: "SyncType",
+	4: "PreConsensusSignatureType",
 }
 
 var NetworkMsg_value = map[string]int32{
-	"IBFTType":      0,
-	"DecidedType":   1,
-	"SignatureType": 2,
-	"SyncType":      3,
+	"IBFTType":                  0,
+	"DecidedType":               1,
+	"SignatureType":             2,
+	"SyncType":                  3,
+	"PreConsensusSignatureType": 4,
 }
 
 func (x NetworkMsg) String() string {
@@ -166,10 +170,75 @@ func (m *SyncMessage) GetError() string {
 	return ""
 }
 
+// Message is a container for network messages
+type Message struct {
+	SignedMessage        *proto1.SignedMessage `protobuf:"bytes,1,opt,name=SignedMessage,proto3" json:"SignedMessage,omitempty"`
+	SyncMessage          *SyncMessage          `protobuf:"bytes,2,opt,name=SyncMessage,proto3" json:"SyncMessage,omitempty"`
+	Type                 NetworkMsg            `protobuf:"varint,3,opt,name=Type,proto3,enum=network.NetworkMsg" json:"Type,omitempty"`
+	StreamID             string                `protobuf:"bytes,4,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Message) Reset()         { *m = Message{} }
+func (m *Message) String() string { return proto.CompactTextString(m) }
+func (*Message) ProtoMessage()    {}
+func (*Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a755f4b722170306, []int{1}
+}
+
+func (m *Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Message.Unmarshal(m, b)
+}
+func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Message.Marshal(b, m, deterministic)
+}
+func (m *Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Message.Merge(m, src)
+}
+func (m *Message) XXX_Size() int {
+	return xxx_messageInfo_Message.Size(m)
+}
+func (m *Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Message proto.InternalMessageInfo
+
+func (m *Message) GetSignedMessage() *proto1.SignedMessage {
+	if m != nil {
+		return m.SignedMessage
+	}
+	return nil
+}
+
+func (m *Message) GetSyncMessage() *SyncMessage {
+	if m != nil {
+		return m.SyncMessage
+	}
+	return nil
+}
+
+func (m *Message) GetType() NetworkMsg {
+	if m != nil {
+		return m.Type
+	}
+	return NetworkMsg_IBFTType
+}
+
+func (m *Message) GetStreamID() string {
+	if m != nil {
+		return m.StreamID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("network.NetworkMsg", NetworkMsg_name, NetworkMsg_value)
 	proto.RegisterEnum("network.Sync", Sync_name, Sync_value)
 	proto.RegisterType((*SyncMessage)(nil), "network.SyncMessage")
+	proto.RegisterType((*Message)(nil), "network.Message")
 }
 
 func init() {
@@ -177,25 +246,30 @@ func init() {
 }
 
 var fileDescriptor_a755f4b722170306 = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x4d, 0x4f, 0xc2, 0x40,
-	0x10, 0x86, 0x2d, 0x2d, 0x88, 0xc3, 0x87, 0x38, 0x69, 0xcc, 0xc6, 0x83, 0xa9, 0x9e, 0x1a, 0x0e,
-	0x35, 0xc1, 0xab, 0x27, 0x24, 0x20, 0x06, 0x8c, 0x59, 0x38, 0x79, 0x31, 0x0b, 0x9d, 0x14, 0x62,
-	0xba, 0x25, 0xbb, 0x4b, 0x0c, 0xff, 0xd3, 0x1f, 0x64, 0x76, 0xd9, 0xc4, 0x8f, 0xe3, 0xf3, 0xcc,
-	0xdb, 0x99, 0xbe, 0x0b, 0x28, 0xc9, 0x7c, 0x56, 0xea, 0xe3, 0xbd, 0xd4, 0x85, 0xce, 0x76, 0xaa,
-	0x32, 0x15, 0x9e, 0x7a, 0x77, 0x05, 0x3f, 0xf2, 0xf6, 0x2b, 0x80, 0xd6, 0xe2, 0x20, 0xd7, 0x73,
-	0xd2, 0x5a, 0x14, 0x84, 0x0f, 0xd0, 0x5d, 0x6c, 0x0b, 0x49, 0xb9, 0x17, 0x9a, 0x05, 0x49, 0x98,
-	0xb6, 0x06, 0xf1, 0x31, 0x9f, 0xfd, 0x19, 0xf2, 0x7f, 0x59, 0xbc, 0x06, 0x18, 0xab, 0xaa, 0x7c,
-	0x25, 0x52, 0xd3, 0x11, 0xab, 0x25, 0x41, 0x7a, 0xc6, 0x7f, 0x19, 0xbc, 0x84, 0xc6, 0x4e, 0x28,
-	0x51, 0x6a, 0x16, 0x26, 0x61, 0x1a, 0x71, 0x4f, 0xd6, 0xcf, 0x44, 0xb9, 0xca, 0x05, 0x8b, 0x92,
-	0x20, 0x6d, 0x73, 0x4f, 0x78, 0x03, 0xd1, 0xf2, 0xb0, 0x23, 0x56, 0x4f, 0x82, 0xb4, 0x3b, 0xe8,
-	0x64, 0xbe, 0x41, 0x66, 0xff, 0x98, 0xbb, 0x11, 0xc6, 0x50, 0x27, 0xa5, 0x2a, 0xc5, 0x1a, 0xee,
-	0xda, 0x11, 0xfa, 0x33, 0x80, 0x97, 0x63, 0x76, 0xae, 0x0b, 0x6c, 0x43, 0x73, 0x3a, 0x1c, 0x2f,
-	0x6d, 0xbe, 0x77, 0x82, 0xe7, 0xd0, 0x1a, 0xd1, 0x7a, 0x9b, 0x53, 0xee, 0x44, 0x80, 0x17, 0xd0,
-	0xb1, 0x3d, 0x84, 0xd9, 0x2b, 0x72, 0xaa, 0x66, 0xbf, 0xb0, 0x37, 0x1c, 0x85, 0xfd, 0x67, 0x88,
-	0x2c, 0x21, 0x42, 0x77, 0x42, 0xe6, 0x69, 0x5b, 0x6c, 0x48, 0x1b, 0xbf, 0x2d, 0x86, 0xde, 0x84,
-	0xcc, 0x54, 0x6a, 0x23, 0xe4, 0x9a, 0xb8, 0x90, 0x85, 0x5d, 0xc9, 0x20, 0x9e, 0x90, 0x99, 0x09,
-	0x43, 0xda, 0x3c, 0x6e, 0xac, 0xe4, 0xd5, 0x5e, 0xe6, 0xbd, 0xda, 0x10, 0xde, 0x9a, 0x77, 0xbe,
-	0xc6, 0xaa, 0xe1, 0xde, 0xf4, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xbd, 0xf0, 0xb7, 0xae,
-	0x01, 0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x5f, 0x8b, 0x13, 0x31,
+	0x14, 0xc5, 0x4d, 0x67, 0xb6, 0xdb, 0xbd, 0xdd, 0xad, 0x35, 0x0e, 0x12, 0x17, 0x94, 0x71, 0x5f,
+	0x1c, 0xf6, 0x61, 0x84, 0x15, 0x7c, 0x10, 0x9f, 0x76, 0x4b, 0xeb, 0x48, 0x2b, 0x25, 0xed, 0x93,
+	0x2f, 0x92, 0x76, 0x2e, 0xd3, 0x52, 0x26, 0x53, 0x92, 0x14, 0xe9, 0xd7, 0xf3, 0x33, 0xf8, 0x81,
+	0x24, 0x99, 0xf4, 0xcf, 0x08, 0x3e, 0x85, 0xfb, 0xcb, 0x39, 0xdc, 0x73, 0x2e, 0x50, 0x89, 0xe6,
+	0x57, 0xa5, 0x36, 0x3f, 0x4b, 0x5d, 0xe8, 0x74, 0xab, 0x2a, 0x53, 0xd1, 0x4b, 0xcf, 0x6e, 0xe1,
+	0x04, 0xef, 0xfe, 0x10, 0xe8, 0xce, 0xf6, 0x72, 0x39, 0x41, 0xad, 0x45, 0x81, 0xf4, 0x0b, 0xf4,
+	0x66, 0xeb, 0x42, 0x62, 0xee, 0x81, 0x66, 0x24, 0x0e, 0x92, 0xee, 0x43, 0x54, 0xeb, 0xd3, 0xc6,
+	0x27, 0xff, 0x47, 0x4b, 0xdf, 0x02, 0x0c, 0x55, 0x55, 0x4e, 0x11, 0x55, 0x36, 0x60, 0xad, 0x98,
+	0x24, 0x57, 0xfc, 0x8c, 0xd0, 0x57, 0xd0, 0xde, 0x0a, 0x25, 0x4a, 0xcd, 0x82, 0x38, 0x48, 0x42,
+	0xee, 0x27, 0xcb, 0xc7, 0xa2, 0x5c, 0xe4, 0x82, 0x85, 0x31, 0x49, 0xae, 0xb9, 0x9f, 0xe8, 0x3b,
+	0x08, 0xe7, 0xfb, 0x2d, 0xb2, 0x8b, 0x98, 0x24, 0xbd, 0x87, 0x9b, 0xd4, 0x37, 0x48, 0x6d, 0x62,
+	0xee, 0xbe, 0x68, 0x04, 0x17, 0xa8, 0x54, 0xa5, 0x58, 0xdb, 0x6d, 0xab, 0x87, 0xbb, 0xdf, 0x04,
+	0x2e, 0x0f, 0x95, 0x3e, 0xc3, 0x4d, 0x23, 0x26, 0x23, 0x31, 0xf9, 0x6f, 0xa3, 0xa6, 0x94, 0x7e,
+	0x6a, 0x5c, 0xc7, 0x35, 0xb2, 0xce, 0xf3, 0x1c, 0x07, 0x67, 0xe3, 0x8c, 0xef, 0x7d, 0xf0, 0xc0,
+	0x05, 0x7f, 0x79, 0x34, 0x7c, 0xaf, 0xdf, 0x89, 0x2e, 0x7c, 0xfc, 0x5b, 0xe8, 0xcc, 0x8c, 0x42,
+	0x51, 0x66, 0x03, 0xd7, 0xfd, 0x8a, 0x1f, 0xe7, 0xfb, 0x0d, 0xc0, 0x49, 0x4f, 0xaf, 0xa1, 0x93,
+	0x3d, 0x0e, 0xe7, 0xd6, 0xd5, 0x7f, 0x46, 0x9f, 0x43, 0x77, 0x80, 0xcb, 0x75, 0x8e, 0xb9, 0x03,
+	0x84, 0xbe, 0xa8, 0x5b, 0x0a, 0xb3, 0x53, 0xe8, 0x50, 0xcb, 0x3a, 0x6c, 0x26, 0x37, 0x05, 0xf4,
+	0x0d, 0xbc, 0x9e, 0x2a, 0x7c, 0xaa, 0xa4, 0x46, 0xa9, 0x77, 0xba, 0x29, 0x0e, 0xef, 0xbf, 0x41,
+	0x68, 0xc5, 0x94, 0x42, 0x6f, 0x84, 0xe6, 0xeb, 0xba, 0x58, 0xa1, 0x36, 0x7e, 0x59, 0x04, 0xfd,
+	0x11, 0x9a, 0x4c, 0x6a, 0x23, 0xe4, 0x12, 0xb9, 0x90, 0x85, 0xdd, 0xc8, 0x20, 0x1a, 0xa1, 0x19,
+	0x0b, 0x83, 0xda, 0x3c, 0xad, 0x2c, 0xe4, 0xd5, 0x4e, 0xe6, 0xfd, 0xd6, 0x23, 0xfc, 0xe8, 0x7c,
+	0xf0, 0x8d, 0x17, 0x6d, 0x77, 0xe5, 0x8f, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xca, 0x8c,
+	0x52, 0x92, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,237 @@
+package p2p
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"go.uber.org/zap"
+)
+
+const (
+	// connManagerInterval is the interval for checking the peers count against the watermarks
+	connManagerInterval = 30 * time.Second
+	// connGracePeriod is the time that new connections are not trimmed,
+	// giving the peer time to be indexed and to join our topics
+	connGracePeriod = time.Minute
+	// lowWatermarkRatio sets the low watermark as a ratio of MaxPeers (the high watermark),
+	// once the high watermark is reached, peers are trimmed down to the low watermark
+	lowWatermarkRatio = 0.8
+
+	// peer score weights
+	sharedTopicScore    = 1.0
+	sharedSubnetScore   = 2.0
+	committeePeerScore  = 10.0
+	exporterPeerScore   = 5.0
+	latencyPenaltyUnit  = 100 * time.Millisecond
+	latencyPenaltyScore = 0.5
+	maxLatencyPenalty   = 3.0
+)
+
+// peerScore is the value of a connected peer for the current node
+type peerScore struct {
+	id    peer.ID
+	oid   string
+	score float64
+	// protected peers are never trimmed
+	protected bool
+}
+
+// watermarks returns the low and high watermarks of connected peers
+func (n *p2pNetwork) watermarks() (int, int) {
+	high := n.peersLimit
+	low := int(float64(high) * lowWatermarkRatio)
+	if low < minPeers {
+		low = minPeers
+	}
+	return low, high
+}
+
+// manageConnections trims the connected peers periodically
+func (n *p2pNetwork) manageConnections() {
+	ticker := time.NewTicker(connManagerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case <-ticker.C:
+			n.trimPeers()
+		}
+	}
+}
+
+// trimPeers disconnects the least valuable peers once the high watermark was reached, down to the low watermark.
+// peers that share a committee with the current node and new connections are kept
+func (n *p2pNetwork) trimPeers() {
+	low, high := n.watermarks()
+	conns := n.host.Network().Conns()
+	connected := make(map[peer.ID]bool)
+	var candidates []peer.ID
+	graced := make(map[peer.ID]bool)
+	for _, conn := range conns {
+		id := conn.RemotePeer()
+		if connected[id] {
+			continue
+		}
+		connected[id] = true
+		if time.Since(conn.Stat().Opened) < connGracePeriod {
+			graced[id] = true
+			continue
+		}
+		candidates = append(candidates, id)
+	}
+	if len(connected) < high {
+		return
+	}
+
+	scores := n.scorePeers(candidates)
+	protectedPeers := make(map[peer.ID]bool)
+	for _, s := range scores {
+		if s.protected {
+			protectedPeers[s.id] = true
+		}
+	}
+	protected := len(protectedPeers)
+	metricsConnManagerProtectedPeers.Set(float64(protected))
+	expired, newlyProtected := n.trimDecisions.update(connected, graced, protectedPeers)
+	metricsConnManagerDecisions.WithLabelValues("grace").Add(float64(expired))
+	metricsConnManagerDecisions.WithLabelValues("protect").Add(float64(newlyProtected))
+
+	toTrim := selectPeersToTrim(scores, len(connected)-low)
+	n.logger.Debug("trimming peers", zap.Int("connected", len(connected)), zap.Int("low", low),
+		zap.Int("high", high), zap.Int("protected", protected), zap.Int("trimmed", len(toTrim)))
+	for _, s := range toTrim {
+		metricsConnManagerDecisions.WithLabelValues("trim").Inc()
+		n.trace("trimming peer", zap.String("peerID", s.id.String()), zap.Float64("score", s.score))
+		// pruning the peer so it won't reconnect right away
+		n.peersIndex.Prune(s.id, s.oid)
+		if err := n.host.Network().ClosePeer(s.id); err != nil {
+			n.trace("WARNING: could not close connection", zap.String("peerID", s.id.String()), zap.Error(err))
+		}
+	}
+}
+
+// trimDecisions holds the peers that were graced or protected in the previous trim pass,
+// so decisions are counted once per peer rather than on every pass
+type trimDecisions struct {
+	graced    map[peer.ID]bool
+	protected map[peer.ID]bool
+}
+
+// newTrimDecisions creates a new instance of trimDecisions
+func newTrimDecisions() *trimDecisions {
+	return &trimDecisions{
+		graced:    make(map[peer.ID]bool),
+		protected: make(map[peer.ID]bool),
+	}
+}
+
+// update replaces the graced and protected peers with the ones of the current pass,
+// it returns the number of connected peers whose grace period has expired and the number of newly protected peers
+func (d *trimDecisions) update(connected, graced, protected map[peer.ID]bool) (int, int) {
+	expired := 0
+	for id := range d.graced {
+		if connected[id] && !graced[id] {
+			expired++
+		}
+	}
+	newlyProtected := 0
+	for id := range protected {
+		if !d.protected[id] {
+			newlyProtected++
+		}
+	}
+	d.graced = graced
+	d.protected = protected
+	return expired, newlyProtected
+}
+
+// selectPeersToTrim returns up to count peers with the lowest scores, protected peers are never selected
+func selectPeersToTrim(scores []peerScore, count int) []peerScore {
+	if count <= 0 {
+		return nil
+	}
+	var candidates []peerScore
+	for _, s := range scores {
+		if !s.protected {
+			candidates = append(candidates, s)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score < candidates[j].score
+	})
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+	return candidates[:count]
+}
+
+// scorePeers scores the given peers according to the topics and subnets they share with the current node,
+// their operator id, node type and latency
+func (n *p2pNetwork) scorePeers(ids []peer.ID) []peerScore {
+	sharedTopics := n.sharedTopicsCount()
+	scores := make([]peerScore, 0, len(ids))
+	for _, id := range ids {
+		s := peerScore{id: id}
+		s.score += float64(sharedTopics[id]) * sharedTopicScore
+		if node := n.peerNode(id); node != nil && n.sharesSubnet(node) {
+			s.score += sharedSubnetScore
+		}
+		oid, err := n.peersIndex.getOperatorID(id)
+		if err == nil && len(oid) > 0 {
+			s.oid = oid
+			if n.lookupOperator(oid) {
+				s.score += committeePeerScore
+				s.protected = true
+			}
+		}
+		if nodeType, err := n.peersIndex.getNodeType(id); err == nil && nodeType == Exporter {
+			s.score += exporterPeerScore
+		}
+		s.score -= latencyPenalty(n.host.Peerstore().LatencyEWMA(id))
+		scores = append(scores, s)
+	}
+	return scores
+}
+
+// latencyPenalty returns the penalty of the given latency, unknown latency (0) is not penalized
+func latencyPenalty(latency time.Duration) float64 {
+	penalty := float64(latency/latencyPenaltyUnit) * latencyPenaltyScore
+	if penalty > maxLatencyPenalty {
+		return maxLatencyPenalty
+	}
+	return penalty
+}
+
+// sharedTopicsCount returns the number of our topics that each peer is subscribed to
+func (n *p2pNetwork) sharedTopicsCount() map[peer.ID]int {
+	n.psTopicsLock.RLock()
+	defer n.psTopicsLock.RUnlock()
+
+	counts := make(map[peer.ID]int)
+	for _, topic := range n.cfg.Topics {
+		for _, id := range topic.ListPeers() {
+			counts[id]++
+		}
+	}
+	return counts
+}
+
+// peerNode returns the node record of the given peer if it was indexed
+func (n *p2pNetwork) peerNode(id peer.ID) *enode.Node {
+	raw, found, err := n.peersIndex.GetData(id, NodeRecordKey)
+	if err != nil || !found {
+		return nil
+	}
+	data, ok := raw.([]byte)
+	if !ok {
+		return nil
+	}
+	node, err := enode.Parse(enode.ValidSchemes, string(data))
+	if err != nil {
+		return nil
+	}
+	return node
+}
@@ -0,0 +1,75 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectPeersToTrim(t *testing.T) {
+	scores := []peerScore{
+		{id: peer.ID("committee"), score: 10, protected: true},
+		{id: peer.ID("exporter"), score: 5},
+		{id: peer.ID("topics"), score: 2},
+		{id: peer.ID("idle"), score: 0},
+		{id: peer.ID("slow"), score: -1.5},
+		{id: peer.ID("committee-slow"), score: -1, protected: true},
+	}
+
+	require.Len(t, selectPeersToTrim(scores, 0), 0)
+
+	trimmed := selectPeersToTrim(scores, 2)
+	require.Len(t, trimmed, 2)
+	require.Equal(t, peer.ID("slow"), trimmed[0].id)
+	require.Equal(t, peer.ID("idle"), trimmed[1].id)
+
+	// protected peers are kept even if the low watermark can't be reached
+	trimmed = selectPeersToTrim(scores, 10)
+	require.Len(t, trimmed, 4)
+	for _, s := range trimmed {
+		require.False(t, s.protected)
+	}
+}
+
+func TestTrimDecisions(t *testing.T) {
+	d := newTrimDecisions()
+	a, b, c := peer.ID("a"), peer.ID("b"), peer.ID("c")
+
+	expired, protected := d.update(map[peer.ID]bool{a: true, b: true, c: true},
+		map[peer.ID]bool{a: true, b: true}, map[peer.ID]bool{c: true})
+	require.Equal(t, 0, expired)
+	require.Equal(t, 1, protected)
+
+	// same state, nothing is counted again
+	expired, protected = d.update(map[peer.ID]bool{a: true, b: true, c: true},
+		map[peer.ID]bool{a: true, b: true}, map[peer.ID]bool{c: true})
+	require.Equal(t, 0, expired)
+	require.Equal(t, 0, protected)
+
+	// the grace period of a has expired, b was disconnected and a is protected
+	expired, protected = d.update(map[peer.ID]bool{a: true, c: true},
+		map[peer.ID]bool{}, map[peer.ID]bool{a: true, c: true})
+	require.Equal(t, 1, expired)
+	require.Equal(t, 1, protected)
+}
+
+func TestLatencyPenalty(t *testing.T) {
+	require.Equal(t, 0.0, latencyPenalty(0))
+	require.Equal(t, 0.0, latencyPenalty(50*time.Millisecond))
+	require.Equal(t, 1.0, latencyPenalty(250*time.Millisecond))
+	require.Equal(t, maxLatencyPenalty, latencyPenalty(5*time.Second))
+}
+
+func TestWatermarks(t *testing.T) {
+	n := &p2pNetwork{peersLimit: 250}
+	low, high := n.watermarks()
+	require.Equal(t, 200, low)
+	require.Equal(t, 250, high)
+
+	n.peersLimit = minPeers
+	low, high = n.watermarks()
+	require.Equal(t, minPeers, low)
+	require.Equal(t, minPeers, high)
+}
@@ -132,6 +132,13 @@ func (n *p2pNetwork) createExtendedLocalNode(ipAddr net.IP) (*enode.LocalNode, e
 		return nil, errors.Wrap(err, "could not create node type entry")
 	}
 
+	if fork, ok := n.subnetsFork(); ok {
+		localNode, err = setSubnetsEntry(localNode, make([]byte, (fork.SubnetsCount()+7)/8))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create subnets entry")
+		}
+	}
+
 	// TODO: add fork entry once applicable
 	//localNode, err = addForkEntry(localNode, s.genesisTime, s.genesisValidatorsRoot)
 	//if err != nil {
@@ -277,9 +284,14 @@ func (n *p2pNetwork) tryNode(node *enode.Node) {
 // isRelevantNode checks whether the given node if relevant by ENR entries.
 // a node is relevant if it fullfils one of the following:
 // - it shares a committee with the current node
+// - it shares a subnet with the current node
 // - it is an exporter or bootnode (TODO: bootnode)
 func (n *p2pNetwork) isRelevantNode(node *enode.Node) bool {
 	where := zap.String("where", "discovery:isRelevantNode")
+	if n.sharesSubnet(node) {
+		n.trace("found node with shared subnet", where)
+		return true
+	}
 	oid, err := extractOperatorIDEntry(node.Record())
 	if err != nil {
 		n.trace("WARNING: could not extract operator id entry", where, zap.Error(err))
@@ -69,3 +69,27 @@ func extractOperatorIDEntry(record *enr.Record) (*OperatorIDEntry, error) {
 	}
 	return oid, nil
 }
+
+// SubnetsEntry holds the subnets that the node is subscribed to, as a bitfield
+type SubnetsEntry []byte
+
+// ENRKey implements enr.Entry, returns the entry key
+func (se SubnetsEntry) ENRKey() string { return "subnets" }
+
+// setSubnetsEntry sets the subnets entry ('subnets') of the node
+func setSubnetsEntry(node *enode.LocalNode, subnets []byte) (*enode.LocalNode, error) {
+	node.Set(SubnetsEntry(subnets))
+	return node, nil
+}
+
+// extractSubnetsEntry extracts the value of subnets entry ('subnets')
+func extractSubnetsEntry(record *enr.Record) ([]byte, error) {
+	var se SubnetsEntry
+	if err := record.Load(&se); err != nil {
+		if enr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return se, nil
+}
@@ -60,3 +60,25 @@ func genPublicKey() *bls.PublicKey {
 
 	return sk.GetPublicKey()
 }
+
+func Test_ENR_SubnetsEntry(t *testing.T) {
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	pk := convertFromInterfacePrivKey(priv)
+	ip, err := ipAddr()
+	require.NoError(t, err)
+	node, err := createLocalNode(pk, ip, 12000, 13000)
+	require.NoError(t, err)
+
+	subnets, err := extractSubnetsEntry(node.Node().Record())
+	require.NoError(t, err)
+	require.Nil(t, subnets)
+
+	bitfield := make([]byte, 16)
+	bitfield[3] = 0x81
+	node, err = setSubnetsEntry(node, bitfield)
+	require.NoError(t, err)
+	subnets, err = extractSubnetsEntry(node.Node().Record())
+	require.NoError(t, err)
+	require.Equal(t, bitfield, subnets)
+}
@@ -22,6 +22,8 @@ func (n *p2pNetwork) propagateSignedMsg(cm *network.Message) {
 		go propagateIBFTMessage(lss, cm.SignedMessage)
 	case network.NetworkMsg_SignatureType:
 		go propagateSigMessage(lss, cm.SignedMessage)
+	case network.NetworkMsg_PreConsensusSignatureType:
+		go propagatePreConsensusSigMessage(lss, cm.SignedMessage)
 	case network.NetworkMsg_DecidedType:
 		go propagateDecidedMessage(lss, cm.SignedMessage)
 	default:
@@ -47,6 +49,15 @@ func propagateSigMessage(listeners []*listeners.Listener, msg *proto.SignedMessa
 	}
 }
 
+func propagatePreConsensusSigMessage(listeners []*listeners.Listener, msg *proto.SignedMessage) {
+	for _, ls := range listeners {
+		cn := ls.PreConsensusSigChan()
+		if cn != nil {
+			cn <- msg
+		}
+	}
+}
+
 func propagateDecidedMessage(listeners []*listeners.Listener, msg *proto.SignedMessage) {
 	for _, ls := range listeners {
 		cn := ls.DecidedChan()
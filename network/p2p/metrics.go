@@ -1,6 +1,7 @@
 package p2p
 
 import (
+	"github.com/bloxapp/ssv/network/validation"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/prometheus/client_golang/prometheus"
@@ -39,6 +40,22 @@ var (
 		Name: "ssv:network:connections",
 		Help: "Counts opened/closed connections",
 	})
+	metricsMsgValidation = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:network:pubsub:msg_validation",
+		Help: "Results of pubsub messages validation",
+	}, []string{"type", "result"})
+	metricsInvalidSyncRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:network:sync:invalid_requests",
+		Help: "Count invalid inbound sync requests",
+	}, []string{"protocol"})
+	metricsConnManagerDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:network:conn_manager:decisions",
+		Help: "Count decisions of the connection manager when trimming peers (trim, newly protected, grace expired)",
+	}, []string{"decision"})
+	metricsConnManagerProtectedPeers = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "ssv:network:conn_manager:protected_peers",
+		Help: "Count peers that are protected from trimming as they share a committee with the node",
+	})
 )
 
 func init() {
@@ -63,6 +80,18 @@ func init() {
 	if err := prometheus.Register(metricsConnections); err != nil {
 		log.Println("could not register prometheus collector")
 	}
+	if err := prometheus.Register(metricsMsgValidation); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsInvalidSyncRequests); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsConnManagerDecisions); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsConnManagerProtectedPeers); err != nil {
+		log.Println("could not register prometheus collector")
+	}
 }
 
 func reportAllPeers(n *p2pNetwork) {
@@ -119,3 +148,7 @@ func reportConnection(open bool) {
 		metricsConnections.Dec()
 	}
 }
+
+func reportMsgValidation(msgType string, res validation.Result) {
+	metricsMsgValidation.WithLabelValues(msgType, res.String()).Inc()
+}
@@ -140,6 +140,7 @@ func (n *p2pNetwork) newGossipPubsub(cfg *Config) (*pubsub.PubSub, error) {
 		pubsub.WithValidateQueueSize(pubsubQueueSize),
 		pubsub.WithFloodPublish(true),
 		pubsub.WithGossipSubParams(pubsubGossipParam()),
+		pubsub.WithPeerScore(peerScoreParams(), peerScoreThresholds()),
 	}
 	if len(cfg.ExporterPeerID) > 0 {
 		exporterPeerID, err := peerFromString(cfg.ExporterPeerID)
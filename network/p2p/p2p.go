@@ -11,6 +11,7 @@ import (
 	"github.com/bloxapp/ssv/utils/rsaencryption"
 	"github.com/prysmaticlabs/prysm/async"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/libp2p/go-libp2p"
@@ -28,12 +29,6 @@ const (
 
 	// minPeers is the min value for peers limit
 	minPeers = 10
-
-	//baseSyncStream           = "/sync/"
-	//highestDecidedStream     = baseSyncStream + "highest_decided"
-	//decidedByRangeStream     = baseSyncStream + "decided_by_range"
-	//lastChangeRoundMsgStream = baseSyncStream + "last_change_round"
-	legacyMsgStream = "/sync/0.0.1"
 )
 
 // p2pNetwork implements network.Network interface using P2P
@@ -52,8 +47,14 @@ type p2pNetwork struct {
 
 	streamCtrl streams.StreamController
 
-	psSubs       map[string]context.CancelFunc
+	psSubs map[string]*topicSubscription
+	// psClosing holds the subscriptions that were canceled and whose topics are not yet closed
+	psClosing    map[string]*topicSubscription
 	psTopicsLock *sync.RWMutex
+	// topicValidators holds the subscribed validators of each topic, as a subnet topic is shared by many validators
+	topicValidators map[string]map[string]bool
+	// subnets is a bitfield of the subscribed subnets, advertised in the ENR
+	subnets []byte
 
 	useMainTopic  bool
 	reportLastMsg bool
@@ -61,6 +62,10 @@ type p2pNetwork struct {
 
 	lookupOperator LookupOperatorHandler
 	peersLimit     int
+	// trimDecisions holds the decisions of previous trim passes, see trimPeers
+	trimDecisions *trimDecisions
+	// msgValidator holds the validator of incoming messages, see UseMsgValidator
+	msgValidator atomic.Value
 }
 
 // LookupOperatorHandler is a function that checks if the given operator
@@ -93,12 +98,15 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config) (network.Network,
 		logger:          logger,
 		operatorPrivKey: cfg.OperatorPrivateKey,
 		privKey:         cfg.NetworkPrivateKey,
-		psSubs:          make(map[string]context.CancelFunc),
+		psSubs:          make(map[string]*topicSubscription),
+		psClosing:       make(map[string]*topicSubscription),
+		topicValidators: make(map[string]map[string]bool),
 		psTopicsLock:    &sync.RWMutex{},
 		reportLastMsg:   cfg.ReportLastMsg,
 		fork:            cfg.Fork,
 		nodeType:        cfg.NodeType,
 		peersLimit:      cfg.MaxPeers,
+		trimDecisions:   newTrimDecisions(),
 		lookupOperator: func(s string) bool {
 			return true
 		},
@@ -119,7 +127,7 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config) (network.Network,
 		return nil, errors.Wrap(err, "failed to create p2p host")
 	}
 	n.host = host
-	n.streamCtrl = streams.NewStreamController(ctx, logger, host, cfg.Fork, cfg.RequestTimeout)
+	n.streamCtrl = streams.NewStreamController(ctx, logger, host, cfg.Fork, cfg.RequestTimeout, cfg.SyncRateLimit)
 	n.cfg.HostID = host.ID()
 	n.logger = logger.With(zap.String("id", n.cfg.HostID.String()))
 	n.logger.Info("listening on port", zap.String("addr", n.host.Addrs()[0].String()))
@@ -161,15 +169,17 @@ func New(ctx context.Context, logger *zap.Logger, cfg *Config) (network.Network,
 	n.setStreamHandlers()
 
 	n.watchPeers()
+	n.watchValidatorsTopics()
+	go n.manageConnections()
 
 	return n, nil
 }
 
 func (n *p2pNetwork) setStreamHandlers() {
 	n.setLegacyStreamHandler()
-	//n.setHighestDecidedStreamHandler()
-	//n.setDecidedByRangeStreamHandler()
-	//n.setLastChangeRoundStreamHandler()
+	for prot, syncType := range syncProtocols {
+		n.setSyncStreamHandler(prot, syncType)
+	}
 }
 
 func (n *p2pNetwork) watchPeers() {
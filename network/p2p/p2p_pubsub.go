@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"fmt"
+	"github.com/bloxapp/ssv/network"
 	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -13,16 +14,40 @@ import (
 	"sync/atomic"
 )
 
+// topicSubscription is an active subscription to a validator topic,
+// done is closed once the subscription was canceled and the topic was closed
+type topicSubscription struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
 // SubscribeToValidatorNetwork  for new validator create new topic, subscribe and start listen
 func (n *p2pNetwork) SubscribeToValidatorNetwork(validatorPk *bls.PublicKey) error {
-	n.psTopicsLock.Lock()
-	defer n.psTopicsLock.Unlock()
-
 	pubKey := validatorPk.SerializeToHexStr()
-	logger := n.logger.With(zap.String("who", "SubscribeToValidatorNetwork"), zap.String("pubKey", pubKey))
+	for _, topicID := range n.validatorTopicIDs(validatorPk.Serialize()) {
+		n.lockClosedTopic(topicID)
+		err := n.subscribeTopic(topicID, pubKey)
+		n.psTopicsLock.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// subscribeTopic subscribes the given validator to the given topic, the topic is joined if needed
+// this method is not thread-safe - should be called after psTopicsLock was acquired (see lockClosedTopic)
+func (n *p2pNetwork) subscribeTopic(topicID string, pubKey string) error {
+	logger := n.logger.With(zap.String("who", "SubscribeToValidatorNetwork"), zap.String("pubKey", pubKey),
+		zap.String("topic", topicID))
+
+	if _, ok := n.topicValidators[topicID]; !ok {
+		n.topicValidators[topicID] = make(map[string]bool)
+	}
+	n.topicValidators[topicID][pubKey] = true
 
-	if _, ok := n.cfg.Topics[pubKey]; !ok {
-		if err := n.joinTopic(pubKey); err != nil {
+	if _, ok := n.cfg.Topics[topicID]; !ok {
+		if err := n.joinTopic(topicID); err != nil {
 			return errors.Wrap(err, "failed to join to topic")
 		}
 		logger.Debug("joined topic")
@@ -30,25 +55,28 @@ func (n *p2pNetwork) SubscribeToValidatorNetwork(validatorPk *bls.PublicKey) err
 		logger.Debug("known topic")
 	}
 
-	if _, ok := n.psSubs[pubKey]; !ok {
-		sub, err := n.cfg.Topics[pubKey].Subscribe()
+	if _, ok := n.psSubs[topicID]; !ok {
+		sub, err := n.cfg.Topics[topicID].Subscribe()
 		if err != nil {
 			if err != pubsub.ErrTopicClosed {
 				return errors.Wrap(err, "failed to subscribe on Topic")
 			}
 			// rejoin a topic in case it was closed, and trying to subscribe again
-			if err := n.joinTopic(pubKey); err != nil {
+			if err := n.joinTopic(topicID); err != nil {
 				return errors.Wrap(err, "failed to join to topic")
 			}
-			sub, err = n.cfg.Topics[pubKey].Subscribe()
+			sub, err = n.cfg.Topics[topicID].Subscribe()
 			if err != nil {
 				return errors.Wrap(err, "failed to subscribe on Topic")
 			}
 		}
 		logger.Debug("subscribed to topic")
 		ctx, cancel := context.WithCancel(n.ctx)
-		n.psSubs[pubKey] = cancel
+		ts := &topicSubscription{cancel: cancel, done: make(chan struct{})}
+		n.psSubs[topicID] = ts
+		n.updateSubnetsEntry()
 		go func() {
+			defer close(ts.done)
 			topicName := sub.Topic()
 			n.listen(ctx, sub)
 			// close topic and mark it as not subscribed
@@ -58,9 +86,13 @@ func (n *p2pNetwork) SubscribeToValidatorNetwork(validatorPk *bls.PublicKey) err
 				n.logger.Error("failed to close topic", zap.String("topic", topicName), zap.Error(err))
 			}
 			// make sure the context is canceled once listen was done from some reason
-			if cancel, ok := n.psSubs[pubKey]; ok {
-				defer cancel()
-				delete(n.psSubs, pubKey)
+			defer cancel()
+			if current, ok := n.psSubs[topicID]; ok && current == ts {
+				delete(n.psSubs, topicID)
+				n.updateSubnetsEntry()
+			}
+			if closing, ok := n.psClosing[topicID]; ok && closing == ts {
+				delete(n.psClosing, topicID)
 			}
 		}()
 	} else {
@@ -70,6 +102,74 @@ func (n *p2pNetwork) SubscribeToValidatorNetwork(validatorPk *bls.PublicKey) err
 	return nil
 }
 
+// UnsubscribeFromValidatorNetwork stops listening to the validator's topics and leaves them,
+// returns once the topics were closed.
+// a topic that is shared with other validators (subnet) is left once the last validator was unsubscribed
+func (n *p2pNetwork) UnsubscribeFromValidatorNetwork(validatorPk *bls.PublicKey) error {
+	pubKey := validatorPk.SerializeToHexStr()
+
+	n.psTopicsLock.Lock()
+	var closing []*topicSubscription
+	for topicID, validators := range n.topicValidators {
+		if !validators[pubKey] {
+			continue
+		}
+		if ts := n.unsubscribeTopic(topicID, pubKey); ts != nil {
+			closing = append(closing, ts)
+		}
+	}
+	n.psTopicsLock.Unlock()
+
+	if len(closing) == 0 {
+		n.logger.Debug("no subscription was closed", zap.String("pubKey", pubKey))
+		return nil
+	}
+	for _, ts := range closing {
+		ts.cancel()
+		<-ts.done
+	}
+	n.logger.Debug("unsubscribed from topics", zap.String("pubKey", pubKey), zap.Int("topics", len(closing)))
+	return nil
+}
+
+// unsubscribeTopic removes the given validator from the given topic,
+// returns the subscription to cancel if no other validator uses the topic
+// this method is not thread-safe - should be called after psTopicsLock was acquired
+func (n *p2pNetwork) unsubscribeTopic(topicID string, pubKey string) *topicSubscription {
+	if validators, ok := n.topicValidators[topicID]; ok {
+		delete(validators, pubKey)
+		if len(validators) > 0 {
+			n.logger.Debug("topic is still used by other validators", zap.String("pubKey", pubKey),
+				zap.String("topic", topicID))
+			return nil
+		}
+		delete(n.topicValidators, topicID)
+	}
+	ts, ok := n.psSubs[topicID]
+	if !ok {
+		return nil
+	}
+	delete(n.psSubs, topicID)
+	// the topic is closed by the listener, new subscriptions must wait for it
+	n.psClosing[topicID] = ts
+	n.updateSubnetsEntry()
+	return ts
+}
+
+// lockClosedTopic acquires psTopicsLock once the given topic is not being closed,
+// otherwise a subscription might be created on a topic that is about to be closed
+func (n *p2pNetwork) lockClosedTopic(topicID string) {
+	for {
+		n.psTopicsLock.Lock()
+		closing, ok := n.psClosing[topicID]
+		if !ok {
+			return
+		}
+		n.psTopicsLock.Unlock()
+		<-closing.done
+	}
+}
+
 // AllPeers returns all connected peers for a validator PK (except for the validator itself)
 func (n *p2pNetwork) AllPeers(validatorPk []byte) ([]string, error) {
 	topic, err := n.getTopic(validatorPk)
@@ -82,12 +182,21 @@ func (n *p2pNetwork) AllPeers(validatorPk []byte) ([]string, error) {
 
 // joinTopic joins to the given topic and mark it in topics map
 // this method is not thread-safe - should be called after psTopicsLock was acquired
-func (n *p2pNetwork) joinTopic(pubKey string) error {
-	topic, err := n.pubsub.Join(getTopicName(pubKey))
+func (n *p2pNetwork) joinTopic(topicID string) error {
+	topicName := getTopicName(topicID)
+	// validator might be registered already in case the topic was closed w/o unregistering
+	_ = n.pubsub.UnregisterTopicValidator(topicName)
+	if err := n.pubsub.RegisterTopicValidator(topicName, n.validateTopicMsg); err != nil {
+		return errors.Wrap(err, "failed to register topic validator")
+	}
+	topic, err := n.pubsub.Join(topicName)
 	if err != nil {
 		return errors.Wrap(err, "failed to join to topic")
 	}
-	n.cfg.Topics[pubKey] = topic
+	if err := topic.SetScoreParams(topicScoreParams()); err != nil {
+		n.logger.Warn("could not set topic score params", zap.String("topic", topicName), zap.Error(err))
+	}
+	n.cfg.Topics[topicID] = topic
 	return nil
 }
 
@@ -96,6 +205,9 @@ func (n *p2pNetwork) closeTopic(topicName string) error {
 	pk := unwrapTopicName(topicName)
 	if t, ok := n.cfg.Topics[pk]; ok {
 		delete(n.cfg.Topics, pk)
+		if err := n.pubsub.UnregisterTopicValidator(topicName); err != nil {
+			n.logger.Debug("could not unregister topic validator", zap.String("topic", topicName), zap.Error(err))
+		}
 		return t.Close()
 	}
 	return nil
@@ -157,14 +269,22 @@ func (n *p2pNetwork) listen(ctx context.Context, sub *pubsub.Subscription) {
 				return
 			}
 			n.trace("received raw network msg", zap.ByteString("network.Message bytes", msg.Data))
-			cm, err := n.fork.DecodeNetworkMsg(msg.Data)
-			if err != nil {
-				n.logger.Error("failed to un-marshal message", zap.Error(err))
-				continue
+			// messages of validators topics were already decoded by the topic validator
+			cm, ok := msg.ValidatorData.(*network.Message)
+			if !ok {
+				cm, err = n.fork.DecodeNetworkMsg(msg.Data)
+				if err != nil {
+					n.logger.Error("failed to un-marshal message", zap.Error(err))
+					continue
+				}
 			}
 			if n.reportLastMsg && len(msg.ReceivedFrom) > 0 {
 				reportLastMsg(msg.ReceivedFrom.String())
 			}
+			if !n.isSubscribedValidatorMsg(t, cm) {
+				n.trace("skipping message of an unknown validator in subnet", zap.String("topic", t))
+				continue
+			}
 			n.propagateSignedMsg(cm)
 		}
 	}
@@ -3,19 +3,50 @@ package p2p
 import (
 	"github.com/bloxapp/ssv/network"
 	"github.com/bloxapp/ssv/network/commons/listeners"
+	"github.com/bloxapp/ssv/network/p2p/streams"
 	core "github.com/libp2p/go-libp2p-core"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
 
-// sendSyncRequest sends a sync request and returns the result
-func (n *p2pNetwork) sendSyncRequest(peerStr string, protocol protocol.ID, msg *network.SyncMessage) (*network.Message, error) {
+const (
+	// legacyMsgStream is the protocol that multiplexes all the sync requests,
+	// it is still served for peers that are not aware of the typed protocols
+	legacyMsgStream = "/sync/0.0.1"
+
+	baseSyncProtocol        = "/ssv/sync/"
+	highestDecidedProtocol  = baseSyncProtocol + "highest_decided/0.0.1"
+	decidedByRangeProtocol  = baseSyncProtocol + "decided_by_range/0.0.1"
+	lastChangeRoundProtocol = baseSyncProtocol + "last_change_round/0.0.1"
+)
+
+// syncProtocols maps the typed sync protocols to the type of requests they serve
+var syncProtocols = map[protocol.ID]network.Sync{
+	highestDecidedProtocol:  network.Sync_GetHighestType,
+	decidedByRangeProtocol:  network.Sync_GetInstanceRange,
+	lastChangeRoundProtocol: network.Sync_GetLatestChangeRound,
+}
+
+// syncProtocol returns the typed protocol of the given sync type
+func syncProtocol(syncType network.Sync) protocol.ID {
+	for prot, t := range syncProtocols {
+		if t == syncType {
+			return prot
+		}
+	}
+	return legacyMsgStream
+}
+
+// sendSyncRequest sends a sync request and returns the result.
+// the typed protocol is used if the peer supports it, otherwise the request is sent over the legacy protocol
+func (n *p2pNetwork) sendSyncRequest(peerStr string, msg *network.SyncMessage) (*network.Message, error) {
 	peerID, err := peerFromString(peerStr)
 	if err != nil {
 		return nil, err
 	}
-	res, err := n.streamCtrl.Request(peerID, protocol, &network.Message{
+	res, err := n.streamCtrl.Request(peerID, n.selectSyncProtocol(peerID, msg.Type), &network.Message{
 		SyncMessage: msg,
 		Type:        network.NetworkMsg_SyncType,
 	})
@@ -25,14 +56,32 @@ func (n *p2pNetwork) sendSyncRequest(peerStr string, protocol protocol.ID, msg *
 	if res.SyncMessage == nil {
 		return nil, errors.New("no response for sync request")
 	}
+	if err := n.validateSyncResponse(msg, res.SyncMessage); err != nil {
+		return nil, errors.Wrap(err, "invalid sync response")
+	}
 	n.logger.Debug("got sync response",
 		zap.String("FromPeerID", res.SyncMessage.GetFromPeerID()))
 	return res, nil
 }
 
-// RespondSyncMsg responds to the given stream
+// selectSyncProtocol returns the typed protocol of the given sync type if the peer supports it
+func (n *p2pNetwork) selectSyncProtocol(peerID peer.ID, syncType network.Sync) protocol.ID {
+	prot := syncProtocol(syncType)
+	supported, err := n.host.Peerstore().FirstSupportedProtocol(peerID, string(prot))
+	if err != nil || len(supported) == 0 {
+		return legacyMsgStream
+	}
+	return prot
+}
+
+// RespondSyncMsg responds to the given stream, responses are capped according to MaxBatchResponse
 func (n *p2pNetwork) RespondSyncMsg(streamID string, msg *network.SyncMessage) error {
 	msg.FromPeerID = n.host.ID().Pretty()
+	if max := n.maxSyncResponse(msg.Type); uint64(len(msg.SignedMessages)) > max {
+		n.logger.Debug("capping sync response", zap.String("type", msg.Type.String()),
+			zap.Int("count", len(msg.SignedMessages)), zap.Uint64("max", max))
+		msg.SignedMessages = msg.SignedMessages[:max]
+	}
 	return n.streamCtrl.Respond(&network.Message{
 		SyncMessage: msg,
 		Type:        network.NetworkMsg_SyncType,
@@ -40,59 +89,133 @@ func (n *p2pNetwork) RespondSyncMsg(streamID string, msg *network.SyncMessage) e
 	})
 }
 
+// maxSyncResponse returns the max number of signed messages in a response of the given type.
+// decided ranges are inclusive, therefore a full batch contains MaxBatchResponse + 1 messages
+func (n *p2pNetwork) maxSyncResponse(syncType network.Sync) uint64 {
+	if syncType == network.Sync_GetInstanceRange {
+		return n.cfg.MaxBatchResponse + 1
+	}
+	return 1
+}
+
 func (n *p2pNetwork) setLegacyStreamHandler() {
-	n.host.SetStreamHandler("/sync/0.0.1", func(stream core.Stream) {
-		cm, _, err := n.streamCtrl.HandleStream(stream)
-		if err != nil {
-			n.logger.Error(" highest decided preStreamHandler failed", zap.Error(err))
+	n.host.SetStreamHandler(legacyMsgStream, func(stream core.Stream) {
+		n.handleSyncStream(stream, nil)
+	})
+}
+
+func (n *p2pNetwork) setSyncStreamHandler(prot protocol.ID, syncType network.Sync) {
+	n.host.SetStreamHandler(prot, func(stream core.Stream) {
+		n.handleSyncStream(stream, &syncType)
+	})
+}
+
+// handleSyncStream reads and validates a sync request and propagates it to the sync listeners.
+// expectedType is nil for the legacy protocol, which accepts any type of request
+func (n *p2pNetwork) handleSyncStream(stream core.Stream, expectedType *network.Sync) {
+	prot := stream.Protocol()
+	cm, _, err := n.streamCtrl.HandleStream(stream)
+	if err != nil {
+		if err == streams.ErrRateLimited {
+			n.logger.Debug("sync request was rate limited", zap.String("protocol", string(prot)),
+				zap.String("peer", stream.Conn().RemotePeer().String()))
 			return
 		}
-		if cm == nil {
-			n.logger.Debug("got nil sync message")
-			return
+		n.logger.Error("could not handle sync stream", zap.String("protocol", string(prot)), zap.Error(err))
+		return
+	}
+	if cm == nil {
+		n.logger.Debug("got nil sync message")
+		return
+	}
+	var syncType network.Sync
+	if expectedType != nil {
+		syncType = *expectedType
+	} else if cm.SyncMessage != nil {
+		syncType = cm.SyncMessage.Type
+	}
+	if err := validateSyncRequest(cm, syncType); err != nil {
+		metricsInvalidSyncRequests.WithLabelValues(string(prot)).Inc()
+		n.logger.Debug("invalid sync request", zap.String("protocol", string(prot)),
+			zap.String("peer", stream.Conn().RemotePeer().String()), zap.Error(err))
+		var lambda []byte
+		if cm.SyncMessage != nil {
+			lambda = cm.SyncMessage.Lambda
 		}
-		// adjusting message and propagating to other (internal) components
-		cm.SyncMessage.FromPeerID = stream.Conn().RemotePeer().String()
-		go propagateSyncMessage(n.listeners.GetListeners(network.NetworkMsg_SyncType), cm)
-	})
+		if err := n.RespondSyncMsg(cm.StreamID, &network.SyncMessage{
+			Lambda: lambda,
+			Type:   syncType,
+			Error:  errors.Wrap(err, "invalid sync request").Error(),
+		}); err != nil {
+			n.logger.Debug("could not respond to invalid sync request", zap.Error(err))
+		}
+		return
+	}
+	// adjusting message and propagating to other (internal) components
+	cm.SyncMessage.FromPeerID = stream.Conn().RemotePeer().String()
+	go propagateSyncMessage(n.listeners.GetListeners(network.NetworkMsg_SyncType), cm)
+}
+
+// validateSyncRequest checks that the given message is a well formed sync request of the given type
+func validateSyncRequest(cm *network.Message, syncType network.Sync) error {
+	if cm.Type != network.NetworkMsg_SyncType {
+		return errors.Errorf("unexpected message type %s", cm.Type.String())
+	}
+	msg := cm.SyncMessage
+	if msg == nil {
+		return errors.New("missing sync message")
+	}
+	if msg.Type != syncType {
+		return errors.Errorf("unexpected sync type %s", msg.Type.String())
+	}
+	if len(msg.Lambda) == 0 {
+		return errors.New("missing lambda")
+	}
+	if len(msg.SignedMessages) > 0 {
+		return errors.New("request should not contain signed messages")
+	}
+	switch syncType {
+	case network.Sync_GetHighestType:
+		if len(msg.Params) != 0 {
+			return errors.New("params should be empty")
+		}
+	case network.Sync_GetInstanceRange:
+		if len(msg.Params) != 2 {
+			return errors.New("params should contain 2 elements")
+		}
+		if msg.Params[0] > msg.Params[1] {
+			return errors.New("param[0] should be <= param[1]")
+		}
+	case network.Sync_GetLatestChangeRound:
+		if len(msg.Params) != 1 {
+			return errors.New("params should contain 1 element")
+		}
+	default:
+		return errors.Errorf("unknown sync type %d", syncType)
+	}
+	return nil
 }
 
-//func (n *p2pNetwork) setHighestDecidedStreamHandler() {
-//	n.host.SetStreamHandler(highestDecidedStream, func(stream core.Stream) {
-//		cm, s, err := n.preStreamHandler(stream)
-//		if err != nil {
-//			n.logger.Error(" highest decided preStreamHandler failed", zap.Error(err))
-//			return
-//		}
-//		n.propagateSyncMsg(cm, s)
-//	})
-//}
-//
-//func (n *p2pNetwork) setDecidedByRangeStreamHandler() {
-//	n.host.SetStreamHandler(decidedByRangeStream, func(stream core.Stream) {
-//		cm, s, err := n.preStreamHandler(stream)
-//		if err != nil {
-//			n.logger.Error("decided by range preStreamHandler failed", zap.Error(err))
-//			return
-//		}
-//		n.propagateSyncMsg(cm, s)
-//	})
-//}
-//
-//func (n *p2pNetwork) setLastChangeRoundStreamHandler() {
-//	n.host.SetStreamHandler(lastChangeRoundMsgStream, func(stream core.Stream) {
-//		cm, s, err := n.preStreamHandler(stream)
-//		if err != nil {
-//			n.logger.Error("last change round preStreamHandler failed", zap.Error(err))
-//			return
-//		}
-//		n.propagateSyncMsg(cm, s)
-//	})
-//}
+// validateSyncResponse checks that the given response matches the request
+func (n *p2pNetwork) validateSyncResponse(req *network.SyncMessage, res *network.SyncMessage) error {
+	if res.Type != req.Type {
+		return errors.Errorf("unexpected sync type %s", res.Type.String())
+	}
+	max := n.maxSyncResponse(req.Type)
+	if req.Type == network.Sync_GetInstanceRange && len(req.Params) == 2 && req.Params[1] >= req.Params[0] {
+		if requested := req.Params[1] - req.Params[0] + 1; requested < max {
+			max = requested
+		}
+	}
+	if uint64(len(res.SignedMessages)) > max {
+		return errors.Errorf("response contains %d messages, max is %d", len(res.SignedMessages), max)
+	}
+	return nil
+}
 
 // GetHighestDecidedInstance asks peers for SyncMessage
 func (n *p2pNetwork) GetHighestDecidedInstance(peerStr string, msg *network.SyncMessage) (*network.SyncMessage, error) {
-	res, err := n.sendSyncRequest(peerStr, legacyMsgStream, msg)
+	res, err := n.sendSyncRequest(peerStr, msg)
 	if err != nil || res == nil {
 		return nil, err
 	}
@@ -101,7 +224,7 @@ func (n *p2pNetwork) GetHighestDecidedInstance(peerStr string, msg *network.Sync
 
 // GetDecidedByRange returns a list of decided signed messages up to 25 in a batch.
 func (n *p2pNetwork) GetDecidedByRange(peerStr string, msg *network.SyncMessage) (*network.SyncMessage, error) {
-	res, err := n.sendSyncRequest(peerStr, legacyMsgStream, msg)
+	res, err := n.sendSyncRequest(peerStr, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +233,7 @@ func (n *p2pNetwork) GetDecidedByRange(peerStr string, msg *network.SyncMessage)
 
 // GetLastChangeRoundMsg returns the latest change round msg for a running instance, could return nil
 func (n *p2pNetwork) GetLastChangeRoundMsg(peerStr string, msg *network.SyncMessage) (*network.SyncMessage, error) {
-	res, err := n.sendSyncRequest(peerStr, legacyMsgStream, msg)
+	res, err := n.sendSyncRequest(peerStr, msg)
 	if err != nil || res == nil {
 		return nil, err
 	}
@@ -5,12 +5,14 @@ import (
 	"crypto/ecdsa"
 	"encoding/hex"
 	"encoding/json"
+	"github.com/bloxapp/ssv/ibft/proto"
 	"github.com/bloxapp/ssv/network"
 	"github.com/bloxapp/ssv/network/forks"
 	"github.com/bloxapp/ssv/utils"
 	"github.com/bloxapp/ssv/utils/logex"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -26,6 +28,7 @@ func TestSyncMessageBroadcastingTimeout(t *testing.T) {
 	// broadcast msg
 	messageToBroadcast := &network.SyncMessage{
 		SignedMessages: nil,
+		Lambda:         []byte("lambda"),
 		Type:           network.Sync_GetHighestType,
 	}
 
@@ -52,6 +55,7 @@ func TestSyncMessageBroadcasting(t *testing.T) {
 
 		messageToBroadcast := &network.SyncMessage{
 			SignedMessages: nil,
+			Lambda:         []byte("lambda"),
 			Type:           network.Sync_GetHighestType,
 		}
 		require.NoError(t, peer2.RespondSyncMsg(msgFromPeer1.StreamID, messageToBroadcast))
@@ -60,6 +64,7 @@ func TestSyncMessageBroadcasting(t *testing.T) {
 	// broadcast msg
 	messageToBroadcast := &network.SyncMessage{
 		SignedMessages: nil,
+		Lambda:         []byte("lambda"),
 		Type:           network.Sync_GetHighestType,
 	}
 
@@ -76,6 +81,107 @@ func TestSyncMessageBroadcasting(t *testing.T) {
 	require.EqualValues(t, network.Sync_GetHighestType, res.Type)
 }
 
+func TestSyncProtocols(t *testing.T) {
+	logger := logex.Build("test", zapcore.InfoLevel, nil)
+
+	peer1, peer2 := testPeers(t, logger)
+	p1, p2 := peer1.(*p2pNetwork), peer2.(*p2pNetwork)
+
+	// once identified, peers use the typed protocols
+	for prot, syncType := range syncProtocols {
+		require.Equal(t, prot, p1.selectSyncProtocol(p2.host.ID(), syncType))
+	}
+	require.Equal(t, protocol.ID(legacyMsgStream), p1.selectSyncProtocol(peer.ID("unknown"), network.Sync_GetHighestType))
+
+	peer2Chan, done := peer2.ReceivedSyncMsgChan()
+	defer done()
+	go func() {
+		req := <-peer2Chan
+		msgs := make([]*proto.SignedMessage, 20)
+		for i := range msgs {
+			msgs[i] = &proto.SignedMessage{Message: &proto.Message{SeqNumber: uint64(i)}}
+		}
+		require.NoError(t, peer2.RespondSyncMsg(req.StreamID, &network.SyncMessage{
+			SignedMessages: msgs,
+			Lambda:         req.Msg.Lambda,
+			Type:           network.Sync_GetInstanceRange,
+		}))
+	}()
+
+	peerID := peer.Encode(p2.host.ID())
+	// the response is capped by the responder
+	res, err := peer1.GetDecidedByRange(peerID, &network.SyncMessage{
+		Lambda: []byte("lambda"),
+		Params: []uint64{0, 10},
+		Type:   network.Sync_GetInstanceRange,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.SignedMessages, 11)
+
+	// invalid requests are answered with an error
+	res, err = peer1.GetDecidedByRange(peerID, &network.SyncMessage{
+		Lambda: []byte("lambda"),
+		Params: []uint64{10, 0},
+		Type:   network.Sync_GetInstanceRange,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "invalid sync request: param[0] should be <= param[1]", res.Error)
+}
+
+func TestValidateSyncRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		syncType network.Sync
+		msg      *network.Message
+		err      string
+	}{
+		{"valid highest", network.Sync_GetHighestType, syncRequest(network.Sync_GetHighestType), ""},
+		{"valid range", network.Sync_GetInstanceRange, syncRequest(network.Sync_GetInstanceRange, 1, 5), ""},
+		{"valid change round", network.Sync_GetLatestChangeRound, syncRequest(network.Sync_GetLatestChangeRound, 3), ""},
+		{"wrong message type", network.Sync_GetHighestType, &network.Message{Type: network.NetworkMsg_IBFTType},
+			"unexpected message type IBFTType"},
+		{"missing sync message", network.Sync_GetHighestType, &network.Message{Type: network.NetworkMsg_SyncType},
+			"missing sync message"},
+		{"wrong sync type", network.Sync_GetHighestType, syncRequest(network.Sync_GetInstanceRange, 1, 5),
+			"unexpected sync type GetInstanceRange"},
+		{"missing lambda", network.Sync_GetHighestType, &network.Message{Type: network.NetworkMsg_SyncType,
+			SyncMessage: &network.SyncMessage{Type: network.Sync_GetHighestType}}, "missing lambda"},
+		{"signed messages in request", network.Sync_GetHighestType, &network.Message{Type: network.NetworkMsg_SyncType,
+			SyncMessage: &network.SyncMessage{Type: network.Sync_GetHighestType, Lambda: []byte("lambda"),
+				SignedMessages: []*proto.SignedMessage{{}}}}, "request should not contain signed messages"},
+		{"highest with params", network.Sync_GetHighestType, syncRequest(network.Sync_GetHighestType, 1),
+			"params should be empty"},
+		{"range with 1 param", network.Sync_GetInstanceRange, syncRequest(network.Sync_GetInstanceRange, 1),
+			"params should contain 2 elements"},
+		{"reversed range", network.Sync_GetInstanceRange, syncRequest(network.Sync_GetInstanceRange, 5, 1),
+			"param[0] should be <= param[1]"},
+		{"change round without params", network.Sync_GetLatestChangeRound, syncRequest(network.Sync_GetLatestChangeRound),
+			"params should contain 1 element"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateSyncRequest(test.msg, test.syncType)
+			if len(test.err) == 0 {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func syncRequest(syncType network.Sync, params ...uint64) *network.Message {
+	return &network.Message{
+		Type: network.NetworkMsg_SyncType,
+		SyncMessage: &network.SyncMessage{
+			Lambda: []byte("lambda"),
+			Params: params,
+			Type:   syncType,
+		},
+	}
+}
+
 func testPrivKey(t *testing.T) *ecdsa.PrivateKey {
 	ret, err := utils.ECDSAPrivateKey(logex.Build("test", zap.InfoLevel, nil), "")
 	require.NoError(t, err)
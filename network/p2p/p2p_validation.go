@@ -0,0 +1,134 @@
+package p2p
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/bloxapp/ssv/network/validation"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"go.uber.org/zap"
+)
+
+const (
+	// scoreDecayInterval is the interval of decaying the peer score counters (a single slot)
+	scoreDecayInterval = 12 * time.Second
+	// scoreDecayToZero is the value below which counters are considered 0
+	scoreDecayToZero = 0.01
+	// scoreRetainDuration is the duration of keeping the score of a disconnected peer
+	scoreRetainDuration = 100 * scoreDecayInterval
+	// invalidMessagesDecayEpochs is the number of epochs until invalid messages counter decays to zero
+	invalidMessagesDecayEpochs = 50
+
+	// gossipThreshold is the score below which gossip (IHAVE/IWANT) of the peer is ignored
+	gossipThreshold = -4000
+	// publishThreshold is the score below which self published messages are not sent to the peer
+	publishThreshold = -8000
+	// graylistThreshold is the score below which all the messages of the peer are ignored
+	graylistThreshold = -16000
+)
+
+// msgValidatorContainer wraps the validator so it can be stored in atomic.Value
+type msgValidatorContainer struct {
+	mv validation.MsgValidator
+}
+
+// UseMsgValidator sets the validator of incoming messages in validators topics
+func (n *p2pNetwork) UseMsgValidator(mv validation.MsgValidator) {
+	n.msgValidator.Store(msgValidatorContainer{mv})
+}
+
+func (n *p2pNetwork) getMsgValidator() validation.MsgValidator {
+	if c, ok := n.msgValidator.Load().(msgValidatorContainer); ok {
+		return c.mv
+	}
+	return nil
+}
+
+// validateTopicMsg is a pubsub topic validator that decodes the message with the fork
+// and runs it through the message validator, invalid messages are penalized by the peer scoring.
+// the decoded message is kept in ValidatorData so it won't be decoded again by the listener
+func (n *p2pNetwork) validateTopicMsg(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	cm, err := n.fork.DecodeNetworkMsg(msg.Data)
+	if err != nil {
+		n.trace("rejecting malformed message", zap.String("peer", pid.String()), zap.Error(err))
+		reportMsgValidation("malformed", validation.Reject)
+		return pubsub.ValidationReject
+	}
+	msg.ValidatorData = cm
+	mv := n.getMsgValidator()
+	if mv == nil {
+		return pubsub.ValidationAccept
+	}
+	res, err := mv.ValidateMessage(cm)
+	reportMsgValidation(cm.Type.String(), res)
+	switch res {
+	case validation.Accept:
+		return pubsub.ValidationAccept
+	case validation.Ignore:
+		n.trace("ignoring message", zap.String("peer", pid.String()), zap.Error(err))
+		return pubsub.ValidationIgnore
+	default:
+		n.logger.Debug("rejecting invalid message", zap.String("peer", pid.String()),
+			zap.String("type", cm.Type.String()), zap.Error(err))
+		return pubsub.ValidationReject
+	}
+}
+
+// peerScoreThresholds returns the thresholds of the peer scoring, peers with lower scores are graylisted
+func peerScoreThresholds() *pubsub.PeerScoreThresholds {
+	return &pubsub.PeerScoreThresholds{
+		GossipThreshold:             gossipThreshold,
+		PublishThreshold:            publishThreshold,
+		GraylistThreshold:           graylistThreshold,
+		AcceptPXThreshold:           100,
+		OpportunisticGraftThreshold: 5,
+	}
+}
+
+// peerScoreParams returns the global peer scoring parameters, topic parameters are set once a topic is joined
+func peerScoreParams() *pubsub.PeerScoreParams {
+	return &pubsub.PeerScoreParams{
+		Topics:        make(map[string]*pubsub.TopicScoreParams),
+		TopicScoreCap: 32.72,
+		AppSpecificScore: func(p peer.ID) float64 {
+			return 0
+		},
+		AppSpecificWeight:           1,
+		IPColocationFactorWeight:    -35.11,
+		IPColocationFactorThreshold: 10,
+		BehaviourPenaltyWeight:      -15.92,
+		BehaviourPenaltyThreshold:   6,
+		BehaviourPenaltyDecay:       scoreDecay(10 * 32 * scoreDecayInterval),
+		DecayInterval:               scoreDecayInterval,
+		DecayToZero:                 scoreDecayToZero,
+		RetainScore:                 scoreRetainDuration,
+	}
+}
+
+// topicScoreParams returns the scoring parameters of validators topics.
+// the score of a peer is mostly affected by invalid messages, ~12 rejected messages are enough to graylist a peer
+func topicScoreParams() *pubsub.TopicScoreParams {
+	return &pubsub.TopicScoreParams{
+		TopicWeight: 1,
+		// P1
+		TimeInMeshWeight:  0.0324,
+		TimeInMeshQuantum: scoreDecayInterval,
+		TimeInMeshCap:     300,
+		// P2
+		FirstMessageDeliveriesWeight: 0.5,
+		FirstMessageDeliveriesDecay:  scoreDecay(20 * 32 * scoreDecayInterval),
+		FirstMessageDeliveriesCap:    50,
+		// P3 is disabled as the traffic of a topic depends on the duties of its validators
+		// P4
+		InvalidMessageDeliveriesWeight: -140.4475,
+		InvalidMessageDeliveriesDecay:  scoreDecay(invalidMessagesDecayEpochs * 32 * scoreDecayInterval),
+	}
+}
+
+// scoreDecay returns the decay factor for a counter to reach zero within the given duration
+func scoreDecay(d time.Duration) float64 {
+	ticks := float64(d / scoreDecayInterval)
+	return math.Pow(scoreDecayToZero, 1/ticks)
+}
@@ -18,8 +18,13 @@ import (
 var (
 	pruneInterval = time.Minute * 5
 	cacheTimeout  = time.Minute * 2
+	// RateLimitWindow is the time window of inbound requests rate limiting
+	RateLimitWindow = time.Minute
 )
 
+// ErrRateLimited is returned when a peer exceeded the allowed number of inbound requests
+var ErrRateLimited = errors.New("peer exceeded requests rate limit")
+
 // StreamController simplifies the interaction with libp2p streams.
 // it wraps and keeps a reference to the opened stream, then takes care of reading/writing when needed.
 type StreamController interface {
@@ -31,9 +36,10 @@ type StreamController interface {
 	Respond(msg *network.Message) error
 }
 
-// NewStreamController create a new instance of StreamController
+// NewStreamController create a new instance of StreamController.
+// rateLimit is the max number of inbound requests of a single peer per protocol within RateLimitWindow, 0 means no limit
 func NewStreamController(ctx context.Context, logger *zap.Logger, host host.Host,
-	fork forks.Fork, requestTimeout time.Duration) StreamController {
+	fork forks.Fork, requestTimeout time.Duration, rateLimit int) StreamController {
 	ctrl := streamCtrl{
 		ctx:            ctx,
 		logger:         logger,
@@ -42,9 +48,11 @@ func NewStreamController(ctx context.Context, logger *zap.Logger, host host.Host
 		requestTimeout: requestTimeout,
 		streams:        make(map[string]streamEntry),
 		streamsLock:    &sync.Mutex{},
+		limiter:        newRateLimiter(rateLimit, RateLimitWindow),
 	}
 
 	async.RunEvery(ctx, pruneInterval, ctrl.clean)
+	async.RunEvery(ctx, RateLimitWindow, ctrl.limiter.clean)
 
 	return &ctrl
 }
@@ -61,11 +69,14 @@ type streamCtrl struct {
 	streamsLock *sync.Mutex
 
 	requestTimeout time.Duration
+
+	limiter *rateLimiter
 }
 
 type streamEntry struct {
-	s network.SyncStream
-	t time.Time
+	s        network.SyncStream
+	t        time.Time
+	protocol protocol.ID
 }
 
 // Request sends a message to the given stream and returns the response
@@ -80,9 +91,9 @@ func (n *streamCtrl) Request(peerID peer.ID, protocol protocol.ID, msg *network.
 			n.logger.Error("could not close stream", zap.Error(err))
 		}
 	}()
-	metricsStreamRequests.Inc()
-	metricsStreamRequestsActive.Inc()
-	defer metricsStreamRequestsActive.Dec()
+	metricsStreamRequests.WithLabelValues(string(protocol)).Inc()
+	metricsStreamRequestsActive.WithLabelValues(string(protocol)).Inc()
+	defer metricsStreamRequestsActive.WithLabelValues(string(protocol)).Dec()
 
 	if err := n.sendMsg(stream, msg); err != nil {
 		return nil, err
@@ -95,19 +106,28 @@ func (n *streamCtrl) Request(peerID peer.ID, protocol protocol.ID, msg *network.
 	if err != nil {
 		return nil, err
 	}
-	metricsStreamRequestsSuccess.Inc()
+	metricsStreamRequestsSuccess.WithLabelValues(string(protocol)).Inc()
 	return res, nil
 }
 
-// HandleStream is called at the beginning of stream handlers to create a wrapper stream and read first message
+// HandleStream is called at the beginning of stream handlers to create a wrapper stream and read first message.
+// streams of peers that exceeded the rate limit are reset and ErrRateLimited is returned
 func (n *streamCtrl) HandleStream(stream core.Stream) (*network.Message, network.SyncStream, error) {
+	prot := stream.Protocol()
+	if !n.limiter.allow(stream.Conn().RemotePeer(), prot) {
+		metricsStreamRateLimited.WithLabelValues(string(prot)).Inc()
+		if err := stream.Reset(); err != nil {
+			n.logger.Debug("could not reset stream", zap.Error(err))
+		}
+		return nil, nil, ErrRateLimited
+	}
 	s := NewTimeoutStream(stream)
 
 	msg, err := n.readMsg(s)
 	if err != nil {
 		return nil, nil, err
 	}
-	streamID := n.add(s)
+	streamID := n.add(s, prot)
 	msg.StreamID = streamID
 
 	return msg, s, nil
@@ -118,14 +138,14 @@ func (n *streamCtrl) Respond(msg *network.Message) error {
 	if msg == nil {
 		return errors.New("could not respond with nil message")
 	}
-	s := n.pop(msg.StreamID)
+	s, prot := n.pop(msg.StreamID)
 	if s == nil {
 		return errors.Errorf("stream not found: %s", msg.StreamID)
 	}
 	if err := n.sendMsg(s, msg); err != nil {
 		return err
 	}
-	metricsStreamResponses.Inc()
+	metricsStreamResponses.WithLabelValues(string(prot)).Inc()
 	if err := s.Close(); err != nil {
 		return errors.Wrap(err, "could not close stream")
 	}
@@ -171,7 +191,7 @@ func (n *streamCtrl) clean() {
 }
 
 // add adds the stream based on its id
-func (n *streamCtrl) add(stream network.SyncStream) string {
+func (n *streamCtrl) add(stream network.SyncStream, prot protocol.ID) string {
 	n.streamsLock.Lock()
 	defer n.streamsLock.Unlock()
 
@@ -183,21 +203,22 @@ func (n *streamCtrl) add(stream network.SyncStream) string {
 	}
 
 	n.streams[streamID] = streamEntry{
-		s: stream,
-		t: time.Now(),
+		s:        stream,
+		t:        time.Now(),
+		protocol: prot,
 	}
 
 	return streamID
 }
 
-// pop removes adn returns the stream with the given id
-func (n *streamCtrl) pop(id string) network.SyncStream {
+// pop removes adn returns the stream with the given id and its protocol
+func (n *streamCtrl) pop(id string) (network.SyncStream, protocol.ID) {
 	n.streamsLock.Lock()
 	defer n.streamsLock.Unlock()
 
 	if entry, ok := n.streams[id]; ok {
 		delete(n.streams, id)
-		return entry.s
+		return entry.s, entry.protocol
 	}
-	return nil
+	return nil, ""
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/bloxapp/ssv/network"
 	v0 "github.com/bloxapp/ssv/network/forks/v0"
 	libp2pnetwork "github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
@@ -19,8 +20,8 @@ func TestStreamCtrl(t *testing.T) {
 	prot := protocol.ID("/test/protocol")
 	identifier := []byte("xxx")
 
-	ctrl0 := NewStreamController(context.Background(), zaptest.NewLogger(t), hosts[0], v0.New(), time.Second)
-	ctrl1 := NewStreamController(context.Background(), zaptest.NewLogger(t), hosts[1], v0.New(), time.Second)
+	ctrl0 := NewStreamController(context.Background(), zaptest.NewLogger(t), hosts[0], v0.New(), time.Second, 0)
+	ctrl1 := NewStreamController(context.Background(), zaptest.NewLogger(t), hosts[1], v0.New(), time.Second, 0)
 
 	t.Run("sanity", func(t *testing.T) {
 		hosts[0].SetStreamHandler(prot, func(stream libp2pnetwork.Stream) {
@@ -53,6 +54,45 @@ func TestStreamCtrl(t *testing.T) {
 		require.Nil(t, res)
 	})
 
+	t.Run("rate limit", func(t *testing.T) {
+		limitedProt := protocol.ID("/test/limited")
+		ctrl := NewStreamController(context.Background(), zaptest.NewLogger(t), hosts[2], v0.New(), time.Second, 2)
+		hosts[2].SetStreamHandler(limitedProt, func(stream libp2pnetwork.Stream) {
+			msg, _, err := ctrl.HandleStream(stream)
+			if err != nil {
+				require.Equal(t, ErrRateLimited, err)
+				return
+			}
+			require.NoError(t, ctrl.Respond(msg))
+		})
+		for i := 0; i < 2; i++ {
+			_, err := ctrl1.Request(hosts[2].ID(), limitedProt, dummyMsg())
+			require.NoError(t, err)
+		}
+		_, err := ctrl1.Request(hosts[2].ID(), limitedProt, dummyMsg())
+		require.Error(t, err)
+		// other peers are not affected
+		other := NewStreamController(context.Background(), zaptest.NewLogger(t), hosts[0], v0.New(), time.Second, 0)
+		_, err = other.Request(hosts[2].ID(), limitedProt, dummyMsg())
+		require.NoError(t, err)
+	})
+}
+
+func TestRateLimiter(t *testing.T) {
+	rl := newRateLimiter(2, time.Millisecond*50)
+	pid, prot := peer.ID("peer"), protocol.ID("/prot")
+	require.True(t, rl.allow(pid, prot))
+	require.True(t, rl.allow(pid, prot))
+	require.False(t, rl.allow(pid, prot))
+	// limits are kept per protocol
+	require.True(t, rl.allow(pid, "/other"))
+
+	<-time.After(time.Millisecond * 60)
+	rl.clean()
+	require.Len(t, rl.windows, 0)
+	require.True(t, rl.allow(pid, prot))
+
+	require.True(t, newRateLimiter(0, time.Minute).allow(pid, prot))
 }
 
 func dummyMsg() *network.Message {
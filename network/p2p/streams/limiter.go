@@ -0,0 +1,64 @@
+package streams
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+)
+
+// rateLimiter limits the number of inbound requests of each peer on each protocol within a fixed time window
+type rateLimiter struct {
+	limit  int
+	window time.Duration
+
+	lock    sync.Mutex
+	windows map[string]*requestsWindow
+}
+
+type requestsWindow struct {
+	start time.Time
+	count int
+}
+
+// newRateLimiter creates a new limiter, a non positive limit disables rate limiting
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:   limit,
+		window:  window,
+		windows: make(map[string]*requestsWindow),
+	}
+}
+
+// allow counts a request of the given peer and returns whether it is within the limit
+func (rl *rateLimiter) allow(pid peer.ID, prot protocol.ID) bool {
+	if rl.limit <= 0 {
+		return true
+	}
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	key := string(pid) + string(prot)
+	now := time.Now()
+	w, ok := rl.windows[key]
+	if !ok || now.Sub(w.start) >= rl.window {
+		w = &requestsWindow{start: now}
+		rl.windows[key] = w
+	}
+	w.count++
+	return w.count <= rl.limit
+}
+
+// clean removes expired windows
+func (rl *rateLimiter) clean() {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	now := time.Now()
+	for key, w := range rl.windows {
+		if now.Sub(w.start) >= rl.window {
+			delete(rl.windows, key)
+		}
+	}
+}
@@ -7,25 +7,32 @@ import (
 )
 
 var (
-	metricsStreamRequests = promauto.NewGauge(prometheus.GaugeOpts{
-		Name: "ssv:p2p:streams:req:count",
+	metricsStreamRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:p2p:streams:req:count_total",
 		Help: "Count requests made via streams",
-	})
-	metricsStreamRequestsActive = promauto.NewGauge(prometheus.GaugeOpts{
+	}, []string{"protocol"})
+	metricsStreamRequestsActive = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "ssv:p2p:streams:req:active",
 		Help: "Count requests made via streams",
-	})
-	metricsStreamRequestsSuccess = promauto.NewCounter(prometheus.CounterOpts{
+	}, []string{"protocol"})
+	metricsStreamRequestsSuccess = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "ssv:p2p:streams:req:success",
 		Help: "Count successful requests made via streams",
-	})
-	metricsStreamResponses = promauto.NewCounter(prometheus.CounterOpts{
+	}, []string{"protocol"})
+	metricsStreamResponses = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "ssv:p2p:streams:res",
 		Help: "Count responses for streams",
-	})
+	}, []string{"protocol"})
+	metricsStreamRateLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:p2p:streams:rate_limited",
+		Help: "Count inbound requests that were dropped due to rate limiting",
+	}, []string{"protocol"})
 )
 
 func init() {
+	if err := prometheus.Register(metricsStreamRequests); err != nil {
+		log.Println("could not register prometheus collector")
+	}
 	if err := prometheus.Register(metricsStreamRequestsActive); err != nil {
 		log.Println("could not register prometheus collector")
 	}
@@ -35,4 +42,7 @@ func init() {
 	if err := prometheus.Register(metricsStreamResponses); err != nil {
 		log.Println("could not register prometheus collector")
 	}
+	if err := prometheus.Register(metricsStreamRateLimited); err != nil {
+		log.Println("could not register prometheus collector")
+	}
 }
@@ -0,0 +1,186 @@
+package p2p
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/bloxapp/ssv/network"
+	"github.com/bloxapp/ssv/network/forks"
+	"github.com/bloxapp/ssv/utils/format"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/prysmaticlabs/prysm/async"
+	"go.uber.org/zap"
+)
+
+// subnetsFork returns the fork as forks.SubnetsFork if validators are mapped into subnets
+func (n *p2pNetwork) subnetsFork() (forks.SubnetsFork, bool) {
+	f, ok := n.fork.(forks.SubnetsFork)
+	return f, ok
+}
+
+// validatorTopicIDs returns the topics that the given validator should be subscribed to,
+// during the transition to subnets both the validator and the subnet topics are returned
+func (n *p2pNetwork) validatorTopicIDs(pk []byte) []string {
+	if fork, ok := n.subnetsFork(); ok {
+		return fork.ValidatorTopicIDs(pk)
+	}
+	return []string{n.fork.ValidatorTopicID(pk)}
+}
+
+// watchValidatorsTopics updates the topics of the subscribed validators according to the current slot
+func (n *p2pNetwork) watchValidatorsTopics() {
+	if _, ok := n.subnetsFork(); !ok {
+		return
+	}
+	async.RunEvery(n.ctx, 1*time.Minute, func() {
+		n.updateValidatorsTopics()
+	})
+}
+
+// updateValidatorsTopics subscribes the validators to the topics they are expected to use (see validatorTopicIDs),
+// and unsubscribes them from topics that are no longer used, e.g. validator topics once the transition to subnets is done
+func (n *p2pNetwork) updateValidatorsTopics() {
+	n.psTopicsLock.RLock()
+	current := make(map[string]map[string]bool)
+	for topicID, validators := range n.topicValidators {
+		for pubKey := range validators {
+			if _, ok := current[pubKey]; !ok {
+				current[pubKey] = make(map[string]bool)
+			}
+			current[pubKey][topicID] = true
+		}
+	}
+	n.psTopicsLock.RUnlock()
+
+	for pubKey, topics := range current {
+		pk, err := hex.DecodeString(pubKey)
+		if err != nil {
+			continue
+		}
+		expected := make(map[string]bool)
+		for _, topicID := range n.validatorTopicIDs(pk) {
+			expected[topicID] = true
+			if topics[topicID] {
+				continue
+			}
+			n.lockClosedTopic(topicID)
+			// the validator might have been unsubscribed in the meanwhile
+			if n.isSubscribedValidator(pubKey) {
+				if err := n.subscribeTopic(topicID, pubKey); err != nil {
+					n.logger.Warn("could not subscribe validator to topic", zap.String("pubKey", pubKey),
+						zap.String("topic", topicID), zap.Error(err))
+				}
+			}
+			n.psTopicsLock.Unlock()
+		}
+		var closing []*topicSubscription
+		n.psTopicsLock.Lock()
+		for topicID := range topics {
+			if expected[topicID] || !n.topicValidators[topicID][pubKey] {
+				continue
+			}
+			if ts := n.unsubscribeTopic(topicID, pubKey); ts != nil {
+				closing = append(closing, ts)
+			}
+		}
+		n.psTopicsLock.Unlock()
+		// topics are closed before moving on, so later subscriptions won't wait for them
+		for _, ts := range closing {
+			ts.cancel()
+			<-ts.done
+		}
+	}
+}
+
+// isSubscribedValidator checks whether the given validator is subscribed to some topic
+// this method is not thread-safe - should be called after psTopicsLock was acquired
+func (n *p2pNetwork) isSubscribedValidator(pubKey string) bool {
+	for _, validators := range n.topicValidators {
+		if validators[pubKey] {
+			return true
+		}
+	}
+	return false
+}
+
+// isSubscribedValidatorMsg checks whether the given message belongs to one of the validators that
+// were subscribed to the topic, as subnet topics carry messages of other validators as well.
+// messages of topics that are not mapped to validators (e.g. main topic) are always accepted
+func (n *p2pNetwork) isSubscribedValidatorMsg(topicName string, cm *network.Message) bool {
+	if _, ok := n.subnetsFork(); !ok {
+		return true
+	}
+	n.psTopicsLock.RLock()
+	defer n.psTopicsLock.RUnlock()
+
+	validators, ok := n.topicValidators[unwrapTopicName(topicName)]
+	if !ok {
+		return true
+	}
+	if cm == nil || cm.SignedMessage == nil || cm.SignedMessage.Message == nil {
+		return false
+	}
+	pubKey, _ := format.IdentifierUnformat(string(cm.SignedMessage.Message.Lambda))
+	return validators[pubKey]
+}
+
+// subnetsBitfield returns the subnets of the subscribed topics as a bitfield
+// this method is not thread-safe - should be called after psTopicsLock was acquired
+func (n *p2pNetwork) subnetsBitfield(fork forks.SubnetsFork) []byte {
+	bitfield := make([]byte, (fork.SubnetsCount()+7)/8)
+	for topicID, validators := range n.topicValidators {
+		if _, subscribed := n.psSubs[topicID]; !subscribed {
+			continue
+		}
+		for pubKey := range validators {
+			pk, err := hex.DecodeString(pubKey)
+			if err != nil {
+				continue
+			}
+			subnet := fork.ValidatorSubnet(pk)
+			// validator topics of the previous scheme are not advertised
+			if topicID != fork.SubnetTopicID(subnet) {
+				break
+			}
+			bitfield[subnet/8] |= 1 << (subnet % 8)
+			break
+		}
+	}
+	return bitfield
+}
+
+// updateSubnetsEntry advertises the current subnets of the node in its ENR
+// this method is not thread-safe - should be called after psTopicsLock was acquired
+func (n *p2pNetwork) updateSubnetsEntry() {
+	fork, ok := n.subnetsFork()
+	if !ok {
+		return
+	}
+	n.subnets = n.subnetsBitfield(fork)
+	if n.dv5Listener == nil {
+		return
+	}
+	if _, err := setSubnetsEntry(n.dv5Listener.LocalNode(), n.subnets); err != nil {
+		n.logger.Warn("could not update subnets entry", zap.Error(err))
+	}
+}
+
+// sharesSubnet checks whether the given node is subscribed to one of the subnets of the current node
+func (n *p2pNetwork) sharesSubnet(node *enode.Node) bool {
+	if _, ok := n.subnetsFork(); !ok {
+		return false
+	}
+	subnets, err := extractSubnetsEntry(node.Record())
+	if err != nil || len(subnets) == 0 {
+		return false
+	}
+	n.psTopicsLock.RLock()
+	defer n.psTopicsLock.RUnlock()
+
+	for i := 0; i < len(subnets) && i < len(n.subnets); i++ {
+		if subnets[i]&n.subnets[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,197 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bloxapp/ssv/ibft/proto"
+	"github.com/bloxapp/ssv/network"
+	"github.com/bloxapp/ssv/network/forks"
+	v2 "github.com/bloxapp/ssv/network/forks/v2"
+	"github.com/bloxapp/ssv/utils/format"
+	"github.com/bloxapp/ssv/utils/threshold"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// sameSubnetKeys generates the given number of validator keys that are mapped into the same subnet
+func sameSubnetKeys(fork forks.SubnetsFork, n int) []*bls.PublicKey {
+	var ret []*bls.PublicKey
+	subnet := -1
+	for len(ret) < n {
+		sk := bls.SecretKey{}
+		sk.SetByCSPRNG()
+		pk := sk.GetPublicKey()
+		s := fork.ValidatorSubnet(pk.Serialize())
+		if subnet < 0 {
+			subnet = s
+		}
+		if s == subnet {
+			ret = append(ret, pk)
+		}
+	}
+	return ret
+}
+
+func subnetTestMsg(pk *bls.PublicKey) *network.Message {
+	return &network.Message{
+		SignedMessage: &proto.SignedMessage{
+			Message: &proto.Message{
+				Type:   proto.RoundState_PrePrepare,
+				Lambda: []byte(format.IdentifierFormat(pk.Serialize(), "ATTESTER")),
+			},
+		},
+		Type: network.NetworkMsg_IBFTType,
+	}
+}
+
+func TestP2PNetwork_Subnets(t *testing.T) {
+	threshold.Init()
+	fork := v2.New(0, 0)
+	netKey := testPrivKey(t)
+	n, err := New(context.Background(), zap.L(), &Config{
+		DiscoveryType:     discoveryTypeMdns,
+		NetworkPrivateKey: netKey,
+		UDPPort:           12000,
+		TCPPort:           13000,
+		MaxBatchResponse:  10,
+		RequestTimeout:    time.Second,
+		Fork:              fork,
+	})
+	require.NoError(t, err)
+	p2pNet := n.(*p2pNetwork)
+
+	keys := sameSubnetKeys(fork, 3)
+	subnet := fork.ValidatorSubnet(keys[0].Serialize())
+	topicName := getTopicName(fork.ValidatorTopicID(keys[0].Serialize()))
+
+	require.NoError(t, n.SubscribeToValidatorNetwork(keys[0]))
+	require.NoError(t, n.SubscribeToValidatorNetwork(keys[1]))
+	p2pNet.psTopicsLock.RLock()
+	require.Len(t, p2pNet.psSubs, 1)
+	require.Len(t, p2pNet.cfg.Topics, 1)
+	require.NotZero(t, p2pNet.subnets[subnet/8]&(1<<(subnet%8)))
+	p2pNet.psTopicsLock.RUnlock()
+
+	t.Run("filter by lambda", func(t *testing.T) {
+		require.True(t, p2pNet.isSubscribedValidatorMsg(topicName, subnetTestMsg(keys[0])))
+		require.True(t, p2pNet.isSubscribedValidatorMsg(topicName, subnetTestMsg(keys[1])))
+		require.False(t, p2pNet.isSubscribedValidatorMsg(topicName, subnetTestMsg(keys[2])))
+		// topics that are not mapped to validators are not filtered
+		require.True(t, p2pNet.isSubscribedValidatorMsg(getTopicName("main"), subnetTestMsg(keys[2])))
+	})
+
+	t.Run("shared subnet", func(t *testing.T) {
+		priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+		require.NoError(t, err)
+		ip, err := ipAddr()
+		require.NoError(t, err)
+		node, err := createLocalNode(convertFromInterfacePrivKey(priv), ip, 12001, 13001)
+		require.NoError(t, err)
+		require.False(t, p2pNet.sharesSubnet(node.Node()))
+
+		bitfield := make([]byte, v2.SubnetsCount/8)
+		bitfield[subnet/8] |= 1 << (subnet % 8)
+		node, err = setSubnetsEntry(node, bitfield)
+		require.NoError(t, err)
+		require.True(t, p2pNet.sharesSubnet(node.Node()))
+	})
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		// the subnet is still used by the second validator
+		require.NoError(t, n.UnsubscribeFromValidatorNetwork(keys[0]))
+		p2pNet.psTopicsLock.RLock()
+		require.Len(t, p2pNet.psSubs, 1)
+		p2pNet.psTopicsLock.RUnlock()
+		require.False(t, p2pNet.isSubscribedValidatorMsg(topicName, subnetTestMsg(keys[0])))
+
+		require.NoError(t, n.UnsubscribeFromValidatorNetwork(keys[1]))
+		p2pNet.psTopicsLock.RLock()
+		defer p2pNet.psTopicsLock.RUnlock()
+		require.Len(t, p2pNet.psSubs, 0)
+		require.Len(t, p2pNet.cfg.Topics, 0)
+		require.Zero(t, p2pNet.subnets[subnet/8])
+	})
+	t.Run("subscribe while the topic is closed", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			require.NoError(t, n.SubscribeToValidatorNetwork(keys[0]))
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				require.NoError(t, n.UnsubscribeFromValidatorNetwork(keys[0]))
+			}()
+			require.NoError(t, n.SubscribeToValidatorNetwork(keys[1]))
+			wg.Wait()
+
+			p2pNet.psTopicsLock.RLock()
+			require.Len(t, p2pNet.psSubs, 1)
+			require.Len(t, p2pNet.cfg.Topics, 1)
+			p2pNet.psTopicsLock.RUnlock()
+			_, err := p2pNet.getTopic(keys[1].Serialize())
+			require.NoError(t, err)
+			require.NoError(t, n.UnsubscribeFromValidatorNetwork(keys[1]))
+		}
+	})
+}
+
+func TestP2PNetwork_SubnetsTransition(t *testing.T) {
+	threshold.Init()
+	fork := v2.New(0, 100)
+	netKey := testPrivKey(t)
+	n, err := New(context.Background(), zap.L(), &Config{
+		DiscoveryType:     discoveryTypeMdns,
+		NetworkPrivateKey: netKey,
+		UDPPort:           12100,
+		TCPPort:           13100,
+		MaxBatchResponse:  10,
+		RequestTimeout:    time.Second,
+		Fork:              fork,
+	})
+	require.NoError(t, err)
+	p2pNet := n.(*p2pNetwork)
+
+	keys := sameSubnetKeys(fork, 2)
+	subnet := fork.ValidatorSubnet(keys[0].Serialize())
+	subnetTopic := fork.SubnetTopicID(subnet)
+
+	fork.SlotTick(10)
+	require.NoError(t, n.SubscribeToValidatorNetwork(keys[0]))
+	require.NoError(t, n.SubscribeToValidatorNetwork(keys[1]))
+	p2pNet.psTopicsLock.RLock()
+	require.Len(t, p2pNet.psSubs, 2)
+	require.Zero(t, p2pNet.subnets[subnet/8])
+	p2pNet.psTopicsLock.RUnlock()
+
+	t.Run("transition", func(t *testing.T) {
+		fork.SlotTick(90)
+		p2pNet.updateValidatorsTopics()
+		p2pNet.psTopicsLock.RLock()
+		defer p2pNet.psTopicsLock.RUnlock()
+		require.Len(t, p2pNet.psSubs, 3)
+		require.Contains(t, p2pNet.psSubs, subnetTopic)
+		require.NotZero(t, p2pNet.subnets[subnet/8]&(1<<(subnet%8)))
+	})
+
+	t.Run("forked", func(t *testing.T) {
+		fork.SlotTick(200)
+		p2pNet.updateValidatorsTopics()
+		p2pNet.psTopicsLock.RLock()
+		require.Len(t, p2pNet.psSubs, 1)
+		require.Contains(t, p2pNet.psSubs, subnetTopic)
+		require.Len(t, p2pNet.cfg.Topics, 1)
+		p2pNet.psTopicsLock.RUnlock()
+
+		require.NoError(t, n.UnsubscribeFromValidatorNetwork(keys[0]))
+		require.NoError(t, n.UnsubscribeFromValidatorNetwork(keys[1]))
+		p2pNet.psTopicsLock.RLock()
+		defer p2pNet.psTopicsLock.RUnlock()
+		require.Len(t, p2pNet.psSubs, 0)
+		require.Zero(t, p2pNet.subnets[subnet/8])
+	})
+}
@@ -0,0 +1,216 @@
+package validation
+
+import (
+	"encoding/hex"
+
+	"github.com/bloxapp/ssv/ibft/proto"
+	"github.com/bloxapp/ssv/network"
+	"github.com/bloxapp/ssv/utils/format"
+	"github.com/bloxapp/ssv/validator/storage"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Result is the result of a message validation
+type Result int
+
+const (
+	// Accept means that the message is valid and should be propagated
+	Accept Result = iota
+	// Ignore means that the message should not be propagated, w/o penalizing the sender
+	Ignore
+	// Reject means that the message is invalid, the sender will be penalized
+	Reject
+)
+
+func (r Result) String() string {
+	switch r {
+	case Accept:
+		return "accept"
+	case Ignore:
+		return "ignore"
+	case Reject:
+		return "reject"
+	}
+	return "unknown"
+}
+
+// ShareProvider returns the share of the given validator public key
+type ShareProvider func(pubKey []byte) (*storage.Share, bool, error)
+
+// HighestDecidedProvider returns the sequence number of the highest decided instance of the given lambda
+type HighestDecidedProvider func(lambda []byte) (uint64, bool, error)
+
+// Options contains options to create the message validator
+type Options struct {
+	Logger         *zap.Logger
+	Shares         ShareProvider
+	HighestDecided HighestDecidedProvider
+}
+
+// MsgValidator validates network messages before they are propagated
+type MsgValidator interface {
+	// ValidateMessage returns the validation result of the given message, and the reason for ignoring or rejecting it
+	ValidateMessage(msg *network.Message) (Result, error)
+}
+
+type msgValidator struct {
+	logger         *zap.Logger
+	shares         ShareProvider
+	highestDecided HighestDecidedProvider
+}
+
+// NewMsgValidator creates a new message validator
+func NewMsgValidator(opts Options) MsgValidator {
+	return &msgValidator{
+		logger:         opts.Logger.With(zap.String("component", "msgValidator")),
+		shares:         opts.Shares,
+		highestDecided: opts.HighestDecided,
+	}
+}
+
+// ValidateMessage implements MsgValidator
+func (mv *msgValidator) ValidateMessage(msg *network.Message) (Result, error) {
+	if msg == nil || msg.SignedMessage == nil || msg.SignedMessage.Message == nil {
+		return Reject, errors.New("message is empty")
+	}
+	signed := msg.SignedMessage
+	if len(signed.Signature) == 0 {
+		return Reject, errors.New("message is not signed")
+	}
+	if len(signed.SignerIds) == 0 {
+		return Reject, errors.New("message has no signers")
+	}
+	if err := verifyUniqueSigners(signed.SignerIds); err != nil {
+		return Reject, err
+	}
+	pubKeyHex, _ := format.IdentifierUnformat(string(signed.Message.Lambda))
+	pubKey, err := hex.DecodeString(pubKeyHex)
+	if err != nil || len(pubKey) == 0 {
+		return Reject, errors.New("invalid lambda")
+	}
+
+	share, found, err := mv.shares(pubKey)
+	if err != nil {
+		return Ignore, errors.Wrap(err, "could not get share")
+	}
+	if !found {
+		// messages of unknown validators (e.g. in a shared subnet) can't be verified,
+		// but they are propagated so they reach the committee across the mesh
+		return validateUnknown(msg)
+	}
+	for _, id := range signed.SignerIds {
+		if _, ok := share.Committee[id]; !ok {
+			return Reject, errors.Errorf("signer %d is not part of the committee", id)
+		}
+	}
+
+	switch msg.Type {
+	case network.NetworkMsg_IBFTType:
+		if err := share.VerifySignedMessage(signed); err != nil {
+			return Reject, errors.Wrap(err, "invalid signature")
+		}
+		return mv.validateSeq(signed)
+	case network.NetworkMsg_DecidedType:
+		if len(signed.SignerIds) < share.ThresholdSize() {
+			return Reject, errors.New("decided message has no quorum")
+		}
+		if err := share.VerifySignedMessage(signed); err != nil {
+			return Reject, errors.Wrap(err, "invalid signature")
+		}
+		// older decided messages are valid, but there is no need to propagate them
+		return mv.validateSeq(signed)
+	case network.NetworkMsg_SignatureType, network.NetworkMsg_PreConsensusSignatureType:
+		if len(signed.SignerIds) != 1 {
+			return Reject, errors.New("partial signature must have a single signer")
+		}
+		if len(signed.Message.Value) == 0 {
+			// peers of previous versions don't send the signed root, therefore it can't be verified
+			return Ignore, errors.New("missing signed root")
+		}
+		if err := verifyPartialSignature(share, signed); err != nil {
+			return Reject, errors.Wrap(err, "invalid partial signature")
+		}
+		return Accept, nil
+	}
+	return Reject, errors.Errorf("unsupported message type %d", msg.Type)
+}
+
+// validateUnknown validates the structure of a message of a validator that is not known to this node
+func validateUnknown(msg *network.Message) (Result, error) {
+	switch msg.Type {
+	case network.NetworkMsg_IBFTType, network.NetworkMsg_DecidedType:
+		return Accept, nil
+	case network.NetworkMsg_SignatureType, network.NetworkMsg_PreConsensusSignatureType:
+		if len(msg.SignedMessage.SignerIds) != 1 {
+			return Reject, errors.New("partial signature must have a single signer")
+		}
+		return Accept, nil
+	}
+	return Reject, errors.Errorf("unsupported message type %d", msg.Type)
+}
+
+// validateSeq ignores messages with a sequence number lower than the highest decided,
+// as they are no longer relevant but might have been sent by honest peers that were behind
+func (mv *msgValidator) validateSeq(signed *proto.SignedMessage) (Result, error) {
+	if mv.highestDecided == nil {
+		return Accept, nil
+	}
+	highest, found, err := mv.highestDecided(signed.Message.Lambda)
+	if err != nil {
+		return Ignore, errors.Wrap(err, "could not get highest decided")
+	}
+	if found && signed.Message.SeqNumber < highest {
+		return Ignore, errors.Errorf("stale sequence number %d, highest decided is %d",
+			signed.Message.SeqNumber, highest)
+	}
+	return Accept, nil
+}
+
+// verifyPartialSignature verifies the signature of the given partial signature message,
+// which is signed by a single committee member over the duty's root that is carried as the message's value
+func verifyPartialSignature(share *storage.Share, signed *proto.SignedMessage) error {
+	node, ok := share.Committee[signed.SignerIds[0]]
+	if !ok {
+		return errors.Errorf("signer %d is not part of the committee", signed.SignerIds[0])
+	}
+	pk := &bls.PublicKey{}
+	if err := pk.Deserialize(node.Pk); err != nil {
+		return errors.Wrap(err, "could not deserialize signer public key")
+	}
+	sig := &bls.Sign{}
+	if err := sig.Deserialize(signed.Signature); err != nil {
+		return errors.Wrap(err, "could not deserialize signature")
+	}
+	if !sig.VerifyByte(pk, signed.Message.Value) {
+		return errors.New("could not verify signature")
+	}
+	return nil
+}
+
+func verifyUniqueSigners(signerIds []uint64) error {
+	unique := make(map[uint64]bool, len(signerIds))
+	for _, id := range signerIds {
+		if unique[id] {
+			return errors.Errorf("signer %d is duplicated", id)
+		}
+		unique[id] = true
+	}
+	return nil
+}
+
+// validatingNetwork is implemented by networks that validate messages before propagating them
+type validatingNetwork interface {
+	UseMsgValidator(mv MsgValidator)
+}
+
+// UseMsgValidator injects the given message validator into the network,
+// returns false if the network doesn't support message validation
+func UseMsgValidator(n network.Network, mv MsgValidator) bool {
+	if vn, ok := n.(validatingNetwork); ok {
+		vn.UseMsgValidator(mv)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/bloxapp/ssv/ibft/proto"
+	"github.com/bloxapp/ssv/network"
+	"github.com/bloxapp/ssv/utils/format"
+	"github.com/bloxapp/ssv/utils/threshold"
+	"github.com/bloxapp/ssv/validator/storage"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testCommittee struct {
+	share  *storage.Share
+	sks    map[uint64]*bls.SecretKey
+	lambda []byte
+}
+
+func newTestCommittee(t *testing.T) *testCommittee {
+	threshold.Init()
+	validatorSk := &bls.SecretKey{}
+	validatorSk.SetByCSPRNG()
+	tc := &testCommittee{
+		share: &storage.Share{
+			NodeID:    1,
+			PublicKey: validatorSk.GetPublicKey(),
+			Committee: map[uint64]*proto.Node{},
+		},
+		sks:    map[uint64]*bls.SecretKey{},
+		lambda: []byte(format.IdentifierFormat(validatorSk.GetPublicKey().Serialize(), "ATTESTER")),
+	}
+	for i := uint64(1); i <= 4; i++ {
+		sk := &bls.SecretKey{}
+		sk.SetByCSPRNG()
+		tc.sks[i] = sk
+		tc.share.Committee[i] = &proto.Node{IbftId: i, Pk: sk.GetPublicKey().Serialize()}
+	}
+	return tc
+}
+
+func (tc *testCommittee) sign(t *testing.T, seq uint64, ids ...uint64) *proto.SignedMessage {
+	msg := &proto.Message{
+		Type:      proto.RoundState_Commit,
+		Round:     1,
+		Lambda:    tc.lambda,
+		SeqNumber: seq,
+		Value:     []byte("value"),
+	}
+	var agg *bls.Sign
+	for _, id := range ids {
+		sig, err := msg.Sign(tc.sks[id])
+		require.NoError(t, err)
+		if agg == nil {
+			agg = sig
+		} else {
+			agg.Add(sig)
+		}
+	}
+	return &proto.SignedMessage{Message: msg, Signature: agg.Serialize(), SignerIds: ids}
+}
+
+// signPartial returns a partial signature message of the given signer over the given root
+func (tc *testCommittee) signPartial(seq uint64, id uint64, root []byte) *proto.SignedMessage {
+	return &proto.SignedMessage{
+		Message: &proto.Message{
+			Lambda:    tc.lambda,
+			SeqNumber: seq,
+			Value:     root,
+		},
+		Signature: tc.sks[id].SignByte(root).Serialize(),
+		SignerIds: []uint64{id},
+	}
+}
+
+func (tc *testCommittee) validator() MsgValidator {
+	return NewMsgValidator(Options{
+		Logger: zap.L(),
+		Shares: func(pubKey []byte) (*storage.Share, bool, error) {
+			pk := &bls.PublicKey{}
+			if err := pk.Deserialize(pubKey); err != nil || !pk.IsEqual(tc.share.PublicKey) {
+				return nil, false, nil
+			}
+			return tc.share, true, nil
+		},
+		HighestDecided: func(lambda []byte) (uint64, bool, error) {
+			return 10, true, nil
+		},
+	})
+}
+
+func TestMsgValidator_ValidateMessage(t *testing.T) {
+	tc := newTestCommittee(t)
+	mv := tc.validator()
+
+	unknownValidator := newTestCommittee(t)
+	duplicatedSigners := tc.sign(t, 10, 1, 2)
+	duplicatedSigners.SignerIds = []uint64{1, 1}
+	wrongSigner := tc.sign(t, 10, 1)
+	wrongSigner.SignerIds = []uint64{2}
+	unsigned := tc.sign(t, 10, 1)
+	unsigned.Signature = nil
+	unknownSigner := tc.sign(t, 10, 1)
+	unknownSigner.SignerIds = []uint64{5}
+	root := []byte("root")
+	invalidPartialSig := tc.signPartial(10, 1, root)
+	invalidPartialSig.Signature = []byte{1, 2, 3}
+	wrongPartialSigner := tc.signPartial(10, 1, root)
+	wrongPartialSigner.SignerIds = []uint64{2}
+	wrongPartialRoot := tc.signPartial(10, 1, root)
+	wrongPartialRoot.Message.Value = []byte("other root")
+
+	tests := []struct {
+		name     string
+		msgType  network.NetworkMsg
+		msg      *proto.SignedMessage
+		expected Result
+	}{
+		{"valid ibft message", network.NetworkMsg_IBFTType, tc.sign(t, 10, 1), Accept},
+		{"future ibft message", network.NetworkMsg_IBFTType, tc.sign(t, 11, 1), Accept},
+		{"stale ibft message", network.NetworkMsg_IBFTType, tc.sign(t, 9, 1), Ignore},
+		{"unsigned message", network.NetworkMsg_IBFTType, unsigned, Reject},
+		{"empty message", network.NetworkMsg_IBFTType, &proto.SignedMessage{}, Reject},
+		{"signer is not in committee", network.NetworkMsg_IBFTType, unknownSigner, Reject},
+		{"duplicated signers", network.NetworkMsg_IBFTType, duplicatedSigners, Reject},
+		{"wrong signer", network.NetworkMsg_IBFTType, wrongSigner, Reject},
+		{"valid decided message", network.NetworkMsg_DecidedType, tc.sign(t, 10, 1, 2, 3), Accept},
+		{"decided message w/o quorum", network.NetworkMsg_DecidedType, tc.sign(t, 10, 1, 2), Reject},
+		{"stale decided message", network.NetworkMsg_DecidedType, tc.sign(t, 9, 1, 2, 3), Ignore},
+		{"valid partial signature", network.NetworkMsg_SignatureType, tc.signPartial(10, 1, root), Accept},
+		{"valid pre consensus partial signature", network.NetworkMsg_PreConsensusSignatureType, tc.signPartial(10, 1, root), Accept},
+		{"partial signature with many signers", network.NetworkMsg_SignatureType, tc.sign(t, 10, 1, 2), Reject},
+		{"invalid partial signature", network.NetworkMsg_PreConsensusSignatureType, invalidPartialSig, Reject},
+		{"partial signature of wrong signer", network.NetworkMsg_SignatureType, wrongPartialSigner, Reject},
+		{"partial signature of wrong root", network.NetworkMsg_SignatureType, wrongPartialRoot, Reject},
+		{"partial signature w/o root", network.NetworkMsg_SignatureType, tc.signPartial(10, 1, nil), Ignore},
+		{"unknown validator", network.NetworkMsg_IBFTType, unknownValidator.sign(t, 1, 1), Accept},
+		{"partial signature of unknown validator", network.NetworkMsg_SignatureType, unknownValidator.signPartial(1, 1, root), Accept},
+		{"partial signature of unknown validator with many signers", network.NetworkMsg_SignatureType, unknownValidator.sign(t, 1, 1, 2), Reject},
+		{"unsupported message type of unknown validator", network.NetworkMsg_SyncType, unknownValidator.sign(t, 1, 1), Reject},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := mv.ValidateMessage(&network.Message{SignedMessage: test.msg, Type: test.msgType})
+			require.Equal(t, test.expected.String(), res.String(), "reason: %v", err)
+			if test.expected != Accept {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -102,6 +102,12 @@ func (dc *dutyController) ExecuteDuty(duty *beacon.Duty) error {
 		return errors.Wrap(err, "failed to deserialize pubkey from duty")
 	}
 	if v, ok := dc.validatorController.GetValidator(pubKey.SerializeToHexStr()); ok {
+		if dc.validatorController.IsOwnerLiquidated(v.Share.OwnerAddress) {
+			reportDutySkipped(duty, skipReasonLiquidatedOwner)
+			logger.Debug("skipping duty, owner account is liquidated", zap.String("ownerAddress", v.Share.OwnerAddress))
+			return nil
+		}
+		metricsDutiesDispatched.WithLabelValues(duty.Type.String()).Inc()
 		go func() {
 			// force the validator to be started (subscribed to validator's topic and synced)
 			if err := v.Start(); err != nil {
@@ -112,6 +118,7 @@ func (dc *dutyController) ExecuteDuty(duty *beacon.Duty) error {
 			v.ExecuteDuty(dc.ctx, uint64(duty.Slot), duty)
 		}()
 	} else {
+		reportDutySkipped(duty, skipReasonUnknownValidator)
 		logger.Warn("could not find validator")
 	}
 	return nil
@@ -130,6 +137,7 @@ func (dc *dutyController) listenToTicker(slots <-chan types.Slot) {
 			dc.logger.Error("failed to get duties", zap.Error(err))
 		}
 		for i := range duties {
+			metricsDutiesFetched.WithLabelValues(duties[i].Type.String()).Inc()
 			go dc.onDuty(&duties[i])
 		}
 	}
@@ -152,6 +160,7 @@ func (dc *dutyController) onDuty(duty *beacon.Duty) {
 		}
 		return
 	}
+	reportDutySkipped(duty, skipReasonIrrelevantSlot)
 	logger.Warn("slot is irrelevant, ignoring duty")
 }
 
@@ -131,16 +131,17 @@ func (df *dutyFetcher) fetchDuties(slot uint64) ([]*beacon.Duty, error) {
 // processFetchedDuties loop over fetched duties and process them
 func (df *dutyFetcher) processFetchedDuties(fetchedDuties []*beacon.Duty) error {
 	if len(fetchedDuties) > 0 {
-		var subscriptions []*eth2apiv1.BeaconCommitteeSubscription
 		// entries holds all the new duties to add
 		entries := map[spec.Slot]cacheEntry{}
 		for _, duty := range fetchedDuties {
 			df.fillEntry(entries, duty)
-			subscriptions = append(subscriptions, toSubscription(duty))
 		}
 		df.populateCache(entries)
-		if err := df.beaconClient.SubscribeToCommitteeSubnet(subscriptions); err != nil {
-			df.logger.Warn("failed to subscribe committee to subnet", zap.Error(err))
+		subscriptions := toSubscriptions(fetchedDuties)
+		if len(subscriptions) > 0 {
+			if err := df.beaconClient.SubscribeToCommitteeSubnet(subscriptions); err != nil {
+				df.logger.Warn("failed to subscribe committee to subnet", zap.Error(err))
+			}
 		}
 	}
 	return nil
@@ -167,7 +168,7 @@ func (df *dutyFetcher) populateCache(entriesToAdd map[spec.Slot]cacheEntry) {
 			for _, newDuty := range e.Duties {
 				exist := false
 				for _, existDuty := range existingEntry.Duties {
-					if newDuty.ValidatorIndex == existDuty.ValidatorIndex {
+					if newDuty.ValidatorIndex == existDuty.ValidatorIndex && newDuty.Type == existDuty.Type {
 						exist = true
 						break // already exist, pass
 					}
@@ -214,13 +215,37 @@ func getDutyCacheKey(slot uint64) string {
 }
 
 // toSubscription creates a subscription from the given duty
-func toSubscription(duty *beacon.Duty) *eth2apiv1.BeaconCommitteeSubscription {
+// toSubscriptions returns the committee subnet subscriptions of the given duties.
+// committee subnets are relevant only for attestations and aggregations, the selection proof is known only when
+// the aggregation duty is executed, therefore validators with aggregation duties are subscribed as aggregators
+// so the beacon node will collect the subnet's attestations in advance
+func toSubscriptions(duties []*beacon.Duty) []*eth2apiv1.BeaconCommitteeSubscription {
+	var subscriptions []*eth2apiv1.BeaconCommitteeSubscription
+	// subscription index by validator and slot
+	added := make(map[string]int)
+	for _, duty := range duties {
+		if duty.Type != beacon.RoleTypeAttester && duty.Type != beacon.RoleTypeAggregator {
+			continue
+		}
+		sub := toSubscription(duty, duty.Type == beacon.RoleTypeAggregator)
+		key := fmt.Sprintf("%d/%d", duty.ValidatorIndex, duty.Slot)
+		if i, exist := added[key]; exist {
+			subscriptions[i].IsAggregator = subscriptions[i].IsAggregator || sub.IsAggregator
+			continue
+		}
+		added[key] = len(subscriptions)
+		subscriptions = append(subscriptions, sub)
+	}
+	return subscriptions
+}
+
+func toSubscription(duty *beacon.Duty, isAggregator bool) *eth2apiv1.BeaconCommitteeSubscription {
 	return &eth2apiv1.BeaconCommitteeSubscription{
 		ValidatorIndex:   duty.ValidatorIndex,
 		Slot:             duty.Slot,
 		CommitteeIndex:   duty.CommitteeIndex,
 		CommitteesAtSlot: duty.CommitteesAtSlot,
-		IsAggregator:     false, // TODO need to handle agg case
+		IsAggregator:     isAggregator,
 	}
 }
 
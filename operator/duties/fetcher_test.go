@@ -64,6 +64,37 @@ func TestDutyFetcher_GetDuties(t *testing.T) {
 		require.Len(t, duties, 1)
 	})
 
+	t.Run("serves attester and proposer duties of the same validator", func(t *testing.T) {
+		fetchedDuties := []*beacon.Duty{
+			{
+				Type:           beacon.RoleTypeAttester,
+				Slot:           893108,
+				ValidatorIndex: 205238,
+				PubKey:         spec.BLSPubKey{},
+			},
+			{
+				Type:           beacon.RoleTypeProposer,
+				Slot:           893108,
+				ValidatorIndex: 205238,
+				PubKey:         spec.BLSPubKey{},
+			},
+		}
+		bcMock := beaconDutiesClientMock{duties: fetchedDuties}
+		dm := newDutyFetcher(zap.L(), &bcMock, &indicesFetcher{[]spec.ValidatorIndex{205238}},
+			core.PraterNetwork)
+		duties, err := dm.GetDuties(893108)
+		require.NoError(t, err)
+		require.Len(t, duties, 2)
+		require.True(t, bcMock.subscribed)
+		// populating the same duties again should not duplicate them
+		dm.(*dutyFetcher).populateCache(map[spec.Slot]cacheEntry{
+			893108: {[]beacon.Duty{*fetchedDuties[1]}},
+		})
+		duties, err = dm.GetDuties(893108)
+		require.NoError(t, err)
+		require.Len(t, duties, 2)
+	})
+
 	t.Run("handles no indices", func(t *testing.T) {
 		fetchedDuties := []*beacon.Duty{
 			{
@@ -80,6 +111,21 @@ func TestDutyFetcher_GetDuties(t *testing.T) {
 	})
 }
 
+func TestToSubscriptions(t *testing.T) {
+	duties := []*beacon.Duty{
+		{Type: beacon.RoleTypeAttester, Slot: 10, ValidatorIndex: 1, CommitteeIndex: 2},
+		{Type: beacon.RoleTypeAggregator, Slot: 10, ValidatorIndex: 1, CommitteeIndex: 2},
+		{Type: beacon.RoleTypeAttester, Slot: 11, ValidatorIndex: 2, CommitteeIndex: 3},
+		{Type: beacon.RoleTypeProposer, Slot: 12, ValidatorIndex: 3},
+	}
+	subscriptions := toSubscriptions(duties)
+	require.Len(t, subscriptions, 2)
+	require.Equal(t, spec.ValidatorIndex(1), subscriptions[0].ValidatorIndex)
+	require.True(t, subscriptions[0].IsAggregator)
+	require.Equal(t, spec.ValidatorIndex(2), subscriptions[1].ValidatorIndex)
+	require.False(t, subscriptions[1].IsAggregator)
+}
+
 func TestDutyFetcher_AddMissingSlots(t *testing.T) {
 	df := dutyFetcher{
 		logger:     zap.L(),
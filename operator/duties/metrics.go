@@ -0,0 +1,46 @@
+package duties
+
+import (
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"log"
+)
+
+var (
+	metricsDutiesFetched = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:duties:fetched",
+		Help: "Count duties that were fetched for the current slot",
+	}, []string{"role"})
+	metricsDutiesDispatched = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:duties:dispatched",
+		Help: "Count duties that were dispatched to execution",
+	}, []string{"role"})
+	metricsDutiesSkipped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:duties:skipped",
+		Help: "Count duties that were skipped",
+	}, []string{"role", "reason"})
+)
+
+// reasons for skipping duties
+const (
+	skipReasonIrrelevantSlot   = "irrelevant_slot"
+	skipReasonUnknownValidator = "unknown_validator"
+	skipReasonLiquidatedOwner  = "liquidated_owner"
+)
+
+func init() {
+	if err := prometheus.Register(metricsDutiesFetched); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsDutiesDispatched); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsDutiesSkipped); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+}
+
+func reportDutySkipped(duty *beacon.Duty, reason string) {
+	metricsDutiesSkipped.WithLabelValues(duty.Type.String(), reason).Inc()
+}
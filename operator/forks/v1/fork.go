@@ -0,0 +1,52 @@
+package v1
+
+import (
+	ibftControllerFork "github.com/bloxapp/ssv/ibft/controller/forks"
+	ibftControllerForkV0 "github.com/bloxapp/ssv/ibft/controller/forks/v0"
+	networkForks "github.com/bloxapp/ssv/network/forks"
+	"github.com/bloxapp/ssv/operator/forks"
+	storageForks "github.com/bloxapp/ssv/storage/forks"
+	storageForksV0 "github.com/bloxapp/ssv/storage/forks/v0"
+)
+
+// ForkV1 is the operator fork that uses a newer network fork (v1 encoding or v2 subnets)
+type ForkV1 struct {
+	ibftForks   []ibftControllerFork.Fork
+	networkFork networkForks.Fork
+	storageFork storageForks.Fork
+}
+
+// New returns a new ForkV1 instance with the given network fork
+func New(networkFork networkForks.Fork) forks.Fork {
+	return &ForkV1{
+		ibftForks:   make([]ibftControllerFork.Fork, 0),
+		networkFork: networkFork,
+		storageFork: storageForksV0.New(),
+	}
+}
+
+// SlotTick implementation
+func (v1 *ForkV1) SlotTick(slot uint64) {
+	v1.networkFork.SlotTick(slot)
+	v1.storageFork.SlotTick(slot)
+	for _, f := range v1.ibftForks {
+		f.SlotTick(slot)
+	}
+}
+
+// NewIBFTControllerFork returns ibft controller fork
+func (v1 *ForkV1) NewIBFTControllerFork() ibftControllerFork.Fork {
+	newFork := ibftControllerForkV0.New()
+	v1.ibftForks = append(v1.ibftForks, newFork)
+	return newFork
+}
+
+// NetworkFork returns network fork
+func (v1 *ForkV1) NetworkFork() networkForks.Fork {
+	return v1.networkFork
+}
+
+// StorageFork returns storage fork
+func (v1 *ForkV1) StorageFork() storageForks.Fork {
+	return v1.storageFork
+}
@@ -115,6 +115,7 @@ func (n *operatorNode) Start() error {
 		}
 	}
 	go n.validatorsCtrl.UpdateValidatorMetaDataLoop()
+	go n.validatorsCtrl.PruneDecidedLoop()
 	n.dutyCtrl.Start()
 	go n.listenForCurrentSlot()
 
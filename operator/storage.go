@@ -52,6 +52,10 @@ func (s *storage) SaveOperatorInformation(operatorInformation *registrystorage.O
 	return s.operatorStore.SaveOperatorInformation(operatorInformation)
 }
 
+func (s *storage) UpdateOperatorInformation(operatorInformation *registrystorage.OperatorInformation) error {
+	return s.operatorStore.UpdateOperatorInformation(operatorInformation)
+}
+
 func (s *storage) ListOperators(from int64, to int64) ([]registrystorage.OperatorInformation, error) {
 	return s.operatorStore.ListOperators(from, to)
 }
@@ -60,6 +64,10 @@ func (s *storage) GetOperatorsPrefix() []byte {
 	return s.operatorStore.GetOperatorsPrefix()
 }
 
+func (s *storage) BuildOperatorsIndex() (int, error) {
+	return s.operatorStore.BuildOperatorsIndex()
+}
+
 func (s *storage) CleanRegistryData() error {
 	err := s.cleanSyncOffset()
 	if err != nil {
@@ -2,8 +2,11 @@ package storage
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
 	"sync"
 
 	"github.com/bloxapp/ssv/storage/basedb"
@@ -14,6 +17,8 @@ import (
 
 var (
 	operatorsPrefix = []byte("operators")
+	// operatorsIndexPrefix is the collection of operators public keys by index, used to list operators in order
+	operatorsIndexPrefix = []byte("operators_index/")
 )
 
 // OperatorInformation the public data of an operator
@@ -22,14 +27,20 @@ type OperatorInformation struct {
 	Name         string         `json:"name"`
 	OwnerAddress common.Address `json:"ownerAddress"`
 	Index        int64          `json:"index"`
+	Fee          *big.Int       `json:"fee,omitempty"`
+	Score        *big.Int       `json:"score,omitempty"`
+	Inactive     bool           `json:"inactive,omitempty"`
+	Deleted      bool           `json:"deleted,omitempty"`
 }
 
 // OperatorsCollection is the interface for managing operators information
 type OperatorsCollection interface {
 	GetOperatorInformation(operatorPubKey string) (*OperatorInformation, bool, error)
 	SaveOperatorInformation(operatorInformation *OperatorInformation) error
+	UpdateOperatorInformation(operatorInformation *OperatorInformation) error
 	ListOperators(from int64, to int64) ([]OperatorInformation, error)
 	GetOperatorsPrefix() []byte
+	BuildOperatorsIndex() (int, error)
 }
 
 type operatorsStorage struct {
@@ -53,27 +64,52 @@ func (s *operatorsStorage) GetOperatorsPrefix() []byte {
 	return operatorsPrefix
 }
 
-// ListOperators returns information of the all known operators
-// when 'to' equals zero, all operators will be returned
+// ListOperators returns information of the known operators in the given range of indices, sorted by index
 func (s *operatorsStorage) ListOperators(from int64, to int64) ([]OperatorInformation, error) {
 	s.operatorsLock.RLock()
 	defer s.operatorsLock.RUnlock()
 
 	var operators []OperatorInformation
-	err := s.db.GetAll(append(s.prefix, operatorsPrefix...), func(i int, obj basedb.Obj) error {
+	keys, err := s.listOperatorKeys(from, to)
+	if err != nil || len(keys) == 0 {
+		return operators, err
+	}
+	err = s.db.GetMany(s.prefix, keys, func(obj basedb.Obj) error {
 		var oi OperatorInformation
 		if err := json.Unmarshal(obj.Value, &oi); err != nil {
 			return err
 		}
-		if oi.Index >= from && oi.Index <= to {
-			operators = append(operators, oi)
-		}
+		operators = append(operators, oi)
 		return nil
 	})
-
 	return operators, err
 }
 
+// listOperatorKeys returns the keys of the operators in the given range of indices, by iterating the index
+func (s *operatorsStorage) listOperatorKeys(from int64, to int64) ([][]byte, error) {
+	if from < 0 {
+		from = 0
+	}
+	if to < from {
+		return nil, nil
+	}
+	opts := basedb.IteratorOptions{From: operatorIndexKey(from)}
+	if to < math.MaxInt64 {
+		opts.To = operatorIndexKey(to + 1)
+	}
+	it := s.db.NewIterator(s.indexPrefix(), opts)
+	defer it.Close()
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		obj, err := it.Item()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, operatorKey(string(obj.Value)))
+	}
+	return keys, nil
+}
+
 // GetOperatorInformation returns information of the given operator by public key
 func (s *operatorsStorage) GetOperatorInformation(operatorPubKey string) (*OperatorInformation, bool, error) {
 	s.operatorsLock.RLock()
@@ -121,11 +157,76 @@ func (s *operatorsStorage) SaveOperatorInformation(operatorInformation *Operator
 	if err != nil {
 		return errors.Wrap(err, "could not marshal operator information")
 	}
+	return s.db.Update(func(txn basedb.Txn) error {
+		if err := txn.Set(s.prefix, operatorKey(operatorInformation.PublicKey), raw); err != nil {
+			return err
+		}
+		return txn.Set(s.indexPrefix(), operatorIndexKey(operatorInformation.Index), []byte(operatorInformation.PublicKey))
+	})
+}
+
+// UpdateOperatorInformation overrides the information of an existing operator, the index of the operator is kept
+func (s *operatorsStorage) UpdateOperatorInformation(operatorInformation *OperatorInformation) error {
+	s.operatorsLock.Lock()
+	defer s.operatorsLock.Unlock()
+
+	info, found, err := s.getOperatorInformation(operatorInformation.PublicKey)
+	if err != nil {
+		return errors.Wrap(err, "could not read information from DB")
+	}
+	if !found {
+		return errors.New("operator not found")
+	}
+	operatorInformation.Index = info.Index
+	raw, err := json.Marshal(operatorInformation)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal operator information")
+	}
 	return s.db.Set(s.prefix, operatorKey(operatorInformation.PublicKey), raw)
 }
 
+// BuildOperatorsIndex builds the index of the stored operators according to the index of each operator,
+// returns the number of indexed operators
+func (s *operatorsStorage) BuildOperatorsIndex() (int, error) {
+	s.operatorsLock.Lock()
+	defer s.operatorsLock.Unlock()
+
+	var operators []OperatorInformation
+	err := s.db.GetAll(bytes.Join([][]byte{s.prefix, operatorsPrefix, []byte("/")}, nil), func(i int, obj basedb.Obj) error {
+		var oi OperatorInformation
+		if err := json.Unmarshal(obj.Value, &oi); err != nil {
+			return errors.Wrap(err, "could not unmarshal operator information")
+		}
+		operators = append(operators, oi)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	err = s.db.Update(func(txn basedb.Txn) error {
+		for _, oi := range operators {
+			if err := txn.Set(s.indexPrefix(), operatorIndexKey(oi.Index), []byte(oi.PublicKey)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return len(operators), err
+}
+
+// nextIndex returns the number of items in the given collection, the index is excluded as it has a different prefix
 func (s *operatorsStorage) nextIndex(prefix []byte) (int64, error) {
-	return s.db.CountByCollection(append(s.prefix, prefix...))
+	return s.db.CountByCollection(bytes.Join([][]byte{s.prefix, prefix, []byte("/")}, nil))
+}
+
+func (s *operatorsStorage) indexPrefix() []byte {
+	return bytes.Join([][]byte{s.prefix, operatorsIndexPrefix}, nil)
+}
+
+func operatorIndexKey(index int64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(index))
+	return k
 }
 
 func operatorKey(pubKey string) []byte {
@@ -2,6 +2,8 @@ package storage
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -120,6 +122,88 @@ func TestStorage_ListOperators(t *testing.T) {
 	for _, operator := range operators {
 		require.True(t, strings.Contains(operator.Name, "operator-"))
 	}
+
+	operators, err = storage.ListOperators(1, 3)
+	require.NoError(t, err)
+	require.Len(t, operators, 3)
+	for i, operator := range operators {
+		require.Equal(t, int64(i+1), operator.Index)
+		require.Equal(t, fmt.Sprintf("operator-%d", i+2), operator.Name)
+	}
+
+	operators, err = storage.ListOperators(0, math.MaxInt64)
+	require.NoError(t, err)
+	require.Len(t, operators, n)
+
+	operators, err = storage.ListOperators(3, 1)
+	require.NoError(t, err)
+	require.Len(t, operators, 0)
+}
+
+func TestStorage_BuildOperatorsIndex(t *testing.T) {
+	storage, done := newStorageForTest()
+	require.NotNil(t, storage)
+	defer done()
+
+	n := 3
+	for i := 0; i < n; i++ {
+		pk, _, err := rsaencryption.GenerateKeys()
+		require.NoError(t, err)
+		operator := OperatorInformation{
+			PublicKey: string(pk),
+			Name:      fmt.Sprintf("operator-%d", i+1),
+		}
+		require.NoError(t, storage.SaveOperatorInformation(&operator))
+	}
+	// simulate operators that were saved without an index
+	s := storage.(*operatorsStorage)
+	require.NoError(t, s.db.RemoveAllByCollection(s.indexPrefix()))
+	operators, err := storage.ListOperators(0, math.MaxInt64)
+	require.NoError(t, err)
+	require.Len(t, operators, 0)
+
+	indexed, err := storage.BuildOperatorsIndex()
+	require.NoError(t, err)
+	require.Equal(t, n, indexed)
+	operators, err = storage.ListOperators(0, math.MaxInt64)
+	require.NoError(t, err)
+	require.Len(t, operators, n)
+	for i, operator := range operators {
+		require.Equal(t, int64(i), operator.Index)
+		require.Equal(t, fmt.Sprintf("operator-%d", i+1), operator.Name)
+	}
+}
+
+func TestStorage_UpdateOperatorInformation(t *testing.T) {
+	storage, done := newStorageForTest()
+	require.NotNil(t, storage)
+	defer done()
+
+	require.Error(t, storage.UpdateOperatorInformation(&OperatorInformation{PublicKey: "unknown"}))
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, storage.SaveOperatorInformation(&OperatorInformation{
+			PublicKey: fmt.Sprintf("operator-%d", i),
+			Name:      fmt.Sprintf("operator-%d", i),
+		}))
+	}
+	oi, found, err := storage.GetOperatorInformation("operator-1")
+	require.NoError(t, err)
+	require.True(t, found)
+	oi.Fee = big.NewInt(10)
+	oi.Inactive = true
+	oi.Index = 0 // the index should be kept
+	require.NoError(t, storage.UpdateOperatorInformation(oi))
+
+	updated, found, err := storage.GetOperatorInformation("operator-1")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(1), updated.Index)
+	require.Equal(t, "operator-1", updated.Name)
+	require.EqualValues(t, 10, updated.Fee.Int64())
+	require.True(t, updated.Inactive)
+	require.False(t, updated.Deleted)
+	require.Nil(t, updated.Score)
 }
 
 func newStorageForTest() (OperatorsCollection, func()) {
@@ -0,0 +1,58 @@
+package backup
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/pkg/errors"
+)
+
+const (
+	headerMagic = "ssv-db-backup"
+	// maxHeaderSize is the max size of a header line, the reader fails if the header is larger (i.e. not a backup)
+	maxHeaderSize = 4096
+)
+
+// Header is written at the beginning of a backup, before the db snapshot
+type Header struct {
+	Magic string `json:"magic"`
+	// MigrationsVersion is the number of migrations that were applied to the db, see migrations.Version
+	MigrationsVersion int       `json:"migrationsVersion"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
+
+// Write writes a header with the given migrations version, followed by a consistent snapshot of the db
+func Write(w io.Writer, db basedb.Backuper, migrationsVersion int) error {
+	raw, err := json.Marshal(&Header{
+		Magic:             headerMagic,
+		MigrationsVersion: migrationsVersion,
+		CreatedAt:         time.Now().UTC(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not marshal header")
+	}
+	if _, err := w.Write(append(raw, '\n')); err != nil {
+		return errors.Wrap(err, "could not write header")
+	}
+	if _, err := db.Backup(w); err != nil {
+		return errors.Wrap(err, "could not backup db")
+	}
+	return nil
+}
+
+// Read reads the header of a backup, and returns a reader of the db snapshot that follows it
+func Read(r io.Reader) (*Header, io.Reader, error) {
+	br := bufio.NewReaderSize(r, maxHeaderSize)
+	line, err := br.ReadSlice('\n')
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read header")
+	}
+	var h Header
+	if err := json.Unmarshal(line, &h); err != nil || h.Magic != headerMagic {
+		return nil, nil, errors.New("not a db backup")
+	}
+	return &h, br, nil
+}
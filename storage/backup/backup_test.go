@@ -0,0 +1,88 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/kv"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestWriteAndRead(t *testing.T) {
+	db := newTestDb(t, 100)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, db.(basedb.Backuper), 5))
+
+	h, r, err := Read(&buf)
+	require.NoError(t, err)
+	require.Equal(t, 5, h.MigrationsVersion)
+	requireRestored(t, r, 100)
+
+	_, _, err = Read(bytes.NewReader([]byte("{\"magic\":\"other\"}\n")))
+	require.EqualError(t, err, "not a db backup")
+	_, _, err = Read(bytes.NewReader(make([]byte, maxHeaderSize+1)))
+	require.Error(t, err)
+}
+
+func TestServer(t *testing.T) {
+	db := newTestDb(t, 10)
+	socketPath := filepath.Join(t.TempDir(), "backup.sock")
+	require.False(t, Serving(socketPath))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := NewServer(ServerOptions{
+		Logger:     zap.L(),
+		DB:         db.(basedb.Backuper),
+		SocketPath: socketPath,
+		MigrationsVersion: func() (int, error) {
+			return 3, nil
+		},
+	})
+	go func() {
+		_ = srv.Start(ctx)
+	}()
+	require.Eventually(t, func() bool {
+		return Serving(socketPath)
+	}, time.Second*5, time.Millisecond*10)
+
+	var buf bytes.Buffer
+	require.NoError(t, Fetch(ctx, socketPath, &buf))
+	h, r, err := Read(&buf)
+	require.NoError(t, err)
+	require.Equal(t, 3, h.MigrationsVersion)
+	requireRestored(t, r, 10)
+}
+
+func newTestDb(t *testing.T, n int) basedb.IDb {
+	db, err := kv.New(basedb.Options{
+		Type:   "badger-memory",
+		Logger: zap.L(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+	for i := 0; i < n; i++ {
+		require.NoError(t, db.Set([]byte("test/"), []byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))))
+	}
+	return db
+}
+
+func requireRestored(t *testing.T, r io.Reader, n int) {
+	db := newTestDb(t, 0)
+	require.NoError(t, db.(basedb.Backuper).Restore(r))
+	count, err := db.CountByCollection([]byte("test/"))
+	require.NoError(t, err)
+	require.Equal(t, int64(n), count)
+	obj, found, err := db.Get([]byte("test/"), []byte("key-1"))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("value-1"), obj.Value)
+}
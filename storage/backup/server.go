@@ -0,0 +1,135 @@
+package backup
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const backupPath = "/backup"
+
+// ServerOptions defines the parameters of a backup server
+type ServerOptions struct {
+	Logger     *zap.Logger
+	DB         basedb.Backuper
+	SocketPath string
+	// MigrationsVersion returns the migrations version of the db, see migrations.Version
+	MigrationsVersion func() (int, error)
+}
+
+// Server serves backups of a running node's db.
+// it listens on a unix socket, which is accessible only to the user of the node
+type Server struct {
+	logger            *zap.Logger
+	db                basedb.Backuper
+	socketPath        string
+	migrationsVersion func() (int, error)
+}
+
+// NewServer creates a new backup server
+func NewServer(opts ServerOptions) *Server {
+	return &Server{
+		logger:            opts.Logger.With(zap.String("component", "storage/backup")),
+		db:                opts.DB,
+		socketPath:        opts.SocketPath,
+		migrationsVersion: opts.MigrationsVersion,
+	}
+}
+
+// Start listens on the socket and serves backups until the context is done
+func (s *Server) Start(ctx context.Context) error {
+	// a socket file might be left if the node was not stopped gracefully
+	if err := os.Remove(s.socketPath); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "could not remove existing socket")
+	}
+	l, err := net.Listen("unix", s.socketPath)
+	if err != nil {
+		return errors.Wrap(err, "could not listen on socket")
+	}
+	if err := os.Chmod(s.socketPath, 0600); err != nil {
+		_ = l.Close()
+		return errors.Wrap(err, "could not set socket permissions")
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(backupPath, s.handleBackup)
+	srv := &http.Server{Handler: mux}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	s.logger.Info("serving db backups", zap.String("socket", s.socketPath))
+	if err := srv.Serve(l); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) handleBackup(res http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(res, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	version, err := s.migrationsVersion()
+	if err != nil {
+		s.logger.Error("could not get migrations version", zap.Error(err))
+		http.Error(res, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	start := time.Now()
+	res.Header().Set("Content-Type", "application/octet-stream")
+	if err := Write(res, s.db, version); err != nil {
+		s.logger.Error("could not write backup", zap.Error(err))
+		// the response was already started, aborting it so the client won't take a partial backup
+		panic(http.ErrAbortHandler)
+	}
+	s.logger.Info("db backup was served", zap.Duration("took", time.Since(start)))
+}
+
+// Serving returns whether a node is serving backups on the given socket
+func Serving(socketPath string) bool {
+	conn, err := net.DialTimeout("unix", socketPath, time.Second)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
+
+// Fetch fetches a backup from the node that serves the given socket, and writes it into w
+func Fetch(ctx context.Context, socketPath string, w io.Writer) error {
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://unix"+backupPath, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not request backup")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("backup failed with status %d: %s", resp.StatusCode, string(msg))
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return errors.Wrap(err, "could not read backup")
+	}
+	return nil
+}
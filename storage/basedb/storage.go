@@ -2,13 +2,14 @@ package basedb
 
 import (
 	"context"
+	"io"
 
 	"go.uber.org/zap"
 )
 
 // Options for creating all db type
 type Options struct {
-	Type      string `yaml:"Type" env:"DB_TYPE" env-default:"badger-db" env-description:"Type of db badger-db or badger-memory"`
+	Type      string `yaml:"Type" env:"DB_TYPE" env-default:"badger-db" env-description:"Type of db badger-db, badger-memory, leveldb or leveldb-memory"`
 	Path      string `yaml:"Path" env:"DB_PATH" env-default:"./data/db" env-description:"Path for storage"`
 	Reporting bool   `yaml:"Reporting" env:"DB_REPORTING" env-default:"false" env-description:"Flag to run on-off db size reporting"`
 	Logger    *zap.Logger
@@ -20,7 +21,41 @@ type Txn interface {
 	Set(prefix []byte, key []byte, value []byte) error
 	Get(prefix []byte, key []byte) (Obj, bool, error)
 	Delete(prefix []byte, key []byte) error
-	// TODO: add iterator
+	// NewIterator returns an iterator over the items of the given collection, including the changes of the transaction.
+	// NOTE: some backends (e.g. badger) allow a single open iterator in a read-write transaction
+	NewIterator(prefix []byte, opts IteratorOptions) Iterator
+}
+
+// IteratorOptions defines the range and order of an iteration over a collection,
+// bounds are keys without the collection prefix
+type IteratorOptions struct {
+	// From is the lower bound (inclusive), the iteration covers the first keys of the collection if not provided
+	From []byte
+	// To is the upper bound (exclusive), the iteration covers the last keys of the collection if not provided
+	To []byte
+	// Reverse iterates in descending order of keys
+	Reverse bool
+}
+
+// Iterator iterates over the items of a collection in order of keys, it must be closed once done.
+// it is positioned on the first item (in the iteration order) when created:
+//
+//	for it.Valid() {
+//		obj, err := it.Item()
+//		...
+//		it.Next()
+//	}
+type Iterator interface {
+	// Valid returns whether the iterator points to an item within bounds
+	Valid() bool
+	// Next moves to the next item in the iteration order
+	Next()
+	// Seek moves to the given key (without prefix), or to the following key in the iteration order if it doesn't exist
+	Seek(key []byte)
+	// Item returns the current item, the key is without the collection prefix
+	Item() (Obj, error)
+	// Close releases the resources of the iterator
+	Close()
 }
 
 // RegistryStore interface for registry store
@@ -37,12 +72,23 @@ type IDb interface {
 	GetMany(prefix []byte, keys [][]byte, iterator func(Obj) error) error
 	Delete(prefix []byte, key []byte) error
 	GetAll(prefix []byte, handler func(int, Obj) error) error
+	// NewIterator returns an iterator over the items of the given collection, from a consistent view of the db
+	NewIterator(prefix []byte, opts IteratorOptions) Iterator
 	CountByCollection(prefix []byte) (int64, error)
 	RemoveAllByCollection(prefix []byte) error
 	Update(fn func(Txn) error) error
 	Close()
 }
 
+// Backuper is implemented by storages that support online backups
+type Backuper interface {
+	// Backup writes a consistent snapshot of the whole db into the given writer,
+	// it can run while the db is in use and returns the version (timestamp) of the snapshot
+	Backup(w io.Writer) (uint64, error)
+	// Restore loads a snapshot that was created with Backup, the db should not be in use
+	Restore(r io.Reader) error
+}
+
 // Obj struct for getting key/value from storage
 type Obj struct {
 	Key   []byte
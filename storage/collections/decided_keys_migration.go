@@ -0,0 +1,105 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/pkg/errors"
+)
+
+const (
+	// legacyDecidedKeyID was followed by the sequence number in little endian
+	legacyDecidedKeyID = "decided"
+	// decidedKeysMigrationBatch is the max number of keys that are migrated in a single transaction
+	decidedKeysMigrationBatch = 1000
+)
+
+// MigrateDecidedKeys re-writes the decided messages of all the ibft storages (i.e. all roles) from the legacy keys,
+// where the sequence number is encoded in little endian, to keys that are ordered by sequence number.
+// legacy and new keys are distinguishable, therefore the migration can be safely repeated if it was interrupted
+func MigrateDecidedKeys(db basedb.IDb) (int, error) {
+	var total int
+	var next []byte
+	for {
+		batch, last, err := nextLegacyDecidedBatch(db, next)
+		if err != nil {
+			return total, errors.Wrap(err, "could not read legacy decided keys")
+		}
+		if len(batch) == 0 {
+			return total, nil
+		}
+		err = db.Update(func(txn basedb.Txn) error {
+			for _, obj := range batch {
+				if err := txn.Set(nil, migratedDecidedKey(obj.Key), obj.Value); err != nil {
+					return err
+				}
+				if err := txn.Delete(nil, obj.Key); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return total, errors.Wrap(err, "could not migrate decided keys")
+		}
+		total += len(batch)
+		next = last
+	}
+}
+
+// nextLegacyDecidedBatch scans the db from the given key, and returns the next batch of legacy decided messages
+// together with the last scanned key
+func nextLegacyDecidedBatch(db basedb.IDb, from []byte) ([]basedb.Obj, []byte, error) {
+	it := db.NewIterator(nil, basedb.IteratorOptions{})
+	defer it.Close()
+	if from != nil {
+		it.Seek(from)
+	}
+	var batch []basedb.Obj
+	var last []byte
+	for ; it.Valid() && len(batch) < decidedKeysMigrationBatch; it.Next() {
+		obj, err := it.Item()
+		if err != nil {
+			return nil, nil, err
+		}
+		last = obj.Key
+		if isLegacyDecidedKey(obj.Key) {
+			batch = append(batch, obj)
+		}
+	}
+	return batch, last, nil
+}
+
+// isLegacyDecidedKey returns whether the given key is <instance type><identifier>decided<seq little endian>,
+// where the identifier is <public key hex>_<ROLE>
+func isLegacyDecidedKey(key []byte) bool {
+	idLen := len(legacyDecidedKeyID)
+	if len(key) < idLen+8 {
+		return false
+	}
+	if !bytes.Equal(key[len(key)-8-idLen:len(key)-8], []byte(legacyDecidedKeyID)) {
+		return false
+	}
+	identifier := key[:len(key)-8-idLen]
+	sep := bytes.LastIndexByte(identifier, '_')
+	if sep < 0 || sep == len(identifier)-1 {
+		return false
+	}
+	for _, c := range identifier[sep+1:] {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// migratedDecidedKey returns the new key of the given legacy key
+func migratedDecidedKey(key []byte) []byte {
+	base := key[:len(key)-8-len(legacyDecidedKeyID)]
+	seq := binary.LittleEndian.Uint64(key[len(key)-8:])
+	res := make([]byte, 0, len(base)+len(decidedKeyID)+8)
+	res = append(res, base...)
+	res = append(res, decidedKeyID...)
+	return append(res, uInt64ToByteSlice(seq)...)
+}
@@ -0,0 +1,60 @@
+package collections
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/bloxapp/ssv/ibft/proto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestMigrateDecidedKeys(t *testing.T) {
+	db := newInMemDb()
+	defer db.Close()
+	identifier := []byte("82e9b36feb8147d3f82c1a03ba246d4a63ac1ce0b1dabbb6991940a06401ab46fb4afbf971a3c5839e7d4c3ff0a38cb5_ATTESTER")
+
+	// seq numbers that are ordered differently when encoded in little endian
+	seqs := []uint64{0, 1, 255, 256, 257, 1000, 1500}
+	for _, seq := range seqs {
+		raw, err := json.Marshal(&proto.SignedMessage{
+			Message: &proto.Message{Lambda: identifier, SeqNumber: seq},
+		})
+		require.NoError(t, err)
+		le := make([]byte, 8)
+		binary.LittleEndian.PutUint64(le, seq)
+		key := append(append([]byte{}, identifier...), []byte(legacyDecidedKeyID)...)
+		require.NoError(t, db.Set([]byte("attestation"), append(key, le...), raw))
+	}
+	// non-decided items should remain untouched
+	require.NoError(t, db.Set([]byte("attestation"), append(append([]byte{}, identifier...), []byte("highest")...), []byte("x")))
+	require.NoError(t, db.Set([]byte("operator/"), []byte("operators/decided12345678"), []byte("y")))
+
+	n, err := MigrateDecidedKeys(db)
+	require.NoError(t, err)
+	require.Equal(t, len(seqs), n)
+
+	storage := NewIbft(db, zap.L(), "attestation")
+	msgs, err := storage.GetDecidedInRange(identifier, 1, 1000)
+	require.NoError(t, err)
+	require.Len(t, msgs, 5)
+	for i, msg := range msgs {
+		require.Equal(t, seqs[i+1], msg.Message.SeqNumber)
+	}
+
+	_, found, err := db.Get([]byte("attestation"), append(append([]byte{}, identifier...), []byte("highest")...))
+	require.NoError(t, err)
+	require.True(t, found)
+	_, found, err = db.Get([]byte("operator/"), []byte("operators/decided12345678"))
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// running again should not find legacy keys
+	n, err = MigrateDecidedKeys(db)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	count, err := db.CountByCollection(append([]byte("attestation"), identifier...))
+	require.NoError(t, err)
+	require.Equal(t, int64(len(seqs)+1), count)
+}
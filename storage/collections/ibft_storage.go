@@ -3,6 +3,8 @@ package collections
 import (
 	"encoding/binary"
 	"encoding/json"
+	"math"
+
 	"github.com/bloxapp/ssv/ibft/proto"
 	"github.com/bloxapp/ssv/storage/basedb"
 	"github.com/pkg/errors"
@@ -25,14 +27,30 @@ type Iibft interface {
 	SaveDecidedMessages(msgs []*proto.SignedMessage) error
 	// GetDecided returns a signed message for an ibft instance which decided by identifier
 	GetDecided(identifier []byte, seqNumber uint64) (*proto.SignedMessage, bool, error)
-	// GetDecidedInRange returns decided message in the given range
+	// GetDecidedInRange returns decided message in the given range, sorted by sequence number
 	GetDecidedInRange(identifier []byte, from uint64, to uint64) ([]*proto.SignedMessage, error)
 	// SaveHighestDecidedInstance saves a signed message for an ibft instance which is currently highest
 	SaveHighestDecidedInstance(signedMsg *proto.SignedMessage) error
 	// GetHighestDecidedInstance gets a signed message for an ibft instance which is the highest
 	GetHighestDecidedInstance(identifier []byte) (*proto.SignedMessage, bool, error)
+	// PruneDecided deletes the decided messages with a sequence number lower than the given one
+	PruneDecided(identifier []byte, below uint64) (int, int64, error)
+	// GetPrunedBelow returns the sequence number below which decided messages were pruned
+	GetPrunedBelow(identifier []byte) (uint64, error)
+	// CleanDecided deletes all the decided messages of the given identifier, including the highest decided
+	CleanDecided(identifier []byte) error
 }
 
+const (
+	// decidedKeyID is followed by the sequence number in big endian,
+	// so decided messages are stored in order of sequence and can be read with range scans
+	decidedKeyID = "decided/"
+	// prunedKeyID is the sequence number below which decided messages were pruned
+	prunedKeyID = "pruned"
+	// pruneBatchSize is the max number of decided messages that are deleted in a single transaction
+	pruneBatchSize = 1000
+)
+
 var (
 	metricsHighestDecided = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "ssv:validator:ibft_highest_decided",
@@ -95,15 +113,14 @@ func (i *IbftStorage) SaveDecided(signedMsg *proto.SignedMessage) error {
 	if err != nil {
 		return errors.Wrap(err, "marshaling error")
 	}
-	return i.save(value, "decided", signedMsg.Message.Lambda, uInt64ToByteSlice(signedMsg.Message.SeqNumber))
+	return i.save(value, decidedKeyID, signedMsg.Message.Lambda, uInt64ToByteSlice(signedMsg.Message.SeqNumber))
 }
 
 // SaveDecidedMessages func implementation
 func (i *IbftStorage) SaveDecidedMessages(msgs []*proto.SignedMessage) error {
 	return i.db.SetMany(i.prefix, len(msgs), func(j int) (basedb.Obj, error) {
 		msg := msgs[j]
-		k := i.key("decided", uInt64ToByteSlice(msg.Message.SeqNumber))
-		key := append(msg.Message.Lambda, k...)
+		key := i.key(string(msg.Message.Lambda), []byte(decidedKeyID), uInt64ToByteSlice(msg.Message.SeqNumber))
 		value, err := json.Marshal(msg)
 		if err != nil {
 			return basedb.Obj{}, err
@@ -114,7 +131,7 @@ func (i *IbftStorage) SaveDecidedMessages(msgs []*proto.SignedMessage) error {
 
 // GetDecided returns a signed message for an ibft instance which decided by identifier
 func (i *IbftStorage) GetDecided(identifier []byte, seqNumber uint64) (*proto.SignedMessage, bool, error) {
-	val, found, err := i.get("decided", identifier, uInt64ToByteSlice(seqNumber))
+	val, found, err := i.get(decidedKeyID, identifier, uInt64ToByteSlice(seqNumber))
 	if !found {
 		return nil, found, nil
 	}
@@ -128,29 +145,128 @@ func (i *IbftStorage) GetDecided(identifier []byte, seqNumber uint64) (*proto.Si
 	return ret, found, nil
 }
 
-// GetDecidedInRange returns decided message in the given range
+// GetDecidedInRange returns decided message in the given range, sorted by sequence number
 func (i *IbftStorage) GetDecidedInRange(identifier []byte, from uint64, to uint64) ([]*proto.SignedMessage, error) {
-	prefix := make([]byte, len(i.prefix))
-	copy(prefix, i.prefix)
-	prefix = append(prefix, identifier...)
-
-	var sequences [][]byte
-	for seq := from; seq <= to; seq++ {
-		sequences = append(sequences, i.key("decided", uInt64ToByteSlice(seq)))
-	}
 	msgs := make([]*proto.SignedMessage, 0)
-	err := i.db.GetMany(prefix, sequences, func(obj basedb.Obj) error {
+	if to < from {
+		return msgs, nil
+	}
+	opts := basedb.IteratorOptions{From: uInt64ToByteSlice(from)}
+	if to < math.MaxUint64 {
+		opts.To = uInt64ToByteSlice(to + 1)
+	}
+	it := i.db.NewIterator(i.key(string(i.prefix), identifier, []byte(decidedKeyID)), opts)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		obj, err := it.Item()
+		if err != nil {
+			return []*proto.SignedMessage{}, err
+		}
 		msg := proto.SignedMessage{}
 		if err := json.Unmarshal(obj.Value, &msg); err != nil {
-			return errors.Wrap(err, "un-marshaling error")
+			return []*proto.SignedMessage{}, errors.Wrap(err, "un-marshaling error")
 		}
 		msgs = append(msgs, &msg)
+	}
+	return msgs, nil
+}
+
+// PruneDecided deletes the decided messages of the given identifier with a sequence number lower than the given one,
+// it returns the number of pruned messages and their size in bytes.
+// the pruned sequence is saved before deleting, so sync responders won't serve a range that is being pruned
+func (i *IbftStorage) PruneDecided(identifier []byte, below uint64) (int, int64, error) {
+	prunedBelow, err := i.GetPrunedBelow(identifier)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "could not get pruned sequence")
+	}
+	if below > prunedBelow {
+		if err := i.save(uInt64ToByteSlice(below), prunedKeyID, identifier); err != nil {
+			return 0, 0, errors.Wrap(err, "could not save pruned sequence")
+		}
+	}
+	return i.deleteDecided(identifier, uInt64ToByteSlice(below))
+}
+
+// CleanDecided deletes all the decided messages of the given identifier, together with the highest decided,
+// the current instance and the pruned sequence
+func (i *IbftStorage) CleanDecided(identifier []byte) error {
+	if _, _, err := i.deleteDecided(identifier, nil); err != nil {
+		return err
+	}
+	prefix := append(i.prefix, identifier...)
+	err := i.db.Update(func(txn basedb.Txn) error {
+		for _, id := range []string{"highest", "current", prunedKeyID} {
+			if err := txn.Delete(prefix, i.key(id)); err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 	if err != nil {
-		return []*proto.SignedMessage{}, err
+		return errors.Wrap(err, "could not delete decided instances")
 	}
-	return msgs, nil
+	deleteHighestDecided(identifier)
+	return nil
+}
+
+// deleteDecided deletes the decided messages of the given identifier below the given (big endian) sequence number,
+// or all of them if it is nil. it returns the number of deleted messages and their size in bytes
+func (i *IbftStorage) deleteDecided(identifier []byte, to []byte) (int, int64, error) {
+	prefix := i.key(string(i.prefix), identifier, []byte(decidedKeyID))
+	var n int
+	var size int64
+	for {
+		keys, batchSize, err := i.nextDeleteBatch(prefix, to)
+		if err != nil {
+			return n, size, errors.Wrap(err, "could not read decided messages")
+		}
+		if len(keys) == 0 {
+			return n, size, nil
+		}
+		err = i.db.Update(func(txn basedb.Txn) error {
+			for _, k := range keys {
+				if err := txn.Delete(prefix, k); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return n, size, errors.Wrap(err, "could not delete decided messages")
+		}
+		n += len(keys)
+		size += batchSize
+	}
+}
+
+// nextDeleteBatch returns the keys (without prefix) of the next batch of decided messages to delete, and their size
+func (i *IbftStorage) nextDeleteBatch(prefix []byte, to []byte) ([][]byte, int64, error) {
+	it := i.db.NewIterator(prefix, basedb.IteratorOptions{To: to})
+	defer it.Close()
+	var keys [][]byte
+	var size int64
+	for ; it.Valid() && len(keys) < pruneBatchSize; it.Next() {
+		obj, err := it.Item()
+		if err != nil {
+			return nil, 0, err
+		}
+		keys = append(keys, obj.Key)
+		size += int64(len(prefix) + len(obj.Key) + len(obj.Value))
+	}
+	return keys, size, nil
+}
+
+// GetPrunedBelow returns the sequence number below which the decided messages of the given identifier were pruned,
+// or 0 if they were never pruned
+func (i *IbftStorage) GetPrunedBelow(identifier []byte) (uint64, error) {
+	val, found, err := i.get(prunedKeyID, identifier)
+	if err != nil || !found {
+		return 0, err
+	}
+	if len(val) != 8 {
+		return 0, errors.New("invalid pruned sequence")
+	}
+	return binary.BigEndian.Uint64(val), nil
 }
 
 // SaveHighestDecidedInstance saves a signed message for an ibft instance which is currently highest
@@ -178,6 +294,14 @@ func reportHighestDecided(signedMsg *proto.SignedMessage) {
 	}
 }
 
+// deleteHighestDecided deletes the highest decided metric of the given identifier
+func deleteHighestDecided(identifier []byte) {
+	l := string(identifier)
+	if idx := strings.Index(l, "_"); idx > 0 {
+		metricsHighestDecided.DeleteLabelValues(l, l[:idx])
+	}
+}
+
 // GetHighestDecidedInstance gets a signed message for an ibft instance which is the highest
 func (i *IbftStorage) GetHighestDecidedInstance(identifier []byte) (*proto.SignedMessage, bool, error) {
 	val, found, err := i.get("highest", identifier)
@@ -223,6 +347,6 @@ func (i *IbftStorage) key(id string, params ...[]byte) []byte {
 
 func uInt64ToByteSlice(n uint64) []byte {
 	b := make([]byte, 8)
-	binary.LittleEndian.PutUint64(b, n)
+	binary.BigEndian.PutUint64(b, n)
 	return b
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
+	"math"
 	"os"
 	"path"
 	"sync"
@@ -135,6 +136,112 @@ func TestIbftStorage_SaveDecided(t *testing.T) {
 	require.False(t, found)
 }
 
+func TestIbftStorage_GetDecidedInRange(t *testing.T) {
+	storage := NewIbft(newInMemDb(), zap.L(), "attestation")
+	for _, seq := range []uint64{300, 1, 255, 256, 2, 0} {
+		require.NoError(t, storage.SaveDecided(&proto.SignedMessage{
+			Message: &proto.Message{Lambda: []byte{1, 2, 3, 4}, SeqNumber: seq},
+		}))
+	}
+	// other identifiers should not be included
+	require.NoError(t, storage.SaveDecided(&proto.SignedMessage{
+		Message: &proto.Message{Lambda: []byte{1, 2, 3, 4, 5}, SeqNumber: 3},
+	}))
+
+	msgs, err := storage.GetDecidedInRange([]byte{1, 2, 3, 4}, 1, 256)
+	require.NoError(t, err)
+	require.Len(t, msgs, 4)
+	for i, seq := range []uint64{1, 2, 255, 256} {
+		require.Equal(t, seq, msgs[i].Message.SeqNumber)
+	}
+
+	msgs, err = storage.GetDecidedInRange([]byte{1, 2, 3, 4}, 256, math.MaxUint64)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+
+	msgs, err = storage.GetDecidedInRange([]byte{1, 2, 3, 4}, 10, 5)
+	require.NoError(t, err)
+	require.Len(t, msgs, 0)
+}
+
+func TestIbftStorage_PruneDecided(t *testing.T) {
+	storage := NewIbft(newInMemDb(), zap.L(), "attestation")
+	identifier := []byte{1, 2, 3, 4}
+	for seq := uint64(0); seq < 2500; seq++ {
+		require.NoError(t, storage.SaveDecided(&proto.SignedMessage{
+			Message: &proto.Message{Lambda: identifier, SeqNumber: seq},
+		}))
+	}
+	require.NoError(t, storage.SaveDecided(&proto.SignedMessage{
+		Message: &proto.Message{Lambda: []byte{1, 2, 3, 4, 5}, SeqNumber: 0},
+	}))
+	prunedBelow, err := storage.GetPrunedBelow(identifier)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, prunedBelow)
+
+	n, size, err := storage.PruneDecided(identifier, 2100)
+	require.NoError(t, err)
+	require.Equal(t, 2100, n)
+	require.Greater(t, size, int64(0))
+	prunedBelow, err = storage.GetPrunedBelow(identifier)
+	require.NoError(t, err)
+	require.EqualValues(t, 2100, prunedBelow)
+
+	msgs, err := storage.GetDecidedInRange(identifier, 0, math.MaxUint64)
+	require.NoError(t, err)
+	require.Len(t, msgs, 400)
+	require.EqualValues(t, 2100, msgs[0].Message.SeqNumber)
+	// other identifiers should not be pruned
+	_, found, err := storage.GetDecided([]byte{1, 2, 3, 4, 5}, 0)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// pruned sequence is not lowered
+	n, _, err = storage.PruneDecided(identifier, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	prunedBelow, err = storage.GetPrunedBelow(identifier)
+	require.NoError(t, err)
+	require.EqualValues(t, 2100, prunedBelow)
+}
+
+func TestIbftStorage_CleanDecided(t *testing.T) {
+	storage := NewIbft(newInMemDb(), zap.L(), "attestation")
+	identifier := []byte{1, 2, 3, 4}
+	for seq := uint64(0); seq < 1500; seq++ {
+		require.NoError(t, storage.SaveDecided(&proto.SignedMessage{
+			Message: &proto.Message{Lambda: identifier, SeqNumber: seq},
+		}))
+	}
+	require.NoError(t, storage.SaveHighestDecidedInstance(&proto.SignedMessage{
+		Message: &proto.Message{Lambda: identifier, SeqNumber: 1499},
+	}))
+	_, _, err := storage.PruneDecided(identifier, 10)
+	require.NoError(t, err)
+	other := &proto.SignedMessage{Message: &proto.Message{Lambda: []byte{1, 2, 3, 4, 5}, SeqNumber: 0}}
+	require.NoError(t, storage.SaveDecided(other))
+	require.NoError(t, storage.SaveHighestDecidedInstance(other))
+
+	require.NoError(t, storage.CleanDecided(identifier))
+	msgs, err := storage.GetDecidedInRange(identifier, 0, math.MaxUint64)
+	require.NoError(t, err)
+	require.Len(t, msgs, 0)
+	_, found, err := storage.GetHighestDecidedInstance(identifier)
+	require.NoError(t, err)
+	require.False(t, found)
+	prunedBelow, err := storage.GetPrunedBelow(identifier)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, prunedBelow)
+
+	// other identifiers should not be deleted
+	_, found, err = storage.GetDecided(other.Message.Lambda, 0)
+	require.NoError(t, err)
+	require.True(t, found)
+	_, found, err = storage.GetHighestDecidedInstance(other.Message.Lambda)
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
 func TestIbftStorage_SaveCurrentInstance(t *testing.T) {
 	storage := NewIbft(newInMemDb(), zap.L(), "attestation")
 	err := storage.SaveCurrentInstance([]byte{1, 2, 3, 4}, &proto.State{
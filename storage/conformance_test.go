@@ -0,0 +1,360 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// backends are all the supported db types, each is expected to pass the conformance tests
+var backends = []string{"badger-memory", "badger-db", "leveldb-memory", "leveldb"}
+
+// conformanceTests define the expected behavior of basedb.IDb
+var conformanceTests = []struct {
+	name string
+	test func(t *testing.T, db basedb.IDb)
+}{
+	{"set and get", testSetGet},
+	{"set many", testSetMany},
+	{"get many", testGetMany},
+	{"delete", testDelete},
+	{"get all", testGetAll},
+	{"count and remove collection", testCollection},
+	{"update", testUpdate},
+	{"prefix is not modified", testPrefixNotModified},
+	{"iterator", testIterator},
+	{"iterator seek", testIteratorSeek},
+	{"transaction iterator", testTxnIterator},
+}
+
+func TestConformance(t *testing.T) {
+	for _, backend := range backends {
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			for _, ct := range conformanceTests {
+				ct := ct
+				t.Run(ct.name, func(t *testing.T) {
+					db, err := GetStorageFactory(basedb.Options{
+						Type:   backend,
+						Logger: zap.L(),
+						Path:   t.TempDir(),
+					})
+					require.NoError(t, err)
+					defer db.Close()
+					ct.test(t, db)
+				})
+			}
+		})
+	}
+}
+
+func TestGetStorageFactory_Unsupported(t *testing.T) {
+	_, err := GetStorageFactory(basedb.Options{Type: "xxx", Logger: zap.L()})
+	require.EqualError(t, err, "unsupported storage type passed")
+}
+
+func testSetGet(t *testing.T, db basedb.IDb) {
+	require.NoError(t, db.Set([]byte("prefix1/"), []byte("key1"), []byte("value1")))
+	require.NoError(t, db.Set([]byte("prefix2/"), []byte("key1"), []byte("value2")))
+
+	obj, found, err := db.Get([]byte("prefix1/"), []byte("key1"))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("key1"), obj.Key)
+	require.Equal(t, []byte("value1"), obj.Value)
+
+	obj, found, err = db.Get([]byte("prefix2/"), []byte("key1"))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("value2"), obj.Value)
+
+	// override
+	require.NoError(t, db.Set([]byte("prefix1/"), []byte("key1"), []byte("value3")))
+	obj, found, err = db.Get([]byte("prefix1/"), []byte("key1"))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("value3"), obj.Value)
+
+	_, found, err = db.Get([]byte("prefix1/"), []byte("unknown"))
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func testSetMany(t *testing.T, db basedb.IDb) {
+	prefix := []byte("prefix/")
+	require.NoError(t, db.SetMany(prefix, 10, func(i int) (basedb.Obj, error) {
+		return basedb.Obj{Key: []byte(fmt.Sprintf("key-%d", i)), Value: []byte(fmt.Sprintf("value-%d", i))}, nil
+	}))
+	count, err := db.CountByCollection(prefix)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, count)
+	obj, found, err := db.Get(prefix, []byte("key-7"))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("value-7"), obj.Value)
+
+	// nothing is saved if some item failed
+	err = db.SetMany([]byte("failed/"), 10, func(i int) (basedb.Obj, error) {
+		if i == 5 {
+			return basedb.Obj{}, errors.New("test")
+		}
+		return basedb.Obj{Key: []byte(fmt.Sprintf("key-%d", i)), Value: []byte("value")}, nil
+	})
+	require.EqualError(t, err, "test")
+	count, err = db.CountByCollection([]byte("failed/"))
+	require.NoError(t, err)
+	require.EqualValues(t, 0, count)
+}
+
+func testGetMany(t *testing.T, db basedb.IDb) {
+	prefix := []byte("prefix/")
+	for i := 0; i < 5; i++ {
+		require.NoError(t, db.Set(prefix, []byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))))
+	}
+	var results []basedb.Obj
+	err := db.GetMany(prefix, [][]byte{[]byte("key-3"), []byte("unknown"), []byte("key-1")}, func(obj basedb.Obj) error {
+		results = append(results, obj)
+		return nil
+	})
+	require.NoError(t, err)
+	// missing keys are skipped, results are in the order of the given keys
+	require.Equal(t, []basedb.Obj{
+		{Key: []byte("key-3"), Value: []byte("value-3")},
+		{Key: []byte("key-1"), Value: []byte("value-1")},
+	}, results)
+
+	err = db.GetMany(prefix, [][]byte{[]byte("key-1")}, func(obj basedb.Obj) error {
+		return errors.New("test")
+	})
+	require.EqualError(t, err, "test")
+
+	require.NoError(t, db.GetMany(prefix, nil, func(obj basedb.Obj) error {
+		return errors.New("should not be called")
+	}))
+}
+
+func testDelete(t *testing.T, db basedb.IDb) {
+	require.NoError(t, db.Set([]byte("prefix/"), []byte("key"), []byte("value")))
+	require.NoError(t, db.Delete([]byte("prefix/"), []byte("key")))
+	_, found, err := db.Get([]byte("prefix/"), []byte("key"))
+	require.NoError(t, err)
+	require.False(t, found)
+	// deleting a missing key is not an error
+	require.NoError(t, db.Delete([]byte("prefix/"), []byte("unknown")))
+}
+
+func testGetAll(t *testing.T, db basedb.IDb) {
+	prefix := []byte("prefix/")
+	expected := make(map[string]string)
+	for i := 0; i < 200; i++ {
+		key, value := fmt.Sprintf("key-%03d", i), fmt.Sprintf("value-%d", i)
+		expected[key] = value
+		require.NoError(t, db.Set(prefix, []byte(key), []byte(value)))
+	}
+	// a collection that starts with the same prefix
+	require.NoError(t, db.Set([]byte("prefix"), []byte("x"), []byte("value")))
+	require.NoError(t, db.Set([]byte("prefix/2"), []byte("x"), []byte("value")))
+
+	results := make(map[string]string)
+	var keys []string
+	var indexes []int
+	err := db.GetAll(prefix, func(i int, obj basedb.Obj) error {
+		results[string(obj.Key)] = string(obj.Value)
+		keys = append(keys, string(obj.Key))
+		indexes = append(indexes, i)
+		return nil
+	})
+	require.NoError(t, err)
+	expected["2x"] = "value"
+	require.Equal(t, expected, results)
+	// keys are ordered and indexes are sequential
+	require.True(t, sort.StringsAreSorted(keys))
+	for i, index := range indexes {
+		require.Equal(t, i, index)
+	}
+
+	err = db.GetAll(prefix, func(i int, obj basedb.Obj) error {
+		return errors.New("test")
+	})
+	require.EqualError(t, err, "test")
+
+	require.NoError(t, db.GetAll([]byte("empty/"), func(i int, obj basedb.Obj) error {
+		return errors.New("should not be called")
+	}))
+}
+
+func testCollection(t *testing.T, db basedb.IDb) {
+	for i := 0; i < 10; i++ {
+		require.NoError(t, db.Set([]byte("prefix1/"), []byte(fmt.Sprintf("key-%d", i)), []byte("value")))
+	}
+	require.NoError(t, db.Set([]byte("prefix2/"), []byte("key"), []byte("value")))
+
+	count, err := db.CountByCollection([]byte("prefix1/"))
+	require.NoError(t, err)
+	require.EqualValues(t, 10, count)
+
+	require.NoError(t, db.RemoveAllByCollection([]byte("prefix1/")))
+	count, err = db.CountByCollection([]byte("prefix1/"))
+	require.NoError(t, err)
+	require.EqualValues(t, 0, count)
+	count, err = db.CountByCollection([]byte("prefix2/"))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, count)
+}
+
+func testUpdate(t *testing.T, db basedb.IDb) {
+	prefix := []byte("prefix/")
+	require.NoError(t, db.Set(prefix, []byte("existing"), []byte("value")))
+
+	require.NoError(t, db.Update(func(txn basedb.Txn) error {
+		if err := txn.Set(prefix, []byte("key"), []byte("value")); err != nil {
+			return err
+		}
+		// the transaction reads its own writes
+		obj, found, err := txn.Get(prefix, []byte("key"))
+		if err != nil || !found || string(obj.Value) != "value" {
+			return errors.New("could not read own write")
+		}
+		return txn.Delete(prefix, []byte("existing"))
+	}))
+	_, found, err := db.Get(prefix, []byte("key"))
+	require.NoError(t, err)
+	require.True(t, found)
+	_, found, err = db.Get(prefix, []byte("existing"))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// changes are discarded on error
+	err = db.Update(func(txn basedb.Txn) error {
+		if err := txn.Set(prefix, []byte("discarded"), []byte("value")); err != nil {
+			return err
+		}
+		return errors.New("test")
+	})
+	require.EqualError(t, err, "test")
+	_, found, err = db.Get(prefix, []byte("discarded"))
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func testPrefixNotModified(t *testing.T, db basedb.IDb) {
+	// a prefix with extra capacity might be overridden by append
+	prefix := make([]byte, 0, 64)
+	prefix = append(prefix, []byte("prefix/")...)
+	require.NoError(t, db.Set(prefix, []byte("key1"), []byte("value1")))
+	require.NoError(t, db.Set(prefix, []byte("key2"), []byte("value2")))
+	require.NoError(t, db.GetMany(prefix, [][]byte{[]byte("key1"), []byte("key2")}, func(obj basedb.Obj) error {
+		return nil
+	}))
+	require.Equal(t, []byte("prefix/"), prefix)
+
+	var keys []string
+	require.NoError(t, db.GetAll([]byte("prefix/"), func(i int, obj basedb.Obj) error {
+		keys = append(keys, string(obj.Key))
+		return nil
+	}))
+	require.Equal(t, []string{"key1", "key2"}, keys)
+}
+
+// iterate returns the keys of the given iterator, and closes it
+func iterate(t *testing.T, it basedb.Iterator) []string {
+	defer it.Close()
+	var keys []string
+	for it.Valid() {
+		obj, err := it.Item()
+		require.NoError(t, err)
+		require.Equal(t, "value-"+string(obj.Key), string(obj.Value))
+		keys = append(keys, string(obj.Key))
+		it.Next()
+	}
+	return keys
+}
+
+func setIteratorItems(t *testing.T, db basedb.IDb, prefix []byte) {
+	for _, k := range []string{"b", "d", "a", "c", "e"} {
+		require.NoError(t, db.Set(prefix, []byte(k), []byte("value-"+k)))
+	}
+	// neighbour collections
+	require.NoError(t, db.Set([]byte("prefix"), []byte("x"), []byte("value-x")))
+	require.NoError(t, db.Set([]byte("prefix0"), []byte("x"), []byte("value-x")))
+	require.NoError(t, db.Set([]byte("prefiw"), []byte("x"), []byte("value-x")))
+}
+
+func testIterator(t *testing.T, db basedb.IDb) {
+	prefix := []byte("prefix/")
+	setIteratorItems(t, db, prefix)
+
+	tests := []struct {
+		name     string
+		opts     basedb.IteratorOptions
+		expected []string
+	}{
+		{"all", basedb.IteratorOptions{}, []string{"a", "b", "c", "d", "e"}},
+		{"reverse", basedb.IteratorOptions{Reverse: true}, []string{"e", "d", "c", "b", "a"}},
+		{"from", basedb.IteratorOptions{From: []byte("b")}, []string{"b", "c", "d", "e"}},
+		{"to", basedb.IteratorOptions{To: []byte("d")}, []string{"a", "b", "c"}},
+		{"range", basedb.IteratorOptions{From: []byte("bb"), To: []byte("d")}, []string{"c"}},
+		{"reverse range", basedb.IteratorOptions{From: []byte("b"), To: []byte("d"), Reverse: true}, []string{"c", "b"}},
+		{"reverse to", basedb.IteratorOptions{To: []byte("cc"), Reverse: true}, []string{"c", "b", "a"}},
+		{"empty range", basedb.IteratorOptions{From: []byte("x"), To: []byte("z")}, nil},
+		{"inverted range", basedb.IteratorOptions{From: []byte("d"), To: []byte("b")}, nil},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.expected, iterate(t, db.NewIterator(prefix, test.opts)), test.name)
+	}
+
+	require.Nil(t, iterate(t, db.NewIterator([]byte("empty/"), basedb.IteratorOptions{})))
+	require.Nil(t, iterate(t, db.NewIterator([]byte("empty/"), basedb.IteratorOptions{Reverse: true})))
+}
+
+func testIteratorSeek(t *testing.T, db basedb.IDb) {
+	prefix := []byte("prefix/")
+	setIteratorItems(t, db, prefix)
+
+	seek := func(opts basedb.IteratorOptions, key string) []string {
+		it := db.NewIterator(prefix, opts)
+		it.Seek([]byte(key))
+		return iterate(t, it)
+	}
+	require.Equal(t, []string{"c", "d", "e"}, seek(basedb.IteratorOptions{}, "c"))
+	require.Equal(t, []string{"d", "e"}, seek(basedb.IteratorOptions{}, "cc"))
+	require.Nil(t, seek(basedb.IteratorOptions{}, "z"))
+	require.Equal(t, []string{"c", "b", "a"}, seek(basedb.IteratorOptions{Reverse: true}, "c"))
+	require.Equal(t, []string{"c", "b", "a"}, seek(basedb.IteratorOptions{Reverse: true}, "cc"))
+	require.Equal(t, []string{"e", "d", "c", "b", "a"}, seek(basedb.IteratorOptions{Reverse: true}, "z"))
+	require.Nil(t, seek(basedb.IteratorOptions{Reverse: true}, "0"))
+
+	// seek is limited to the bounds
+	bounds := basedb.IteratorOptions{From: []byte("b"), To: []byte("d")}
+	require.Equal(t, []string{"b", "c"}, seek(bounds, "a"))
+	require.Nil(t, seek(bounds, "d"))
+	bounds.Reverse = true
+	require.Equal(t, []string{"c", "b"}, seek(bounds, "z"))
+	require.Nil(t, seek(bounds, "a"))
+}
+
+func testTxnIterator(t *testing.T, db basedb.IDb) {
+	prefix := []byte("prefix/")
+	setIteratorItems(t, db, prefix)
+
+	var keys, reversed []string
+	require.NoError(t, db.Update(func(txn basedb.Txn) error {
+		if err := txn.Set(prefix, []byte("bb"), []byte("value-bb")); err != nil {
+			return err
+		}
+		if err := txn.Delete(prefix, []byte("d")); err != nil {
+			return err
+		}
+		// the iterator includes the changes of the transaction
+		keys = iterate(t, txn.NewIterator(prefix, basedb.IteratorOptions{From: []byte("b")}))
+		reversed = iterate(t, txn.NewIterator(prefix, basedb.IteratorOptions{Reverse: true}))
+		return nil
+	}))
+	require.Equal(t, []string{"b", "bb", "c", "e"}, keys)
+	require.Equal(t, []string{"e", "c", "bb", "b", "a"}, reversed)
+}
@@ -2,6 +2,7 @@ package kv
 
 import (
 	"bytes"
+	"io"
 	"time"
 
 	"github.com/bloxapp/ssv/storage/basedb"
@@ -14,6 +15,8 @@ import (
 const (
 	// EntryNotFoundError is an error for a storage entry not found
 	EntryNotFoundError = "EntryNotFoundError"
+	// restoreMaxPendingWrites is the max number of pending writes while loading a backup
+	restoreMaxPendingWrites = 256
 )
 
 // BadgerDb struct
@@ -73,7 +76,7 @@ func (b *BadgerDb) SetMany(prefix []byte, n int, next func(int) (basedb.Obj, err
 			wb.Cancel()
 			return err
 		}
-		if err := wb.Set(append(prefix, item.Key...), item.Value); err != nil {
+		if err := wb.Set(prefixedKey(prefix, item.Key), item.Value); err != nil {
 			wb.Cancel()
 			return err
 		}
@@ -184,6 +187,16 @@ func (b *BadgerDb) RemoveAllByCollection(prefix []byte) error {
 	return b.db.DropPrefix(prefix)
 }
 
+// Backup streams a consistent snapshot of the db into the given writer, using badger's stream backup
+func (b *BadgerDb) Backup(w io.Writer) (uint64, error) {
+	return b.db.Backup(w, 0)
+}
+
+// Restore loads a snapshot that was created with Backup
+func (b *BadgerDb) Restore(r io.Reader) error {
+	return b.db.Load(r, restoreMaxPendingWrites)
+}
+
 // Close close db
 func (b *BadgerDb) Close() {
 	if err := b.db.Close(); err != nil {
@@ -235,7 +248,7 @@ type badgerTxn struct {
 }
 
 func (t badgerTxn) Set(prefix []byte, key []byte, value []byte) error {
-	return t.txn.Set(append(prefix, key...), value)
+	return t.txn.Set(prefixedKey(prefix, key), value)
 }
 
 func (t badgerTxn) Get(prefix []byte, key []byte) (obj basedb.Obj, found bool, err error) {
@@ -258,5 +271,108 @@ func (t badgerTxn) Get(prefix []byte, key []byte) (obj basedb.Obj, found bool, e
 }
 
 func (t badgerTxn) Delete(prefix []byte, key []byte) error {
-	return t.txn.Delete(append(prefix, key...))
+	return t.txn.Delete(prefixedKey(prefix, key))
+}
+
+func (t badgerTxn) NewIterator(prefix []byte, opts basedb.IteratorOptions) basedb.Iterator {
+	return newBadgerIterator(t.txn, false, prefix, opts)
+}
+
+// NewIterator returns an iterator over the items of the given collection, within a read-only transaction
+func (b *BadgerDb) NewIterator(prefix []byte, opts basedb.IteratorOptions) basedb.Iterator {
+	return newBadgerIterator(b.db.NewTransaction(false), true, prefix, opts)
+}
+
+// badgerIterator implements basedb.Iterator on top of badger's iterator
+type badgerIterator struct {
+	txn *badger.Txn
+	// owned is true if the transaction was created for the iterator, and therefore should be discarded on close
+	owned  bool
+	it     *badger.Iterator
+	prefix []byte
+	opts   basedb.IteratorOptions
+}
+
+func newBadgerIterator(txn *badger.Txn, owned bool, prefix []byte, opts basedb.IteratorOptions) *badgerIterator {
+	itOpts := badger.DefaultIteratorOptions
+	itOpts.Prefix = prefix
+	itOpts.Reverse = opts.Reverse
+	bi := &badgerIterator{
+		txn:    txn,
+		owned:  owned,
+		it:     txn.NewIterator(itOpts),
+		prefix: copyBytes(prefix),
+		opts:   opts,
+	}
+	bi.rewind()
+	return bi
+}
+
+// rewind moves to the first item in the iteration order
+func (bi *badgerIterator) rewind() {
+	if !bi.opts.Reverse {
+		bi.it.Seek(prefixedKey(bi.prefix, bi.opts.From))
+		return
+	}
+	upper := prefixEnd(bi.prefix)
+	if bi.opts.To != nil {
+		upper = prefixedKey(bi.prefix, bi.opts.To)
+	}
+	if upper == nil {
+		// empty prefix, starting from the last key of the db
+		bi.it.Rewind()
+		return
+	}
+	// reverse seek finds the largest key that is smaller or equal, while the upper bound is exclusive
+	bi.it.Seek(upper)
+	if bi.it.Valid() && bytes.Equal(bi.it.Item().Key(), upper) {
+		bi.it.Next()
+	}
+}
+
+// Valid returns whether the iterator points to an item within bounds
+func (bi *badgerIterator) Valid() bool {
+	if !bi.it.ValidForPrefix(bi.prefix) {
+		return false
+	}
+	return withinBounds(bi.it.Item().Key()[len(bi.prefix):], bi.opts)
+}
+
+// Next moves to the next item in the iteration order
+func (bi *badgerIterator) Next() {
+	bi.it.Next()
+}
+
+// Seek moves to the given key, or to the following key in the iteration order
+func (bi *badgerIterator) Seek(key []byte) {
+	if bi.opts.Reverse && bi.opts.To != nil && bytes.Compare(key, bi.opts.To) >= 0 {
+		bi.rewind()
+		return
+	}
+	if !bi.opts.Reverse && bi.opts.From != nil && bytes.Compare(key, bi.opts.From) < 0 {
+		bi.rewind()
+		return
+	}
+	bi.it.Seek(prefixedKey(bi.prefix, key))
+}
+
+// Item returns a copy of the current item
+func (bi *badgerIterator) Item() (basedb.Obj, error) {
+	item := bi.it.Item()
+	value, err := item.ValueCopy(nil)
+	if err != nil {
+		return basedb.Obj{}, err
+	}
+	return basedb.Obj{
+		Key:   item.KeyCopy(nil)[len(bi.prefix):],
+		Value: value,
+	}, nil
+}
+
+// Close closes the iterator, and discards the transaction if it was created for the iterator
+func (bi *badgerIterator) Close() {
+	bi.it.Close()
+	if bi.owned {
+		bi.txn.Discard()
+	}
 }
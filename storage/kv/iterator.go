@@ -0,0 +1,43 @@
+package kv
+
+import (
+	"bytes"
+
+	"github.com/bloxapp/ssv/storage/basedb"
+)
+
+// withinBounds returns whether the given key (without prefix) is within the bounds of the iteration
+func withinBounds(key []byte, opts basedb.IteratorOptions) bool {
+	if opts.From != nil && bytes.Compare(key, opts.From) < 0 {
+		return false
+	}
+	if opts.To != nil && bytes.Compare(key, opts.To) >= 0 {
+		return false
+	}
+	return true
+}
+
+// prefixEnd returns the smallest key that is greater than all the keys with the given prefix,
+// or nil if there is no such key (e.g. empty prefix)
+func prefixEnd(prefix []byte) []byte {
+	end := copyBytes(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// prefixedKey returns a new slice of the given prefix and key, the prefix is never modified
+func prefixedKey(prefix []byte, key []byte) []byte {
+	res := make([]byte, 0, len(prefix)+len(key))
+	return append(append(res, prefix...), key...)
+}
+
+func copyBytes(b []byte) []byte {
+	res := make([]byte, len(b))
+	copy(res, b)
+	return res
+}
@@ -0,0 +1,319 @@
+package kv
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/async"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldberrors "github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"go.uber.org/zap"
+)
+
+const (
+	// LevelDbType is the type of a persistent leveldb
+	LevelDbType = "leveldb"
+	// LevelDbMemoryType is the type of an in-memory leveldb
+	LevelDbMemoryType = "leveldb-memory"
+)
+
+// LevelDb is a pure-Go alternative to badger,
+// keys are stored sorted so collections (prefixes) are read with range scans
+type LevelDb struct {
+	db     *leveldb.DB
+	logger *zap.Logger
+}
+
+// NewLevelDb creates a new instance of leveldb
+func NewLevelDb(options basedb.Options) (basedb.IDb, error) {
+	var stor storage.Storage
+	var err error
+	if options.Type == LevelDbMemoryType {
+		stor = storage.NewMemStorage()
+	} else if stor, err = storage.OpenFile(options.Path, false); err != nil {
+		return nil, errors.Wrap(err, "failed to open leveldb storage")
+	}
+	db, err := leveldb.Open(stor, &opt.Options{})
+	if err != nil {
+		_ = stor.Close()
+		return nil, errors.Wrap(err, "failed to open leveldb")
+	}
+	_db := LevelDb{
+		db:     db,
+		logger: options.Logger,
+	}
+
+	if options.Reporting && options.Ctx != nil {
+		async.RunEvery(options.Ctx, 1*time.Minute, _db.report)
+	}
+
+	options.Logger.Info("Leveldb initialized")
+	return &_db, nil
+}
+
+// Set save value with key to storage
+func (l *LevelDb) Set(prefix []byte, key []byte, value []byte) error {
+	return l.db.Put(prefixedKey(prefix, key), value, nil)
+}
+
+// SetMany save many values with the given keys in a single batch
+func (l *LevelDb) SetMany(prefix []byte, n int, next func(int) (basedb.Obj, error)) error {
+	batch := new(leveldb.Batch)
+	for i := 0; i < n; i++ {
+		item, err := next(i)
+		if err != nil {
+			return err
+		}
+		batch.Put(prefixedKey(prefix, item.Key), item.Value)
+	}
+	return l.db.Write(batch, nil)
+}
+
+// Get return value for specified key
+func (l *LevelDb) Get(prefix []byte, key []byte) (basedb.Obj, bool, error) {
+	return levelDbGet(l.db, prefix, key)
+}
+
+// GetMany return values for the given keys, from a consistent snapshot of the db
+func (l *LevelDb) GetMany(prefix []byte, keys [][]byte, iterator func(basedb.Obj) error) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	snapshot, err := l.db.GetSnapshot()
+	if err != nil {
+		return errors.Wrap(err, "failed to get snapshot")
+	}
+	defer snapshot.Release()
+	for _, k := range keys {
+		obj, found, err := levelDbGet(snapshot, prefix, k)
+		if err != nil {
+			l.logger.Warn("failed to get item", zap.String("key", string(k)))
+			return err
+		}
+		if !found {
+			continue
+		}
+		if err := iterator(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete key in specific prefix
+func (l *LevelDb) Delete(prefix []byte, key []byte) error {
+	return l.db.Delete(prefixedKey(prefix, key), nil)
+}
+
+// GetAll returns all the items of a given collection, sorted by key
+func (l *LevelDb) GetAll(prefix []byte, handler func(int, basedb.Obj) error) error {
+	it := l.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer it.Release()
+	i := 0
+	for it.Next() {
+		// iterator's buffers are reused, therefore key and value are copied
+		if err := handler(i, basedb.Obj{
+			Key:   copyBytes(it.Key()[len(prefix):]),
+			Value: copyBytes(it.Value()),
+		}); err != nil {
+			return err
+		}
+		i++
+	}
+	return it.Error()
+}
+
+// CountByCollection return the object count for all keys under specified prefix(bucket)
+func (l *LevelDb) CountByCollection(prefix []byte) (int64, error) {
+	var res int64
+	it := l.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer it.Release()
+	for it.Next() {
+		res++
+	}
+	return res, it.Error()
+}
+
+// RemoveAllByCollection cleans all items in a collection
+func (l *LevelDb) RemoveAllByCollection(prefix []byte) error {
+	batch := new(leveldb.Batch)
+	it := l.db.NewIterator(util.BytesPrefix(prefix), nil)
+	for it.Next() {
+		batch.Delete(copyBytes(it.Key()))
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return l.db.Write(batch, nil)
+}
+
+// Update runs the given function in a read-write transaction,
+// changes are committed only if the function succeeded
+func (l *LevelDb) Update(fn func(basedb.Txn) error) error {
+	txn, err := l.db.OpenTransaction()
+	if err != nil {
+		return errors.Wrap(err, "failed to open transaction")
+	}
+	if err := fn(&levelDbTxn{txn: txn}); err != nil {
+		txn.Discard()
+		return err
+	}
+	return txn.Commit()
+}
+
+// Close close db
+func (l *LevelDb) Close() {
+	if err := l.db.Close(); err != nil {
+		l.logger.Fatal("failed to close db", zap.Error(err))
+	}
+}
+
+// report the db size and metrics
+func (l *LevelDb) report() {
+	logger := l.logger.With(zap.String("who", "LevelDBReporting"))
+	var stats leveldb.DBStats
+	if err := l.db.Stats(&stats); err != nil {
+		logger.Warn("failed to get stats", zap.Error(err))
+		return
+	}
+	logger.Debug("LevelDBReport", zap.Int64("size", stats.LevelSizes.Sum()),
+		zap.Int("openedTables", stats.OpenedTablesCount),
+		zap.Int("blockCache", stats.BlockCacheSize),
+		zap.Uint64("ioRead", stats.IORead), zap.Uint64("ioWrite", stats.IOWrite))
+}
+
+// levelDbReader is the common read interface of leveldb db, snapshot and transaction
+type levelDbReader interface {
+	Get(key []byte, ro *opt.ReadOptions) ([]byte, error)
+}
+
+func levelDbGet(r levelDbReader, prefix []byte, key []byte) (basedb.Obj, bool, error) {
+	value, err := r.Get(prefixedKey(prefix, key), nil)
+	if err != nil {
+		if err == leveldberrors.ErrNotFound {
+			return basedb.Obj{}, false, nil
+		}
+		return basedb.Obj{}, true, err
+	}
+	return basedb.Obj{
+		Key:   key,
+		Value: value,
+	}, true, nil
+}
+
+type levelDbTxn struct {
+	txn *leveldb.Transaction
+}
+
+func (t *levelDbTxn) Set(prefix []byte, key []byte, value []byte) error {
+	return t.txn.Put(prefixedKey(prefix, key), value, nil)
+}
+
+func (t *levelDbTxn) Get(prefix []byte, key []byte) (basedb.Obj, bool, error) {
+	return levelDbGet(t.txn, prefix, key)
+}
+
+func (t *levelDbTxn) Delete(prefix []byte, key []byte) error {
+	return t.txn.Delete(prefixedKey(prefix, key), nil)
+}
+
+func (t *levelDbTxn) NewIterator(prefix []byte, opts basedb.IteratorOptions) basedb.Iterator {
+	return newLevelDbIterator(t.txn, prefix, opts)
+}
+
+// NewIterator returns an iterator over the items of the given collection, from an implicit snapshot of the db
+func (l *LevelDb) NewIterator(prefix []byte, opts basedb.IteratorOptions) basedb.Iterator {
+	return newLevelDbIterator(l.db, prefix, opts)
+}
+
+// levelDbIterable is the common iteration interface of leveldb db, snapshot and transaction
+type levelDbIterable interface {
+	NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator
+}
+
+// levelDbIterator implements basedb.Iterator on top of leveldb's iterator, which is limited to the bounds
+type levelDbIterator struct {
+	it      iterator.Iterator
+	prefix  []byte
+	reverse bool
+}
+
+func newLevelDbIterator(r levelDbIterable, prefix []byte, opts basedb.IteratorOptions) *levelDbIterator {
+	rng := util.BytesPrefix(copyBytes(prefix))
+	if opts.From != nil {
+		rng.Start = prefixedKey(prefix, opts.From)
+	}
+	if opts.To != nil {
+		rng.Limit = prefixedKey(prefix, opts.To)
+	}
+	li := &levelDbIterator{
+		it:      r.NewIterator(rng, nil),
+		prefix:  copyBytes(prefix),
+		reverse: opts.Reverse,
+	}
+	li.rewind()
+	return li
+}
+
+// rewind moves to the first item in the iteration order
+func (li *levelDbIterator) rewind() {
+	if li.reverse {
+		li.it.Last()
+	} else {
+		li.it.First()
+	}
+}
+
+// Valid returns whether the iterator points to an item within bounds
+func (li *levelDbIterator) Valid() bool {
+	return li.it.Valid()
+}
+
+// Next moves to the next item in the iteration order
+func (li *levelDbIterator) Next() {
+	if li.reverse {
+		li.it.Prev()
+	} else {
+		li.it.Next()
+	}
+}
+
+// Seek moves to the given key, or to the following key in the iteration order
+func (li *levelDbIterator) Seek(key []byte) {
+	k := prefixedKey(li.prefix, key)
+	if !li.reverse {
+		li.it.Seek(k)
+		return
+	}
+	// seek finds the smallest key that is greater or equal, while reverse iteration needs the largest smaller or equal
+	if !li.it.Seek(k) {
+		li.it.Last()
+		return
+	}
+	if bytes.Compare(li.it.Key(), k) > 0 {
+		li.it.Prev()
+	}
+}
+
+// Item returns a copy of the current item
+func (li *levelDbIterator) Item() (basedb.Obj, error) {
+	if err := li.it.Error(); err != nil {
+		return basedb.Obj{}, err
+	}
+	return basedb.Obj{
+		Key:   copyBytes(li.it.Key()[len(li.prefix):]),
+		Value: copyBytes(li.it.Value()),
+	}, nil
+}
+
+// Close releases the iterator
+func (li *levelDbIterator) Close() {
+	li.it.Release()
+}
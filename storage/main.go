@@ -15,6 +15,8 @@ func GetStorageFactory(options basedb.Options) (basedb.IDb, error) {
 	case "badger-memory":
 		db, err := kv.New(options)
 		return db, err
+	case kv.LevelDbType, kv.LevelDbMemoryType:
+		return kv.NewLevelDb(options)
 	}
 	return nil, fmt.Errorf("unsupported storage type passed")
 }
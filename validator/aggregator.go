@@ -0,0 +1,60 @@
+package validator
+
+import (
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// errNotAggregator is returned when the selection proof doesn't elect the validator as an aggregator
+var errNotAggregator = errors.New("validator is not an aggregator")
+
+// selectionProofRound is the pre consensus round of aggregations, in which the selection proof (slot signature) is signed
+type selectionProofRound struct {
+}
+
+// SignPartial signs the duty's slot
+func (r *selectionProofRound) SignPartial(signer beacon.Signer, duty *beacon.Duty, pk []byte) ([]byte, []byte, error) {
+	return signer.SignSlot(duty.Slot, pk)
+}
+
+// getSelectionProof runs the pre consensus round of the given aggregator duty and returns the validator's selection proof
+func (v *Validator) getSelectionProof(logger *zap.Logger, duty *beacon.Duty) (spec.BLSSignature, error) {
+	proof, err := v.executePreConsensusRound(logger, duty)
+	if err != nil {
+		return spec.BLSSignature{}, errors.Wrap(err, "could not reconstruct selection proof")
+	}
+	logger.Debug("selection proof successfully reconstructed")
+
+	ret := spec.BLSSignature{}
+	copy(ret[:], proof.Serialize())
+	return ret, nil
+}
+
+// getAggregateAndProof runs the selection proof round, and in case the validator was elected as an aggregator,
+// fetches the aggregated attestation from the beacon node. errNotAggregator is returned otherwise.
+func (v *Validator) getAggregateAndProof(logger *zap.Logger, duty *beacon.Duty) (*spec.AggregateAndProof, error) {
+	selectionProof, err := v.getSelectionProof(logger, duty)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get selection proof")
+	}
+	isAggregator, err := beacon.IsAggregator(duty.CommitteeLength, selectionProof[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check if aggregator")
+	}
+	if !isAggregator {
+		return nil, errNotAggregator
+	}
+
+	// the beacon node was subscribed to the committee's subnet as an aggregator when the duty was fetched
+	aggregate, err := v.beacon.GetAggregateAttestation(duty.Slot, duty.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get aggregate attestation")
+	}
+	return &spec.AggregateAndProof{
+		AggregatorIndex: duty.ValidatorIndex,
+		Aggregate:       aggregate,
+		SelectionProof:  selectionProof,
+	}, nil
+}
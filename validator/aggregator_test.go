@@ -0,0 +1,124 @@
+package validator
+
+import (
+	"testing"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/ibft/proto"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func broadcastSelectionProofs(t *testing.T, v *Validator, duty *beacon.Duty) {
+	lambda := v.ibfts[beacon.RoleTypeAggregator].GetIdentifier()
+	for id, sig := range partialSigs(t, refSigRoot, 2, 3) {
+		require.NoError(t, v.network.BroadcastPreConsensusSignature(nil, &proto.SignedMessage{
+			Message:   &proto.Message{Lambda: lambda, SeqNumber: uint64(duty.Slot)},
+			Signature: sig,
+			SignerIds: []uint64{id},
+		}))
+	}
+}
+
+func TestGetAggregateAndProof(t *testing.T) {
+	identifier := _byteArray("6139636633363061613135666231643164333065653262353738646335383834383233633139363631383836616538623839323737356363623362643936623764373334353536396132616130623134653464303135633534613661306335345f4154544553544552")
+	duty := &beacon.Duty{
+		Type:            beacon.RoleTypeAggregator,
+		Slot:            320,
+		ValidatorIndex:  1,
+		CommitteeLength: 1,
+	}
+
+	t.Run("elected aggregator", func(t *testing.T) {
+		v := testingValidator(t, true, 4, identifier)
+		v.beacon.(*testBeacon).refAggregate = &spec.Attestation{
+			AggregationBits: bitfield.NewBitlist(1),
+			Data:            v.beacon.(*testBeacon).refAttestationData,
+		}
+		// wait for for listeners to spin up
+		time.Sleep(time.Millisecond * 100)
+		broadcastSelectionProofs(t, v, duty)
+
+		aggregateAndProof, err := v.getAggregateAndProof(v.logger, duty)
+		require.NoError(t, err)
+		require.EqualValues(t, duty.ValidatorIndex, aggregateAndProof.AggregatorIndex)
+
+		proof := aggregateAndProof.SelectionProof
+		sig := &bls.Sign{}
+		require.NoError(t, sig.Deserialize(proof[:]))
+		require.True(t, sig.VerifyByte(v.Share.PublicKey, refSigRoot))
+	})
+
+	t.Run("not an aggregator", func(t *testing.T) {
+		v := testingValidator(t, true, 4, identifier)
+		time.Sleep(time.Millisecond * 100)
+		broadcastSelectionProofs(t, v, duty)
+
+		proof, err := v.getSelectionProof(v.logger, duty)
+		require.NoError(t, err)
+		// find a committee length in which the proof doesn't elect an aggregator
+		notAggregatorDuty := *duty
+		for l := uint64(beacon.TargetAggregatorsPerCommittee); ; l += beacon.TargetAggregatorsPerCommittee {
+			isAggregator, err := beacon.IsAggregator(l, proof[:])
+			require.NoError(t, err)
+			if !isAggregator {
+				notAggregatorDuty.CommitteeLength = l
+				break
+			}
+		}
+
+		broadcastSelectionProofs(t, v, duty)
+		_, err = v.getAggregateAndProof(v.logger, &notAggregatorDuty)
+		require.Equal(t, errNotAggregator, err)
+	})
+}
+
+func TestAggregatorDutyExecution(t *testing.T) {
+	identifier := _byteArray("6139636633363061613135666231643164333065653262353738646335383834383233633139363631383836616538623839323737356363623362643936623764373334353536396132616130623134653464303135633534613661306335345f4154544553544552")
+	v := testingValidator(t, true, 3, identifier)
+	duty := &beacon.Duty{
+		Type:            beacon.RoleTypeAggregator,
+		Slot:            v.beacon.(*testBeacon).refAttestationData.Slot,
+		ValidatorIndex:  1,
+		CommitteeIndex:  v.beacon.(*testBeacon).refAttestationData.Index,
+		CommitteeLength: 1,
+	}
+
+	proof := spec.BLSSignature{}
+	copy(proof[:], refAttestationSplitSigs[0])
+	aggregateAndProof := &spec.AggregateAndProof{
+		AggregatorIndex: duty.ValidatorIndex,
+		Aggregate: &spec.Attestation{
+			AggregationBits: bitfield.NewBitlist(1),
+			Data:            v.beacon.(*testBeacon).refAttestationData,
+		},
+		SelectionProof: proof,
+	}
+	value, err := aggregateAndProof.MarshalSSZ()
+	require.NoError(t, err)
+
+	require.NoError(t, v.valueCheck.AggregationValidation(duty, refSplitSharesPubKeys[0], v.beacon.(*testBeacon).refAttestationData).Check(value))
+	wrongDuty := *duty
+	wrongDuty.ValidatorIndex = 2
+	require.EqualError(t, v.valueCheck.AggregationValidation(&wrongDuty, refSplitSharesPubKeys[0], v.beacon.(*testBeacon).refAttestationData).Check(value),
+		"aggregator index 1 is different than validator index 2")
+
+	sig, root, dutyData, err := v.signDuty(value, duty)
+	require.NoError(t, err)
+	require.EqualValues(t, refSigRoot, root)
+	require.NotNil(t, dutyData.GetAggregation())
+
+	sigs := partialSigs(t, root, 2, 3)
+	sigs[1] = sig
+	require.NoError(t, v.reconstructAndBroadcastSignature(v.logger, sigs, root, dutyData, duty))
+
+	submitted := v.beacon.(*testBeacon).LastSubmittedAggregation
+	require.NotNil(t, submitted)
+	submittedSig := submitted.Signature
+	reconstructed := &bls.Sign{}
+	require.NoError(t, reconstructed.Deserialize(submittedSig[:]))
+	require.True(t, reconstructed.VerifyByte(v.Share.PublicKey, root))
+}
@@ -13,13 +13,17 @@ import (
 	controller2 "github.com/bloxapp/ssv/ibft/controller"
 	"github.com/bloxapp/ssv/network"
 	"github.com/bloxapp/ssv/network/p2p"
+	"github.com/bloxapp/ssv/network/validation"
 	"github.com/bloxapp/ssv/operator/forks"
 	registrystorage "github.com/bloxapp/ssv/registry/storage"
 	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/collections"
+	"github.com/bloxapp/ssv/utils/format"
 	"github.com/bloxapp/ssv/utils/tasks"
 	validatorstorage "github.com/bloxapp/ssv/validator/storage"
 
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/herumi/bls-eth-go-binary/bls"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/async/event"
@@ -35,22 +39,27 @@ type ShareEventHandlerFunc func(share *validatorstorage.Share)
 
 // ControllerOptions for creating a validator controller
 type ControllerOptions struct {
-	Context                    context.Context
-	DB                         basedb.IDb
-	Logger                     *zap.Logger
-	SignatureCollectionTimeout time.Duration `yaml:"SignatureCollectionTimeout" env:"SIGNATURE_COLLECTION_TIMEOUT" env-default:"5s" env-description:"Timeout for signature collection after consensus"`
-	MetadataUpdateInterval     time.Duration `yaml:"MetadataUpdateInterval" env:"METADATA_UPDATE_INTERVAL" env-default:"12m" env-description:"Interval for updating metadata"`
-	HistorySyncRateLimit       time.Duration `yaml:"HistorySyncRateLimit" env:"HISTORY_SYNC_BACKOFF" env-default:"200ms" env-description:"Interval for updating metadata"`
-	ETHNetwork                 *core.Network
-	Network                    network.Network
-	Beacon                     beacon.Beacon
-	Shares                     []validatorstorage.ShareOptions `yaml:"Shares"`
-	ShareEncryptionKeyProvider eth1.ShareEncryptionKeyProvider
-	CleanRegistryData          bool
-	Fork                       forks.Fork
-	KeyManager                 beacon.KeyManager
-	OperatorPubKey             string
-	RegistryStorage            registrystorage.OperatorsCollection
+	Context                      context.Context
+	DB                           basedb.IDb
+	Logger                       *zap.Logger
+	SignatureCollectionTimeout   time.Duration `yaml:"SignatureCollectionTimeout" env:"SIGNATURE_COLLECTION_TIMEOUT" env-default:"5s" env-description:"Timeout for signature collection after consensus"`
+	PreConsensusSignatureTimeout time.Duration `yaml:"PreConsensusSignatureTimeout" env:"PRE_CONSENSUS_SIGNATURE_TIMEOUT" env-default:"4s" env-description:"Timeout for signature collection before consensus"`
+	MetadataUpdateInterval       time.Duration `yaml:"MetadataUpdateInterval" env:"METADATA_UPDATE_INTERVAL" env-default:"12m" env-description:"Interval for updating metadata"`
+	HistorySyncRateLimit         time.Duration `yaml:"HistorySyncRateLimit" env:"HISTORY_SYNC_BACKOFF" env-default:"200ms" env-description:"Interval for updating metadata"`
+	DecidedRetentionSequences    uint64        `yaml:"DecidedRetentionSequences" env:"DECIDED_RETENTION_SEQUENCES" env-description:"Number of the last decided sequences to keep per validator and role, all are kept if not set"`
+	DecidedRetentionEpochs       uint64        `yaml:"DecidedRetentionEpochs" env:"DECIDED_RETENTION_EPOCHS" env-description:"Number of the last epochs of decided messages to keep per validator and role, all are kept if not set"`
+	DecidedPruneInterval         time.Duration `yaml:"DecidedPruneInterval" env:"DECIDED_PRUNE_INTERVAL" env-default:"1h" env-description:"Interval for pruning decided messages by the retention policy"`
+	ETHNetwork                   *core.Network
+	Network                      network.Network
+	Beacon                       beacon.Beacon
+	Shares                       []validatorstorage.ShareOptions `yaml:"Shares"`
+	ShareEncryptionKeyProvider   eth1.ShareEncryptionKeyProvider
+	CleanRegistryData            bool
+	Fork                         forks.Fork
+	KeyManager                   beacon.KeyManager
+	OperatorPubKey               string
+	RegistryStorage              registrystorage.OperatorsCollection
+	Eth1Client                   eth1.Client
 }
 
 // Controller represent the validators controller,
@@ -61,14 +70,21 @@ type Controller interface {
 	GetValidatorsIndices() []spec.ValidatorIndex
 	GetValidator(pubKey string) (*Validator, bool)
 	UpdateValidatorMetaDataLoop()
+	PruneDecidedLoop()
 	StartNetworkMediators()
 	Eth1EventHandler(handlers ...ShareEventHandlerFunc) eth1.SyncEventHandler
 	GetAllValidatorShares() ([]*validatorstorage.Share, error)
+	StopValidator(pubKey string) error
+	RemoveValidator(pubKey string) error
+	ReplaceValidatorShare(share *validatorstorage.Share, shareSecret *bls.SecretKey) error
+	GetOwnerAccountStatus(ownerAddress string) (validatorstorage.AccountStatus, error)
+	IsOwnerLiquidated(ownerAddress string) bool
 }
 
 // controller implements Controller
 type controller struct {
 	context    context.Context
+	db         basedb.IDb
 	collection validatorstorage.ICollection
 	storage    registrystorage.OperatorsCollection
 	logger     *zap.Logger
@@ -86,6 +102,9 @@ type controller struct {
 	networkMediator controller2.Mediator
 	operatorsIDs    *sync.Map
 	network         network.Network
+	eth1Client      eth1.Client
+
+	decidedPruner *decidedPruner
 }
 
 // NewController creates a new validator controller instance
@@ -104,6 +123,7 @@ func NewController(options ControllerOptions) Controller {
 	}
 
 	ctrl := controller{
+		db:                         options.DB,
 		collection:                 collection,
 		storage:                    options.RegistryStorage,
 		context:                    options.Context,
@@ -113,19 +133,22 @@ func NewController(options ControllerOptions) Controller {
 		operatorPubKey:             options.OperatorPubKey,
 		keyManager:                 options.KeyManager,
 		network:                    options.Network,
+		eth1Client:                 options.Eth1Client,
 
 		validatorsMap: newValidatorsMap(options.Context, options.Logger, &Options{
-			Context:                    options.Context,
-			SignatureCollectionTimeout: options.SignatureCollectionTimeout,
-			Logger:                     options.Logger,
-			Network:                    options.Network,
-			ETHNetwork:                 options.ETHNetwork,
-			Beacon:                     options.Beacon,
-			DB:                         options.DB,
-			Fork:                       options.Fork,
-			Signer:                     options.KeyManager,
-			SyncRateLimit:              options.HistorySyncRateLimit,
-			notifyOperatorID:           notifyOperatorID,
+			Context:                      options.Context,
+			SignatureCollectionTimeout:   options.SignatureCollectionTimeout,
+			PreConsensusSignatureTimeout: options.PreConsensusSignatureTimeout,
+			Logger:                       options.Logger,
+			Network:                      options.Network,
+			ETHNetwork:                   options.ETHNetwork,
+			Beacon:                       options.Beacon,
+			DB:                           options.DB,
+			Fork:                         options.Fork,
+			Signer:                       options.KeyManager,
+			SlashingProtector:            options.KeyManager.SlashingProtector(),
+			SyncRateLimit:                options.HistorySyncRateLimit,
+			notifyOperatorID:             notifyOperatorID,
 		}),
 
 		metadataUpdateQueue:    tasks.NewExecutionQueue(10 * time.Millisecond),
@@ -135,10 +158,32 @@ func NewController(options ControllerOptions) Controller {
 		operatorsIDs:    operatorsIDs,
 	}
 
+	ctrl.decidedPruner = newDecidedPruner(decidedPrunerOptions{
+		Logger:    options.Logger,
+		DB:        options.DB,
+		Shares:    collection.GetAllValidatorShares,
+		Sequences: options.DecidedRetentionSequences,
+		Epochs:    options.DecidedRetentionEpochs,
+		// a full sync batch is kept, the range of a sync request is inclusive
+		MinSequences:  options.Network.MaxBatch() + 1,
+		Interval:      options.DecidedPruneInterval,
+		SlotsPerEpoch: options.ETHNetwork.SlotsPerEpoch(),
+		CurrentEpoch: func() uint64 {
+			return uint64(options.ETHNetwork.EstimatedCurrentEpoch())
+		},
+	})
+
 	if err := ctrl.initShares(options); err != nil {
 		ctrl.logger.Panic("could not initialize shares", zap.Error(err))
 	}
 
+	// validate incoming messages of validators topics
+	validation.UseMsgValidator(options.Network, validation.NewMsgValidator(validation.Options{
+		Logger:         options.Logger,
+		Shares:         collection.GetValidatorShare,
+		HighestDecided: highestDecidedProvider(options.DB, options.Logger),
+	}))
+
 	return &ctrl
 }
 
@@ -187,12 +232,110 @@ func (c *controller) Eth1EventHandler(handlers ...ShareEventHandlerFunc) eth1.Sy
 					h(share)
 				}
 			}
+		case abiparser.ValidatorUpdatedEvent:
+			pubKey := hex.EncodeToString(ev.PublicKey)
+			share, err := c.handleValidatorUpdatedEvent(ev, e.IsOperatorEvent)
+			if err != nil {
+				c.logger.Error("could not handle ValidatorUpdated event", zap.String("pubkey", pubKey), zap.Error(err))
+				return err
+			}
+			if e.IsOperatorEvent {
+				for _, h := range handlers {
+					h(share)
+				}
+			}
+		case abiparser.ValidatorDeletedEvent:
+			if err := c.handleValidatorDeletedEvent(ev); err != nil {
+				c.logger.Error("could not handle ValidatorDeleted event",
+					zap.String("pubkey", hex.EncodeToString(ev.PublicKey)), zap.Error(err))
+				return err
+			}
+		case abiparser.ValidatorActivatedEvent:
+			share, err := c.handleValidatorActivatedEvent(ev)
+			if err != nil {
+				c.logger.Error("could not handle ValidatorActivated event",
+					zap.String("pubkey", hex.EncodeToString(ev.PublicKey)), zap.Error(err))
+				return err
+			}
+			if share != nil {
+				for _, h := range handlers {
+					h(share)
+				}
+			}
+		case abiparser.ValidatorInactivatedEvent:
+			if err := c.handleValidatorInactivatedEvent(ev); err != nil {
+				c.logger.Error("could not handle ValidatorInactivated event",
+					zap.String("pubkey", hex.EncodeToString(ev.PublicKey)), zap.Error(err))
+				return err
+			}
 		case abiparser.OperatorAddedEvent:
 			err := c.handleOperatorAddedEvent(ev)
 			if err != nil {
 				c.logger.Error("could not handle OperatorAdded event", zap.Error(err))
 				return err
 			}
+		case abiparser.OperatorDeletedEvent:
+			if e.IsOperatorEvent {
+				c.logger.Warn("operator was deleted from the contract")
+			}
+			if err := c.updateOperatorInformation(ev.PublicKey, func(oi *registrystorage.OperatorInformation) {
+				oi.Deleted = true
+			}); err != nil {
+				c.logger.Error("could not handle OperatorDeleted event", zap.Error(err))
+				return err
+			}
+		case abiparser.OperatorActivatedEvent:
+			if err := c.updateOperatorInformation(ev.PublicKey, func(oi *registrystorage.OperatorInformation) {
+				oi.Inactive = false
+			}); err != nil {
+				c.logger.Error("could not handle OperatorActivated event", zap.Error(err))
+				return err
+			}
+		case abiparser.OperatorInactivatedEvent:
+			if e.IsOperatorEvent {
+				c.logger.Warn("operator was inactivated in the contract")
+			}
+			if err := c.updateOperatorInformation(ev.PublicKey, func(oi *registrystorage.OperatorInformation) {
+				oi.Inactive = true
+			}); err != nil {
+				c.logger.Error("could not handle OperatorInactivated event", zap.Error(err))
+				return err
+			}
+		case abiparser.OperatorFeeUpdatedEvent:
+			if err := c.updateOperatorInformation(ev.PublicKey, func(oi *registrystorage.OperatorInformation) {
+				oi.Fee = ev.Fee
+			}); err != nil {
+				c.logger.Error("could not handle OperatorFeeUpdated event", zap.Error(err))
+				return err
+			}
+		case abiparser.OperatorScoreUpdatedEvent:
+			if err := c.updateOperatorInformation(ev.PublicKey, func(oi *registrystorage.OperatorInformation) {
+				oi.Score = ev.Score
+			}); err != nil {
+				c.logger.Error("could not handle OperatorScoreUpdated event", zap.Error(err))
+				return err
+			}
+		case abiparser.AccountLiquidatedEvent:
+			if err := c.handleAccountLiquidatedEvent(ev); err != nil {
+				c.logger.Error("could not handle AccountLiquidated event",
+					zap.String("ownerAddress", ev.OwnerAddress.Hex()), zap.Error(err))
+				return err
+			}
+		case abiparser.AccountEnabledEvent:
+			shares, err := c.handleAccountEnabledEvent(ev)
+			if err != nil {
+				c.logger.Error("could not handle AccountEnabled event",
+					zap.String("ownerAddress", ev.OwnerAddress.Hex()), zap.Error(err))
+				return err
+			}
+			for _, share := range shares {
+				for _, h := range handlers {
+					h(share)
+				}
+			}
+		case abiparser.NetworkFeeUpdatedEvent:
+			c.logger.Debug("network fee was updated",
+				zap.String("oldFee", ev.OldFee.String()), zap.String("newFee", ev.NewFee.String()))
 		default:
 			c.logger.Warn("could not handle unknown event")
 		}
@@ -201,6 +344,11 @@ func (c *controller) Eth1EventHandler(handlers ...ShareEventHandlerFunc) eth1.Sy
 }
 
 func (c *controller) handleShare(share *validatorstorage.Share) {
+	if c.IsOwnerLiquidated(share.OwnerAddress) {
+		c.logger.Debug("validator won't start as its owner account is liquidated",
+			zap.String("pubKey", share.PublicKey.SerializeToHexStr()), zap.String("ownerAddress", share.OwnerAddress))
+		return
+	}
 	v := c.validatorsMap.GetOrCreateValidator(share)
 	_, err := c.startValidator(v)
 	if err != nil {
@@ -214,11 +362,17 @@ func (c *controller) StartValidators() {
 	if err != nil {
 		c.logger.Fatal("failed to get validators shares", zap.Error(err))
 	}
-	if len(shares) == 0 {
+	var activeShares []*validatorstorage.Share
+	for _, share := range shares {
+		if !share.Inactive && !c.IsOwnerLiquidated(share.OwnerAddress) {
+			activeShares = append(activeShares, share)
+		}
+	}
+	if len(activeShares) == 0 {
 		c.logger.Info("could not find validators")
 		return
 	}
-	c.setupValidators(shares)
+	c.setupValidators(activeShares)
 	// inject handler for finding relevant operators
 	p2p.UseLookupOperatorHandler(c.network, func(oid string) bool {
 		_, ok := c.operatorsIDs.Load(oid)
@@ -378,6 +532,328 @@ func (c *controller) handleOperatorAddedEvent(event abiparser.OperatorAddedEvent
 	return nil
 }
 
+// handleValidatorUpdatedEvent replaces the share of the given validator with the one in the event,
+// the running validator is stopped and the new share is returned so it could be started by the handlers
+func (c *controller) handleValidatorUpdatedEvent(
+	validatorUpdatedEvent abiparser.ValidatorUpdatedEvent,
+	isOperatorShare bool,
+) (*validatorstorage.Share, error) {
+	pubKey := hex.EncodeToString(validatorUpdatedEvent.PublicKey)
+	share, shareSecret, err := createShareWithOperatorKey(abiparser.ValidatorAddedEvent(validatorUpdatedEvent),
+		c.operatorPubKey, isOperatorShare)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create share")
+	}
+	if err := c.ReplaceValidatorShare(share, shareSecret); err != nil {
+		metricsValidatorStatus.WithLabelValues(pubKey).Set(float64(validatorStatusError))
+		return nil, err
+	}
+	c.logger.Debug("ValidatorUpdated event was handled successfully", zap.String("pubKey", pubKey),
+		zap.Bool("isOperatorShare", isOperatorShare))
+	return share, nil
+}
+
+// handleValidatorDeletedEvent stops the given validator and removes its share
+func (c *controller) handleValidatorDeletedEvent(validatorDeletedEvent abiparser.ValidatorDeletedEvent) error {
+	if err := c.RemoveValidator(hex.EncodeToString(validatorDeletedEvent.PublicKey)); err != nil {
+		return err
+	}
+	c.logger.Debug("ValidatorDeleted event was handled successfully",
+		zap.String("pubKey", hex.EncodeToString(validatorDeletedEvent.PublicKey)))
+	return nil
+}
+
+// handleValidatorActivatedEvent marks the share of the given validator as active,
+// returns the share if it belongs to the operator so it could be started by the handlers
+func (c *controller) handleValidatorActivatedEvent(validatorActivatedEvent abiparser.ValidatorActivatedEvent) (*validatorstorage.Share, error) {
+	share, err := c.setValidatorInactive(validatorActivatedEvent.PublicKey, false)
+	if err != nil || share == nil {
+		return nil, err
+	}
+	if !share.IsOperatorShare(c.operatorPubKey) {
+		return nil, nil
+	}
+	return share, nil
+}
+
+// handleValidatorInactivatedEvent marks the share of the given validator as inactive and stops the validator
+func (c *controller) handleValidatorInactivatedEvent(validatorInactivatedEvent abiparser.ValidatorInactivatedEvent) error {
+	share, err := c.setValidatorInactive(validatorInactivatedEvent.PublicKey, true)
+	if err != nil || share == nil {
+		return err
+	}
+	return c.StopValidator(hex.EncodeToString(validatorInactivatedEvent.PublicKey))
+}
+
+// setValidatorInactive updates the persisted share of the given validator, returns nil if the share was not found
+func (c *controller) setValidatorInactive(pk []byte, inactive bool) (*validatorstorage.Share, error) {
+	share, found, err := c.collection.GetValidatorShare(pk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator share")
+	}
+	if !found {
+		return nil, nil
+	}
+	share.Inactive = inactive
+	if err := c.collection.SaveValidatorShare(share); err != nil {
+		return nil, errors.Wrap(err, "could not save validator share")
+	}
+	return share, nil
+}
+
+// StopValidator stops the given validator (if running) and releases its resources,
+// the share is kept so the validator could be started later on
+func (c *controller) StopValidator(pubKey string) error {
+	v := c.validatorsMap.RemoveValidator(pubKey)
+	if v == nil {
+		return nil
+	}
+	metricsValidatorStatus.WithLabelValues(pubKey).Set(float64(validatorStatusInactive))
+	if err := v.Stop(); err != nil {
+		return errors.Wrap(err, "could not stop validator")
+	}
+	c.logger.Debug("validator was stopped", zap.String("pubKey", pubKey))
+	return nil
+}
+
+// RemoveValidator stops the given validator (if running), removes the share key from the key manager
+// and deletes the share from storage. slashing protection data is kept by the key manager
+func (c *controller) RemoveValidator(pubKey string) error {
+	if err := c.StopValidator(pubKey); err != nil {
+		return err
+	}
+	pk, err := hex.DecodeString(pubKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode validator public key")
+	}
+	share, found, err := c.collection.GetValidatorShare(pk)
+	if err != nil {
+		return errors.Wrap(err, "could not get validator share")
+	}
+	// decided messages are deleted first, so a removal that failed can be retried while the share exists
+	if err := c.cleanDecided(pk); err != nil {
+		return err
+	}
+	if !found {
+		metricsValidatorStatus.DeleteLabelValues(pubKey)
+		deleteDutyMetrics(pubKey, nil)
+		return nil
+	}
+	if err := c.removeShareKey(share); err != nil {
+		return err
+	}
+	if err := c.collection.DeleteValidatorShare(pk); err != nil {
+		return errors.Wrap(err, "could not delete validator share")
+	}
+	metricsValidatorStatus.DeleteLabelValues(pubKey)
+	deleteDutyMetrics(pubKey, share.Committee)
+	c.logger.Debug("validator was removed", zap.String("pubKey", pubKey))
+	return nil
+}
+
+// cleanDecided deletes the decided messages of the given validator, of all the duty roles
+func (c *controller) cleanDecided(pk []byte) error {
+	for _, role := range dutyRoles {
+		ibftStorage := collections.NewIbft(c.db, c.logger, role.String())
+		identifier := []byte(format.IdentifierFormat(pk, role.String()))
+		if err := ibftStorage.CleanDecided(identifier); err != nil {
+			return errors.Wrapf(err, "could not delete decided messages of role %s", role.String())
+		}
+	}
+	return nil
+}
+
+// ReplaceValidatorShare replaces the persisted share of a validator, e.g. when its operators were changed.
+// the running validator is stopped, the share key is replaced in the key manager (if needed) and the new share is saved.
+// the caller is responsible for starting the validator with the new share
+func (c *controller) ReplaceValidatorShare(share *validatorstorage.Share, shareSecret *bls.SecretKey) error {
+	pubKey := share.PublicKey.SerializeToHexStr()
+	if err := c.StopValidator(pubKey); err != nil {
+		return err
+	}
+	prev, found, err := c.collection.GetValidatorShare(share.PublicKey.Serialize())
+	if err != nil {
+		return errors.Wrap(err, "could not get validator share")
+	}
+	if found {
+		if err := c.removeShareKey(prev); err != nil {
+			return err
+		}
+	}
+	isOperatorShare := share.IsOperatorShare(c.operatorPubKey)
+	if err := c.onNewShare(share, shareSecret, isOperatorShare); err != nil {
+		return err
+	}
+	if isOperatorShare {
+		metricsValidatorStatus.WithLabelValues(pubKey).Set(float64(validatorStatusInactive))
+	} else {
+		metricsValidatorStatus.DeleteLabelValues(pubKey)
+	}
+	return nil
+}
+
+// removeShareKey removes the share key of the operator from the key manager, if the share belongs to the operator
+func (c *controller) removeShareKey(share *validatorstorage.Share) error {
+	if !share.IsOperatorShare(c.operatorPubKey) {
+		return nil
+	}
+	sharePubKey, err := share.OperatorPubKey()
+	if err != nil {
+		// shares without the operator's node don't have a key in the key manager
+		return nil
+	}
+	if err := c.keyManager.RemoveShare(sharePubKey.SerializeToHexStr()); err != nil {
+		return errors.Wrap(err, "could not remove share from key manager")
+	}
+	return nil
+}
+
+// handleAccountLiquidatedEvent marks the owner account as liquidated and stops the validators of the owner
+func (c *controller) handleAccountLiquidatedEvent(event abiparser.AccountLiquidatedEvent) error {
+	ownerAddress := event.OwnerAddress.Hex()
+	if err := c.collection.SaveOwnerAccount(&validatorstorage.OwnerAccount{
+		OwnerAddress: ownerAddress,
+		Status:       validatorstorage.AccountLiquidated,
+	}); err != nil {
+		return errors.Wrap(err, "could not save owner account")
+	}
+	shares, err := c.getOwnerShares(ownerAddress)
+	if err != nil {
+		return err
+	}
+	for _, share := range shares {
+		if err := c.StopValidator(share.PublicKey.SerializeToHexStr()); err != nil {
+			return err
+		}
+	}
+	c.logger.Debug("AccountLiquidated event was handled successfully", zap.String("ownerAddress", ownerAddress),
+		zap.Int("validators", len(shares)))
+	return nil
+}
+
+// handleAccountEnabledEvent marks the owner account as active,
+// returns the active shares of the owner so they could be started by the handlers
+func (c *controller) handleAccountEnabledEvent(event abiparser.AccountEnabledEvent) ([]*validatorstorage.Share, error) {
+	ownerAddress := event.OwnerAddress.Hex()
+	if err := c.collection.SaveOwnerAccount(&validatorstorage.OwnerAccount{
+		OwnerAddress: ownerAddress,
+		Status:       validatorstorage.AccountActive,
+	}); err != nil {
+		return nil, errors.Wrap(err, "could not save owner account")
+	}
+	shares, err := c.getOwnerShares(ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+	var activeShares []*validatorstorage.Share
+	for _, share := range shares {
+		if !share.Inactive {
+			activeShares = append(activeShares, share)
+		}
+	}
+	c.logger.Debug("AccountEnabled event was handled successfully", zap.String("ownerAddress", ownerAddress),
+		zap.Int("validators", len(activeShares)))
+	return activeShares, nil
+}
+
+// GetOwnerAccountStatus returns the status of the given owner account
+func (c *controller) GetOwnerAccountStatus(ownerAddress string) (validatorstorage.AccountStatus, error) {
+	return c.collection.GetOwnerAccountStatus(ownerAddress)
+}
+
+// IsOwnerLiquidated returns true if the given owner account was liquidated
+func (c *controller) IsOwnerLiquidated(ownerAddress string) bool {
+	if len(ownerAddress) == 0 {
+		return false
+	}
+	status, err := c.collection.GetOwnerAccountStatus(ownerAddress)
+	if err != nil {
+		c.logger.Warn("could not get owner account status", zap.String("ownerAddress", ownerAddress), zap.Error(err))
+		return false
+	}
+	return status == validatorstorage.AccountLiquidated
+}
+
+// getOwnerShares returns the shares of the operator that belongs to the given owner
+func (c *controller) getOwnerShares(ownerAddress string) ([]*validatorstorage.Share, error) {
+	shares, err := c.collection.GetOperatorValidatorShares(c.operatorPubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validators shares")
+	}
+	owner := common.HexToAddress(ownerAddress)
+	var res []*validatorstorage.Share
+	for _, share := range shares {
+		if common.HexToAddress(share.OwnerAddress) == owner {
+			res = append(res, share)
+		}
+	}
+	return res, nil
+}
+
+// checkLiquidatableAccounts calls the contract to check if the owners of the given shares can be liquidated,
+// the status of the accounts is updated accordingly. liquidated accounts are updated only by contract events
+func (c *controller) checkLiquidatableAccounts(shares []*validatorstorage.Share) {
+	if c.eth1Client == nil {
+		return
+	}
+	checked := make(map[common.Address]bool)
+	for _, share := range shares {
+		if len(share.OwnerAddress) == 0 {
+			continue
+		}
+		owner := common.HexToAddress(share.OwnerAddress)
+		if checked[owner] {
+			continue
+		}
+		checked[owner] = true
+		logger := c.logger.With(zap.String("ownerAddress", owner.Hex()))
+		status, err := c.collection.GetOwnerAccountStatus(owner.Hex())
+		if err != nil {
+			logger.Warn("could not get owner account status", zap.Error(err))
+			continue
+		}
+		if status == validatorstorage.AccountLiquidated {
+			continue
+		}
+		liquidatable, err := c.eth1Client.IsLiquidatable(owner)
+		if err != nil {
+			logger.Warn("could not check if owner account is liquidatable", zap.Error(err))
+			continue
+		}
+		newStatus := validatorstorage.AccountActive
+		if liquidatable {
+			newStatus = validatorstorage.AccountLiquidatable
+			logger.Warn("owner account is liquidatable, validators will stop once it is liquidated")
+		}
+		if newStatus == status {
+			continue
+		}
+		if err := c.collection.SaveOwnerAccount(&validatorstorage.OwnerAccount{
+			OwnerAddress: owner.Hex(),
+			Status:       newStatus,
+		}); err != nil {
+			logger.Warn("could not save owner account", zap.Error(err))
+		}
+	}
+}
+
+// updateOperatorInformation applies the given update on the persisted information of the operator
+func (c *controller) updateOperatorInformation(pk []byte, update func(oi *registrystorage.OperatorInformation)) error {
+	oi, found, err := c.storage.GetOperatorInformation(string(pk))
+	if err != nil {
+		return errors.Wrap(err, "could not get operator information")
+	}
+	if !found {
+		c.logger.Debug("could not find operator information", zap.String("operatorPubKey", string(pk)))
+		return nil
+	}
+	update(oi)
+	if err := c.storage.UpdateOperatorInformation(oi); err != nil {
+		return errors.Wrap(err, "could not update operator information")
+	}
+	return nil
+}
+
 // onMetadataUpdated is called when validator's metadata was updated
 func (c *controller) onMetadataUpdated(pk string, meta *beacon.ValidatorMetadata) {
 	if meta == nil {
@@ -465,5 +941,28 @@ func (c *controller) UpdateValidatorMetaDataLoop() {
 		c.logger.Debug("updating metadata in loop", zap.Int("shares count", len(shares)))
 		beacon.UpdateValidatorsMetadataBatch(pks, c.metadataUpdateQueue, c,
 			c.beacon, c.onMetadataUpdated, metadataBatchSize)
+		c.checkLiquidatableAccounts(shares)
+	}
+}
+
+// PruneDecidedLoop prunes the decided history of the validators by the retention policy, in intervals
+func (c *controller) PruneDecidedLoop() {
+	c.decidedPruner.Start(c.context)
+}
+
+// highestDecidedProvider returns a provider of the highest decided sequence number,
+// the ibft storage is resolved by the role of the given lambda
+func highestDecidedProvider(db basedb.IDb, logger *zap.Logger) validation.HighestDecidedProvider {
+	return func(lambda []byte) (uint64, bool, error) {
+		_, role := format.IdentifierUnformat(string(lambda))
+		if len(role) == 0 {
+			return 0, false, nil
+		}
+		ibftStorage := collections.NewIbft(db, logger, role)
+		highest, found, err := ibftStorage.GetHighestDecidedInstance(lambda)
+		if err != nil || !found {
+			return 0, found, err
+		}
+		return highest.Message.SeqNumber, true, nil
 	}
 }
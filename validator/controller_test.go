@@ -5,11 +5,22 @@ import (
 	"github.com/bloxapp/ssv/utils/logex"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"math/big"
 	"sync"
 	"testing"
 
 	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/eth1"
+	"github.com/bloxapp/ssv/eth1/abiparser"
+	"github.com/bloxapp/ssv/ibft/proto"
+	registrystorage "github.com/bloxapp/ssv/registry/storage"
+	"github.com/bloxapp/ssv/storage"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/collections"
+	"github.com/bloxapp/ssv/utils/format"
 	validatorstorage "github.com/bloxapp/ssv/validator/storage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/herumi/bls-eth-go-binary/bls"
 )
 
 func setupController(logger *zap.Logger, validators map[string]*Validator) controller {
@@ -161,3 +172,248 @@ func TestGetIndices(t *testing.T) {
 	logger.Info("result", zap.Any("indices", indices))
 	require.Equal(t, 1, len(indices)) // should return only active indices
 }
+
+func TestController_Eth1LifecycleEvents(t *testing.T) {
+	logger := logex.Build("test", zap.InfoLevel, nil)
+	db, err := storage.GetStorageFactory(basedb.Options{
+		Type:   "badger-memory",
+		Logger: logger,
+	})
+	require.NoError(t, err)
+	defer db.Close()
+
+	sk := &bls.SecretKey{}
+	sk.SetByCSPRNG()
+	share := &validatorstorage.Share{
+		NodeID:    1,
+		PublicKey: sk.GetPublicKey(),
+		Committee: map[uint64]*proto.Node{},
+		Operators: [][]byte{[]byte("operator-pk")},
+	}
+	pk := share.PublicKey.Serialize()
+	pubKey := share.PublicKey.SerializeToHexStr()
+
+	v := testingValidator(t, true, 4, []byte(format.IdentifierFormat(refPk, beacon.RoleTypeAttester.String())))
+	v.Share = share
+	ctr := setupController(logger, map[string]*Validator{pubKey: v})
+	ctr.operatorPubKey = "operator-pk"
+	ctr.db = db
+	ctr.collection = validatorstorage.NewCollection(validatorstorage.CollectionOptions{DB: db, Logger: logger})
+	ctr.storage = registrystorage.NewOperatorsStorage(db, logger, []byte("test"))
+	require.NoError(t, ctr.collection.SaveValidatorShare(share))
+	require.NoError(t, ctr.storage.SaveOperatorInformation(&registrystorage.OperatorInformation{PublicKey: "operator-pk"}))
+
+	var started []*validatorstorage.Share
+	handler := ctr.Eth1EventHandler(func(share *validatorstorage.Share) {
+		started = append(started, share)
+	})
+
+	t.Run("validator inactivated", func(t *testing.T) {
+		require.NoError(t, handler(eth1.Event{Data: abiparser.ValidatorInactivatedEvent{PublicKey: pk}}))
+		_, found := ctr.GetValidator(pubKey)
+		require.False(t, found)
+		saved, found, err := ctr.collection.GetValidatorShare(pk)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.True(t, saved.Inactive)
+	})
+
+	t.Run("validator activated", func(t *testing.T) {
+		require.NoError(t, handler(eth1.Event{Data: abiparser.ValidatorActivatedEvent{PublicKey: pk}}))
+		require.Len(t, started, 1)
+		require.Equal(t, pubKey, started[0].PublicKey.SerializeToHexStr())
+		require.False(t, started[0].Inactive)
+	})
+
+	t.Run("validator deleted", func(t *testing.T) {
+		require.NoError(t, handler(eth1.Event{Data: abiparser.ValidatorDeletedEvent{PublicKey: pk}}))
+		_, found, err := ctr.collection.GetValidatorShare(pk)
+		require.NoError(t, err)
+		require.False(t, found)
+		// events of unknown validators are ignored
+		require.NoError(t, handler(eth1.Event{Data: abiparser.ValidatorActivatedEvent{PublicKey: pk}}))
+		require.Len(t, started, 1)
+	})
+
+	t.Run("operator updates", func(t *testing.T) {
+		require.NoError(t, handler(eth1.Event{Data: abiparser.OperatorFeeUpdatedEvent{
+			PublicKey: []byte("operator-pk"), Fee: big.NewInt(5),
+		}}))
+		require.NoError(t, handler(eth1.Event{Data: abiparser.OperatorInactivatedEvent{
+			PublicKey: []byte("operator-pk"),
+		}, IsOperatorEvent: true}))
+		oi, found, err := ctr.storage.GetOperatorInformation("operator-pk")
+		require.NoError(t, err)
+		require.True(t, found)
+		require.EqualValues(t, 5, oi.Fee.Int64())
+		require.True(t, oi.Inactive)
+	})
+}
+
+func TestController_ValidatorLifecycle(t *testing.T) {
+	logger := logex.Build("test", zap.InfoLevel, nil)
+	db, err := storage.GetStorageFactory(basedb.Options{
+		Type:   "badger-memory",
+		Logger: logger,
+	})
+	require.NoError(t, err)
+	defer db.Close()
+
+	v := testingValidator(t, true, 4, []byte(format.IdentifierFormat(refPk, beacon.RoleTypeAttester.String())))
+	v.Share.Operators = [][]byte{[]byte("operator-pk")}
+	pk := v.Share.PublicKey.Serialize()
+	pubKey := v.Share.PublicKey.SerializeToHexStr()
+	km := v.beacon.(*testBeacon)
+
+	ctr := setupController(logger, map[string]*Validator{pubKey: v})
+	ctr.operatorPubKey = "operator-pk"
+	ctr.keyManager = km
+	ctr.beacon = km
+	ctr.db = db
+	ctr.collection = validatorstorage.NewCollection(validatorstorage.CollectionOptions{DB: db, Logger: logger})
+	require.NoError(t, ctr.collection.SaveValidatorShare(v.Share))
+
+	t.Run("stop validator", func(t *testing.T) {
+		require.NoError(t, ctr.StopValidator(pubKey))
+		_, found := ctr.GetValidator(pubKey)
+		require.False(t, found)
+		require.Error(t, v.ctx.Err())
+		require.EqualError(t, v.Start(), "validator was stopped")
+		_, found, err := ctr.collection.GetValidatorShare(pk)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Len(t, km.RemovedShares, 0)
+		// stopping an unknown validator is a no-op
+		require.NoError(t, ctr.StopValidator(pubKey))
+	})
+
+	t.Run("replace share", func(t *testing.T) {
+		share := &validatorstorage.Share{
+			NodeID:    2,
+			PublicKey: v.Share.PublicKey,
+			Committee: map[uint64]*proto.Node{
+				2: {IbftId: 2, Pk: refSplitSharesPubKeys[1]},
+			},
+			Operators: [][]byte{[]byte("operator-pk"), []byte("other-operator-pk")},
+		}
+		require.NoError(t, ctr.ReplaceValidatorShare(share, nil))
+		// the key of the previous share was removed
+		require.Len(t, km.RemovedShares, 1)
+		require.Equal(t, v.Share.Committee[1].Pk, _byteArray(km.RemovedShares[0]))
+		saved, found, err := ctr.collection.GetValidatorShare(pk)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.EqualValues(t, 2, saved.NodeID)
+		require.Len(t, saved.Operators, 2)
+	})
+
+	t.Run("remove validator", func(t *testing.T) {
+		identifiers := map[beacon.RoleType][]byte{}
+		for _, role := range dutyRoles {
+			identifier := []byte(format.IdentifierFormat(pk, role.String()))
+			identifiers[role] = identifier
+			ibftStorage := collections.NewIbft(db, logger, role.String())
+			for seq := uint64(0); seq < 3; seq++ {
+				msg := &proto.SignedMessage{Message: &proto.Message{Lambda: identifier, SeqNumber: seq}}
+				require.NoError(t, ibftStorage.SaveDecided(msg))
+				require.NoError(t, ibftStorage.SaveHighestDecidedInstance(msg))
+			}
+		}
+		// decided messages of other validators are kept
+		otherIdentifier := []byte(format.IdentifierFormat([]byte{1, 2, 3}, beacon.RoleTypeAttester.String()))
+		attesterStorage := collections.NewIbft(db, logger, beacon.RoleTypeAttester.String())
+		require.NoError(t, attesterStorage.SaveDecided(&proto.SignedMessage{Message: &proto.Message{Lambda: otherIdentifier}}))
+
+		require.NoError(t, ctr.RemoveValidator(pubKey))
+		_, found, err := ctr.collection.GetValidatorShare(pk)
+		require.NoError(t, err)
+		require.False(t, found)
+		for role, identifier := range identifiers {
+			ibftStorage := collections.NewIbft(db, logger, role.String())
+			msgs, err := ibftStorage.GetDecidedInRange(identifier, 0, 10)
+			require.NoError(t, err)
+			require.Len(t, msgs, 0)
+			_, found, err := ibftStorage.GetHighestDecidedInstance(identifier)
+			require.NoError(t, err)
+			require.False(t, found)
+		}
+		_, found, err = attesterStorage.GetDecided(otherIdentifier, 0)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Len(t, km.RemovedShares, 2)
+		require.Equal(t, refSplitSharesPubKeys[1], _byteArray(km.RemovedShares[1]))
+		// removing an unknown validator is a no-op
+		require.NoError(t, ctr.RemoveValidator(pubKey))
+		require.Len(t, km.RemovedShares, 2)
+	})
+}
+
+func TestController_AccountLiquidation(t *testing.T) {
+	logger := logex.Build("test", zap.InfoLevel, nil)
+	db, err := storage.GetStorageFactory(basedb.Options{
+		Type:   "badger-memory",
+		Logger: logger,
+	})
+	require.NoError(t, err)
+	defer db.Close()
+
+	owner := common.HexToAddress("0xa5cfD290965372553Efd5fDaeB91C335207b76E2")
+	v := testingValidator(t, true, 4, []byte(format.IdentifierFormat(refPk, beacon.RoleTypeAttester.String())))
+	v.Share.Operators = [][]byte{[]byte("operator-pk")}
+	v.Share.OwnerAddress = owner.Hex()
+	pubKey := v.Share.PublicKey.SerializeToHexStr()
+
+	eth1Client := &eth1.ClientMock{Liquidatable: map[common.Address]bool{}}
+	ctr := setupController(logger, map[string]*Validator{pubKey: v})
+	ctr.operatorPubKey = "operator-pk"
+	ctr.eth1Client = eth1Client
+	ctr.collection = validatorstorage.NewCollection(validatorstorage.CollectionOptions{DB: db, Logger: logger})
+	require.NoError(t, ctr.collection.SaveValidatorShare(v.Share))
+
+	var started []*validatorstorage.Share
+	handler := ctr.Eth1EventHandler(func(share *validatorstorage.Share) {
+		started = append(started, share)
+	})
+	requireStatus := func(expected validatorstorage.AccountStatus) {
+		status, err := ctr.GetOwnerAccountStatus(owner.Hex())
+		require.NoError(t, err)
+		require.Equal(t, expected, status)
+	}
+
+	t.Run("liquidatable account", func(t *testing.T) {
+		eth1Client.Liquidatable[owner] = true
+		ctr.checkLiquidatableAccounts([]*validatorstorage.Share{v.Share})
+		requireStatus(validatorstorage.AccountLiquidatable)
+		// validators of a liquidatable account are still running
+		_, found := ctr.GetValidator(pubKey)
+		require.True(t, found)
+
+		eth1Client.Liquidatable[owner] = false
+		ctr.checkLiquidatableAccounts([]*validatorstorage.Share{v.Share})
+		requireStatus(validatorstorage.AccountActive)
+	})
+
+	t.Run("account liquidated", func(t *testing.T) {
+		require.NoError(t, handler(eth1.Event{Data: abiparser.AccountLiquidatedEvent{OwnerAddress: owner}}))
+		requireStatus(validatorstorage.AccountLiquidated)
+		_, found := ctr.GetValidator(pubKey)
+		require.False(t, found)
+		require.Error(t, v.ctx.Err())
+
+		// validators of a liquidated account are not started
+		ctr.handleShare(v.Share)
+		_, found = ctr.GetValidator(pubKey)
+		require.False(t, found)
+		// the status of liquidated accounts is changed only by events
+		eth1Client.Liquidatable[owner] = false
+		ctr.checkLiquidatableAccounts([]*validatorstorage.Share{v.Share})
+		requireStatus(validatorstorage.AccountLiquidated)
+	})
+
+	t.Run("account enabled", func(t *testing.T) {
+		require.NoError(t, handler(eth1.Event{Data: abiparser.AccountEnabledEvent{OwnerAddress: owner}}))
+		requireStatus(validatorstorage.AccountActive)
+		require.Len(t, started, 1)
+		require.Equal(t, pubKey, started[0].PublicKey.SerializeToHexStr())
+	})
+}
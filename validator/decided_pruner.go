@@ -0,0 +1,216 @@
+package validator
+
+import (
+	"context"
+	"time"
+
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/collections"
+	"github.com/bloxapp/ssv/utils/format"
+	validatorstorage "github.com/bloxapp/ssv/validator/storage"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultDecidedPruneInterval is the interval of pruning when not configured
+	defaultDecidedPruneInterval = time.Hour
+	// decidedPruneScanPage is the number of sequences that are read at once when looking for the epochs boundary
+	decidedPruneScanPage = 100
+)
+
+// decidedPrunerOptions defines the parameters of the pruner
+type decidedPrunerOptions struct {
+	Logger *zap.Logger
+	DB     basedb.IDb
+	Shares func() ([]*validatorstorage.Share, error)
+	// Sequences is the number of the last decided sequences to keep, disabled if zero
+	Sequences uint64
+	// Epochs is the number of the last epochs of decided messages to keep, disabled if zero
+	Epochs uint64
+	// MinSequences is the least number of sequences to keep, so peers that are behind can sync from the node
+	MinSequences  uint64
+	Interval      time.Duration
+	SlotsPerEpoch uint64
+	CurrentEpoch  func() uint64
+}
+
+// decidedPruner prunes the decided history of the node's validators, per identifier (validator and role).
+// a decided message is kept if any of the retention limits retains it,
+// the highest decided message and the last sync batch are always kept
+type decidedPruner struct {
+	logger        *zap.Logger
+	db            basedb.IDb
+	shares        func() ([]*validatorstorage.Share, error)
+	sequences     uint64
+	epochs        uint64
+	minSequences  uint64
+	interval      time.Duration
+	slotsPerEpoch uint64
+	currentEpoch  func() uint64
+}
+
+func newDecidedPruner(opts decidedPrunerOptions) *decidedPruner {
+	p := decidedPruner{
+		logger:        opts.Logger.With(zap.String("component", "decidedPruner")),
+		db:            opts.DB,
+		shares:        opts.Shares,
+		sequences:     opts.Sequences,
+		epochs:        opts.Epochs,
+		minSequences:  opts.MinSequences,
+		interval:      opts.Interval,
+		slotsPerEpoch: opts.SlotsPerEpoch,
+		currentEpoch:  opts.CurrentEpoch,
+	}
+	if p.interval == 0 {
+		p.interval = defaultDecidedPruneInterval
+	}
+	if p.minSequences == 0 {
+		p.minSequences = 1
+	}
+	if p.sequences > 0 && p.sequences < p.minSequences {
+		p.logger.Warn("decided retention is lower than a sync batch, using the min number of sequences",
+			zap.Uint64("sequences", p.sequences), zap.Uint64("min", p.minSequences))
+		p.sequences = p.minSequences
+	}
+	return &p
+}
+
+func (p *decidedPruner) enabled() bool {
+	return p.sequences > 0 || p.epochs > 0
+}
+
+// Start prunes decided messages in intervals, until the context is done
+func (p *decidedPruner) Start(ctx context.Context) {
+	if !p.enabled() {
+		p.logger.Debug("decided retention is not configured, pruning is disabled")
+		return
+	}
+	p.logger.Info("starting to prune decided messages", zap.Uint64("sequences", p.sequences),
+		zap.Uint64("epochs", p.epochs), zap.Duration("interval", p.interval))
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := p.Prune(); err != nil {
+			p.logger.Warn("could not prune decided messages", zap.Error(err))
+		}
+	}
+}
+
+// Prune prunes the decided messages of all the validators
+func (p *decidedPruner) Prune() error {
+	shares, err := p.shares()
+	if err != nil {
+		return errors.Wrap(err, "could not get shares")
+	}
+	start := time.Now()
+	var count int
+	var size int64
+	for _, share := range shares {
+		pk := share.PublicKey.Serialize()
+		for _, role := range dutyRoles {
+			identifier := []byte(format.IdentifierFormat(pk, role.String()))
+			n, s, err := p.pruneIdentifier(role, identifier)
+			if err != nil {
+				p.logger.Warn("could not prune decided messages", zap.String("identifier", string(identifier)),
+					zap.Error(err))
+				continue
+			}
+			count += n
+			size += s
+		}
+	}
+	p.logger.Info("pruned decided messages", zap.Int("validators", len(shares)), zap.Int("count", count),
+		zap.Int64("bytes", size), zap.Duration("took", time.Since(start)))
+	return nil
+}
+
+func (p *decidedPruner) pruneIdentifier(role beacon.RoleType, identifier []byte) (int, int64, error) {
+	storage := collections.NewIbft(p.db, p.logger, role.String())
+	highest, found, err := storage.GetHighestDecidedInstance(identifier)
+	if err != nil || !found {
+		return 0, 0, err
+	}
+	prunedBelow, err := storage.GetPrunedBelow(identifier)
+	if err != nil {
+		return 0, 0, err
+	}
+	below, err := p.retainedFrom(role, &storage, identifier, prunedBelow, highest.Message.SeqNumber)
+	if err != nil {
+		return 0, 0, err
+	}
+	if below <= prunedBelow {
+		return 0, 0, nil
+	}
+	n, size, err := storage.PruneDecided(identifier, below)
+	metricsPrunedDecided.WithLabelValues(role.String()).Add(float64(n))
+	metricsPrunedDecidedBytes.WithLabelValues(role.String()).Add(float64(size))
+	return n, size, err
+}
+
+// retainedFrom returns the lowest sequence number to keep
+func (p *decidedPruner) retainedFrom(role beacon.RoleType, storage collections.Iibft, identifier []byte, prunedBelow, highest uint64) (uint64, error) {
+	// the last sync batch (including the highest decided) is always kept
+	maxFrom := seqsFrom(highest, p.minSequences)
+	var from uint64
+	found := false
+	if p.sequences > 0 {
+		from, found = seqsFrom(highest, p.sequences), true
+	}
+	if p.epochs > 0 {
+		epochsFrom, err := p.epochsFrom(role, storage, identifier, prunedBelow, highest)
+		if err != nil {
+			return 0, err
+		}
+		if !found || epochsFrom < from {
+			from = epochsFrom
+		}
+	}
+	if from > maxFrom {
+		return maxFrom, nil
+	}
+	return from, nil
+}
+
+// epochsFrom returns the sequence number of the first decided message within the retained epochs,
+// the messages are scanned in order of sequence as their slots are increasing
+func (p *decidedPruner) epochsFrom(role beacon.RoleType, storage collections.Iibft, identifier []byte, prunedBelow, highest uint64) (uint64, error) {
+	current := p.currentEpoch()
+	if current < p.epochs {
+		return 0, nil
+	}
+	retainedEpoch := current - p.epochs + 1
+	for from := prunedBelow; from <= highest; from += decidedPruneScanPage {
+		msgs, err := storage.GetDecidedInRange(identifier, from, from+decidedPruneScanPage-1)
+		if err != nil {
+			return 0, errors.Wrap(err, "could not read decided messages")
+		}
+		for _, msg := range msgs {
+			slot, err := beacon.DecidedValueSlot(role, msg.Message.Value)
+			if err != nil {
+				// messages that can't be decoded are kept, together with the following ones
+				p.logger.Debug("could not get slot of decided message", zap.String("identifier", string(identifier)),
+					zap.Uint64("seq", msg.Message.SeqNumber), zap.Error(err))
+				return msg.Message.SeqNumber, nil
+			}
+			if uint64(slot)/p.slotsPerEpoch >= retainedEpoch {
+				return msg.Message.SeqNumber, nil
+			}
+		}
+	}
+	return highest, nil
+}
+
+// seqsFrom returns the first sequence of the last n sequences
+func seqsFrom(highest uint64, n uint64) uint64 {
+	if highest+1 <= n {
+		return 0
+	}
+	return highest + 1 - n
+}
@@ -0,0 +1,93 @@
+package validator
+
+import (
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/ibft/proto"
+	ssvstorage "github.com/bloxapp/ssv/storage"
+	"github.com/bloxapp/ssv/storage/basedb"
+	"github.com/bloxapp/ssv/storage/collections"
+	"github.com/bloxapp/ssv/utils/format"
+	validatorstorage "github.com/bloxapp/ssv/validator/storage"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDecidedPruner_Prune(t *testing.T) {
+	require.NoError(t, bls.Init(bls.BLS12_381))
+	sk := &bls.SecretKey{}
+	sk.SetByCSPRNG()
+	share := &validatorstorage.Share{PublicKey: sk.GetPublicKey()}
+	identifier := []byte(format.IdentifierFormat(share.PublicKey.Serialize(), beacon.RoleTypeAttester.String()))
+
+	tests := []struct {
+		name         string
+		sequences    uint64
+		epochs       uint64
+		currentEpoch uint64
+		expectedFrom uint64
+	}{
+		{"sequences", 30, 0, 99, 70},
+		{"epochs", 0, 10, 99, 90},
+		{"sequences and epochs keep the longer", 30, 10, 99, 70},
+		{"sync batch is kept", 0, 1, 200, 90},
+		{"retention is longer than history", 500, 0, 99, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, err := ssvstorage.GetStorageFactory(basedb.Options{Type: "badger-memory", Logger: zap.L()})
+			require.NoError(t, err)
+			defer db.Close()
+			storage := collections.NewIbft(db, zap.L(), beacon.RoleTypeAttester.String())
+			// a decided message per epoch
+			for seq := uint64(0); seq < 100; seq++ {
+				data := &spec.AttestationData{
+					Slot:   spec.Slot(seq * 32),
+					Source: &spec.Checkpoint{},
+					Target: &spec.Checkpoint{},
+				}
+				value, err := data.MarshalSSZ()
+				require.NoError(t, err)
+				msg := &proto.SignedMessage{Message: &proto.Message{Lambda: identifier, SeqNumber: seq, Value: value}}
+				require.NoError(t, storage.SaveDecided(msg))
+				if seq == 99 {
+					require.NoError(t, storage.SaveHighestDecidedInstance(msg))
+				}
+			}
+
+			p := newDecidedPruner(decidedPrunerOptions{
+				Logger: zap.L(),
+				DB:     db,
+				Shares: func() ([]*validatorstorage.Share, error) {
+					return []*validatorstorage.Share{share}, nil
+				},
+				Sequences:     test.sequences,
+				Epochs:        test.epochs,
+				MinSequences:  10,
+				SlotsPerEpoch: 32,
+				CurrentEpoch: func() uint64 {
+					return test.currentEpoch
+				},
+			})
+			require.NoError(t, p.Prune())
+
+			msgs, err := storage.GetDecidedInRange(identifier, 0, 99)
+			require.NoError(t, err)
+			require.Len(t, msgs, int(100-test.expectedFrom))
+			require.Equal(t, test.expectedFrom, msgs[0].Message.SeqNumber)
+			prunedBelow, err := storage.GetPrunedBelow(identifier)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedFrom, prunedBelow)
+
+			// pruning again has no effect
+			require.NoError(t, p.Prune())
+			msgs, err = storage.GetDecidedInRange(identifier, 0, 99)
+			require.NoError(t, err)
+			require.Len(t, msgs, int(100-test.expectedFrom))
+		})
+	}
+}
@@ -17,21 +17,28 @@ import (
 
 // waitForSignatureCollection waits for inbound signatures, collects them or times out if not.
 func (v *Validator) waitForSignatureCollection(logger *zap.Logger, identifier []byte, seqNumber uint64, sigRoot []byte, signaturesCount int, committiee map[uint64]*proto.Node) (map[uint64][]byte, error) {
+	return v.collectSignatures(logger, msgqueue.SigRoundIndexKey(identifier, seqNumber), sigRoot, signaturesCount, committiee,
+		v.signatureCollectionTimeout, "post consensus")
+}
+
+// collectSignatures pops signatures of the given index key from the queue, verifies them and collects them
+// until the given signatures count is reached or the timeout is over. phase is used for error reporting.
+func (v *Validator) collectSignatures(logger *zap.Logger, indexKey string, sigRoot []byte, signaturesCount int, committiee map[uint64]*proto.Node, timeout time.Duration, phase string) (map[uint64][]byte, error) {
 	// Collect signatures from other nodes
 	// TODO - change signature count to min threshold
 	signatures := make(map[uint64][]byte, signaturesCount)
 	signedIndxes := make([]uint64, 0)
 	var err error
-	timer := time.NewTimer(v.signatureCollectionTimeout)
+	timer := time.NewTimer(timeout)
 	// loop through messages until timeout
 SigCollectionLoop:
 	for {
 		select {
 		case <-timer.C:
-			err = errors.Errorf("timed out waiting for post consensus signatures, received %d", len(signedIndxes))
+			err = errors.Errorf("timed out waiting for %s signatures, received %d", phase, len(signedIndxes))
 			break SigCollectionLoop
 		default:
-			if msg := v.msgQueue.PopMessage(msgqueue.SigRoundIndexKey(identifier, seqNumber)); msg != nil {
+			if msg := v.msgQueue.PopMessage(indexKey); msg != nil {
 				if len(msg.SignedMessage.SignerIds) == 0 { // no KeyManager, empty sig
 					v.logger.Error("missing KeyManager id", zap.Any("msg", msg.SignedMessage))
 					continue SigCollectionLoop
@@ -78,40 +85,43 @@ func (v *Validator) postConsensusDutyExecution(
 	signaturesCount int,
 	duty *beacon.Duty,
 ) error {
+	pk := v.Share.PublicKey.SerializeToHexStr()
 	// sign input value and broadcast
 	sig, root, valueStruct, err := v.signDuty(decidedValue, duty)
 	if err != nil {
+		reportDutyResult(pk, duty.Type, dutyResultSigningFailure)
 		return errors.Wrap(err, "failed to sign input data")
 	}
 
 	identifier := v.ibfts[duty.Type].GetIdentifier()
 	// TODO - should we construct it better?
-	if err := v.network.BroadcastSignature(v.Share.PublicKey.Serialize(), &proto.SignedMessage{
-		Message: &proto.Message{
-			Lambda:    identifier,
-			SeqNumber: seqNumber,
-		},
-		Signature: sig,
-		SignerIds: []uint64{v.Share.NodeID},
-	}); err != nil {
+	if err := v.network.BroadcastSignature(v.Share.PublicKey.Serialize(),
+		v.partialSignatureMsg(identifier, seqNumber, root, sig)); err != nil {
+		reportDutyResult(pk, duty.Type, dutyResultBroadcastFailure)
 		return errors.Wrap(err, "failed to broadcast signature")
 	}
 	logger.Info("broadcasting partial signature post consensus")
 
 	signatures, err := v.waitForSignatureCollection(logger, identifier, seqNumber, root, signaturesCount, v.Share.Committee)
+	reportSignaturesCollected(duty.Type, signatures)
 
 	// clean queue for messages, we don't need them anymore.
 	v.msgQueue.PurgeIndexedMessages(msgqueue.SigRoundIndexKey(identifier, seqNumber))
 
 	if err != nil {
+		// collection stops once enough signatures were collected, so signers are known to be missing only on timeout
+		reportMissingSigners(pk, duty.Type, signatures, v.Share.Committee)
+		reportDutyResult(pk, duty.Type, dutyResultSignaturesTimeout)
 		return err
 	}
 	logger.Info("collected enough signature to reconstruct...", zap.Int("signatures", len(signatures)))
 
 	// Reconstruct signatures
 	if err := v.reconstructAndBroadcastSignature(logger, signatures, root, valueStruct, duty); err != nil {
+		reportDutyResult(pk, duty.Type, dutyResultSubmissionFailure)
 		return errors.Wrap(err, "failed to reconstruct and broadcast signature")
 	}
+	reportDutyResult(pk, duty.Type, dutyResultSuccess)
 	logger.Info("Successfully submitted role!")
 	return nil
 }
@@ -125,6 +135,11 @@ func (v *Validator) comeToConsensusOnInputValue(logger *zap.Logger, duty *beacon
 		return 0, nil, 0, errors.Errorf("no ibft for this role [%s]", duty.Type.String())
 	}
 
+	pk, err := v.Share.OperatorPubKey()
+	if err != nil {
+		return 0, nil, 0, errors.Wrap(err, "could not find operator pk for slashing protection")
+	}
+
 	switch duty.Type {
 	case beacon.RoleTypeAttester:
 		attData, err := v.beacon.GetAttestationData(duty.Slot, duty.CommitteeIndex)
@@ -137,34 +152,38 @@ func (v *Validator) comeToConsensusOnInputValue(logger *zap.Logger, duty *beacon
 			return 0, nil, 0, errors.Errorf("failed to marshal on attestation role: %s", duty.Type.String())
 		}
 		valCheckInstance = v.valueCheck.AttestationSlashingProtector()
-	//case beacon.RoleTypeAggregator:
-	//	aggData, err := v.beacon.GetAggregationData(ctx, duty, v.Share.PublicKey, v.Share.ShareKey)
-	//	if err != nil {
-	//		return 0, nil, 0, errors.Wrap(err, "failed to get aggregation data")
-	//	}
-	//
-	//	d := &proto.InputValue_AggregationData{
-	//		AggregationData: aggData,
-	//	}
-	//	inputByts, err = json.Marshal(d)
-	//	if err != nil {
-	//		return 0, nil, 0, errors.Errorf("failed to marshal on aggregation role: %s", role.String())
-	//	}
-	//	valueCheck = &valcheck.AggregatorValueCheck{}
-	//case beacon.RoleTypeProposer:
-	//	block, err := v.beacon.GetProposalData(ctx, slot, v.Share.ShareKey)
-	//	if err != nil {
-	//		return 0, nil, 0, errors.Wrap(err, "failed to get proposal block")
-	//	}
-	//
-	//	d := &proto.InputValue_BeaconBlock{
-	//		BeaconBlock: block,
-	//	}
-	//	inputByts, err = json.Marshal(d)
-	//	if err != nil {
-	//		return 0, nil, 0, errors.Errorf("failed to marshal on proposer role: %s", role.String())
-	//	}
-	//	valueCheck = &valcheck.ProposerValueCheck{}
+	case beacon.RoleTypeAggregator:
+		aggregateAndProof, err := v.getAggregateAndProof(logger, duty)
+		if err != nil {
+			return 0, nil, 0, err
+		}
+
+		// the aggregate is checked against the attestation data of this operator's beacon node
+		attData, err := v.beacon.GetAttestationData(duty.Slot, duty.CommitteeIndex)
+		if err != nil {
+			return 0, nil, 0, errors.Wrap(err, "failed to get attestation data")
+		}
+
+		inputByts, err = aggregateAndProof.MarshalSSZ()
+		if err != nil {
+			return 0, nil, 0, errors.Errorf("failed to marshal on aggregator role: %s", duty.Type.String())
+		}
+		valCheckInstance = v.valueCheck.AggregationValidation(duty, pk.Serialize(), attData)
+	case beacon.RoleTypeProposer:
+		randao, err := v.getRandaoReveal(logger, duty)
+		if err != nil {
+			return 0, nil, 0, errors.Wrap(err, "failed to get randao reveal")
+		}
+		block, err := v.beacon.GetBeaconBlock(duty.Slot, randao)
+		if err != nil {
+			return 0, nil, 0, errors.Wrap(err, "failed to get beacon block")
+		}
+
+		inputByts, err = beacon.MarshalBeaconBlock(block)
+		if err != nil {
+			return 0, nil, 0, errors.Errorf("failed to marshal on proposer role: %s", duty.Type.String())
+		}
+		valCheckInstance = v.valueCheck.ProposalSlashingProtector(duty, pk.Serialize())
 	default:
 		return 0, nil, 0, errors.Errorf("unknown role: %s", duty.Type.String())
 	}
@@ -182,6 +201,7 @@ func (v *Validator) comeToConsensusOnInputValue(logger *zap.Logger, duty *beacon
 		return 0, nil, 0, errors.Wrap(err, "failed to calculate next sequence number")
 	}
 
+	start := time.Now()
 	result, err := v.ibfts[duty.Type].StartInstance(ibft.ControllerStartInstanceOptions{
 		Logger:          logger,
 		ValueCheck:      valCheckInstance,
@@ -198,6 +218,7 @@ func (v *Validator) comeToConsensusOnInputValue(logger *zap.Logger, duty *beacon
 	if !result.Decided {
 		return 0, nil, seqNumber, errors.New("instance did not decide")
 	}
+	reportConsensus(duty.Type, time.Since(start), result.Msg.Message.Round)
 
 	return len(result.Msg.SignerIds), result.Msg.Message.Value, seqNumber, nil
 }
@@ -209,11 +230,23 @@ func (v *Validator) ExecuteDuty(ctx context.Context, slot uint64, duty *beacon.D
 		zap.Uint64("slot", slot),
 		zap.String("duty_type", duty.Type.String()))
 
-	metricsCurrentSlot.WithLabelValues(v.Share.PublicKey.SerializeToHexStr()).Set(float64(duty.Slot))
+	pk := v.Share.PublicKey.SerializeToHexStr()
+	metricsCurrentSlot.WithLabelValues(pk).Set(float64(duty.Slot))
+	reportDutyReceived(pk, duty.Type)
 
 	logger.Debug("executing duty...")
 	signaturesCount, decidedValue, seqNumber, err := v.comeToConsensusOnInputValue(logger, duty)
+	if errors.Is(err, errNotAggregator) {
+		logger.Debug("skipping duty as the validator was not selected as an aggregator")
+		return
+	}
 	if err != nil {
+		var preConsensusErr *preConsensusError
+		if errors.As(err, &preConsensusErr) {
+			reportDutyResult(pk, duty.Type, dutyResultPreConsensusFailure)
+		} else {
+			reportDutyResult(pk, duty.Type, dutyResultConsensusFailure)
+		}
 		logger.Error("could not come to consensus", zap.Error(err))
 		return
 	}
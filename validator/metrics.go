@@ -2,12 +2,18 @@ package validator
 
 import (
 	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/ibft/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
 	"log"
+	"strconv"
+	"time"
 )
 
+// duty metrics are labeled by validator (pubKey) only for counters, histograms are labeled by role
+// to bound the number of series. signer labels hold the operator index within the committee.
+
 var (
 	metricsCurrentSlot = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "ssv:validator:ibft_current_slot",
@@ -17,8 +23,59 @@ var (
 		Name: "ssv:validator:status",
 		Help: "Validator status",
 	}, []string{"pubKey"})
+	metricsDutiesReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:validator:duties_received",
+		Help: "Count duties that were received for execution",
+	}, []string{"pubKey", "role"})
+	metricsDutyResults = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:validator:duty_results",
+		Help: "Count results of duties execution",
+	}, []string{"pubKey", "role", "result"})
+	metricsDutyMissingSigners = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:validator:duty_missing_signers",
+		Help: "Count committee members that didn't send a post consensus signature until the collection timed out",
+	}, []string{"pubKey", "role", "signer"})
+	metricsConsensusDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ssv:validator:duty_consensus_duration_seconds",
+		Help:    "Duration of reaching consensus on a duty",
+		Buckets: []float64{0.1, 0.25, 0.5, 1, 2, 3, 4, 6, 8, 12, 24},
+	}, []string{"role"})
+	metricsConsensusRounds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ssv:validator:duty_consensus_rounds",
+		Help:    "Number of rounds that were needed for reaching consensus on a duty",
+		Buckets: []float64{1, 2, 3, 4, 5, 6, 8, 10},
+	}, []string{"role"})
+	metricsSignaturesCollected = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ssv:validator:duty_signatures_collected",
+		Help:    "Number of post consensus signatures that were collected for a duty",
+		Buckets: []float64{1, 2, 3, 4, 5, 7, 10, 13},
+	}, []string{"role"})
+	metricsPrunedDecided = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:validator:pruned_decided",
+		Help: "Count decided messages that were pruned by the retention policy",
+	}, []string{"role"})
+	metricsPrunedDecidedBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ssv:validator:pruned_decided_bytes",
+		Help: "Size in bytes (keys and values) of the decided messages that were pruned, reclaimed by the db's compaction",
+	}, []string{"role"})
+)
+
+// duty results
+const (
+	dutyResultSuccess             = "success"
+	dutyResultPreConsensusFailure = "pre_consensus_failure"
+	dutyResultConsensusFailure    = "consensus_failure"
+	dutyResultSigningFailure      = "signing_failure"
+	dutyResultBroadcastFailure    = "broadcast_failure"
+	dutyResultSignaturesTimeout   = "signatures_timeout"
+	dutyResultSubmissionFailure   = "submission_failure"
 )
 
+var dutyResults = []string{dutyResultSuccess, dutyResultPreConsensusFailure, dutyResultConsensusFailure,
+	dutyResultSigningFailure, dutyResultBroadcastFailure, dutyResultSignaturesTimeout, dutyResultSubmissionFailure}
+
+var dutyRoles = []beacon.RoleType{beacon.RoleTypeAttester, beacon.RoleTypeAggregator, beacon.RoleTypeProposer}
+
 func init() {
 	if err := prometheus.Register(metricsCurrentSlot); err != nil {
 		log.Println("could not register prometheus collector")
@@ -26,6 +83,74 @@ func init() {
 	if err := prometheus.Register(metricsValidatorStatus); err != nil {
 		log.Println("could not register prometheus collector")
 	}
+	if err := prometheus.Register(metricsDutiesReceived); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsDutyResults); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsDutyMissingSigners); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsConsensusDuration); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsConsensusRounds); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsSignaturesCollected); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsPrunedDecided); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+	if err := prometheus.Register(metricsPrunedDecidedBytes); err != nil {
+		log.Println("could not register prometheus collector")
+	}
+}
+
+// reportDutyReceived reports a duty that was received for execution
+func reportDutyReceived(pk string, role beacon.RoleType) {
+	metricsDutiesReceived.WithLabelValues(pk, role.String()).Inc()
+}
+
+// reportDutyResult reports the result of a duty execution
+func reportDutyResult(pk string, role beacon.RoleType, result string) {
+	metricsDutyResults.WithLabelValues(pk, role.String(), result).Inc()
+}
+
+// reportConsensus reports the duration and the number of rounds of a decided instance
+func reportConsensus(role beacon.RoleType, duration time.Duration, round uint64) {
+	metricsConsensusDuration.WithLabelValues(role.String()).Observe(duration.Seconds())
+	metricsConsensusRounds.WithLabelValues(role.String()).Observe(float64(round))
+}
+
+// reportSignaturesCollected reports the number of collected post consensus signatures
+func reportSignaturesCollected(role beacon.RoleType, signatures map[uint64][]byte) {
+	metricsSignaturesCollected.WithLabelValues(role.String()).Observe(float64(len(signatures)))
+}
+
+// reportMissingSigners reports the committee members whose post consensus signature is missing,
+// should be called only once the collection timed out as it stops when enough signatures were collected
+func reportMissingSigners(pk string, role beacon.RoleType, signatures map[uint64][]byte, committee map[uint64]*proto.Node) {
+	for id := range committee {
+		if _, signed := signatures[id]; !signed {
+			metricsDutyMissingSigners.WithLabelValues(pk, role.String(), strconv.FormatUint(id, 10)).Inc()
+		}
+	}
+}
+
+// deleteDutyMetrics removes the duty metrics of the given validator, so removed validators don't leave series behind
+func deleteDutyMetrics(pk string, committee map[uint64]*proto.Node) {
+	for _, role := range dutyRoles {
+		metricsDutiesReceived.DeleteLabelValues(pk, role.String())
+		for _, result := range dutyResults {
+			metricsDutyResults.DeleteLabelValues(pk, role.String(), result)
+		}
+		for id := range committee {
+			metricsDutyMissingSigners.DeleteLabelValues(pk, role.String(), strconv.FormatUint(id, 10))
+		}
+	}
 }
 
 // ReportValidatorStatus reports the current status of validator
@@ -0,0 +1,32 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/ibft/proto"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutyMetrics(t *testing.T) {
+	pk := "metrics_test_pk"
+	committee := map[uint64]*proto.Node{1: {}, 2: {}, 3: {}, 4: {}}
+	role := beacon.RoleTypeAttester.String()
+
+	reportDutyReceived(pk, beacon.RoleTypeAttester)
+	reportSignaturesCollected(beacon.RoleTypeAttester, map[uint64][]byte{1: {}, 2: {}, 4: {}})
+	reportMissingSigners(pk, beacon.RoleTypeAttester, map[uint64][]byte{1: {}, 2: {}, 4: {}}, committee)
+	reportDutyResult(pk, beacon.RoleTypeAttester, dutyResultSuccess)
+
+	require.Equal(t, 1.0, testutil.ToFloat64(metricsDutiesReceived.WithLabelValues(pk, role)))
+	require.Equal(t, 1.0, testutil.ToFloat64(metricsDutyResults.WithLabelValues(pk, role, dutyResultSuccess)))
+	require.Equal(t, 1.0, testutil.ToFloat64(metricsDutyMissingSigners.WithLabelValues(pk, role, "3")))
+	require.Equal(t, 0.0, testutil.ToFloat64(metricsDutyMissingSigners.WithLabelValues(pk, role, "1")))
+
+	// removed validators don't leave series behind
+	deleteDutyMetrics(pk, committee)
+	require.Equal(t, 0.0, testutil.ToFloat64(metricsDutiesReceived.WithLabelValues(pk, role)))
+	require.Equal(t, 0.0, testutil.ToFloat64(metricsDutyResults.WithLabelValues(pk, role, dutyResultSuccess)))
+	require.Equal(t, 0.0, testutil.ToFloat64(metricsDutyMissingSigners.WithLabelValues(pk, role, "3")))
+}
@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"github.com/bloxapp/eth2-key-manager/core"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/network"
+	"github.com/bloxapp/ssv/network/msgqueue"
+	"github.com/bloxapp/ssv/utils/threshold"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// PreConsensusRound is implemented by duties that require operators to exchange partial signatures
+// before an iBFT instance is started, e.g. randao reveal of proposals or selection proof of aggregations
+type PreConsensusRound interface {
+	// SignPartial signs the pre consensus data of the duty with the given share pk, returns the signature and the signing root
+	SignPartial(signer beacon.Signer, duty *beacon.Duty, pk []byte) ([]byte, []byte, error)
+}
+
+// preConsensusError is returned when the pre consensus round of a duty failed,
+// so it can be told apart from failures to reach consensus
+type preConsensusError struct {
+	error
+}
+
+// Unwrap returns the underlying error
+func (e *preConsensusError) Unwrap() error {
+	return e.error
+}
+
+// newPreConsensusRounds returns the pre consensus rounds of the supported duties
+func newPreConsensusRounds(ethNetwork *core.Network) map[beacon.RoleType]PreConsensusRound {
+	return map[beacon.RoleType]PreConsensusRound{
+		beacon.RoleTypeProposer:   &randaoRound{ethNetwork: ethNetwork},
+		beacon.RoleTypeAggregator: &selectionProofRound{},
+	}
+}
+
+// executePreConsensusRound signs the pre consensus data of the given duty with the share key,
+// exchanges partial signatures with the other operators and reconstructs the validator's signature.
+// the iBFT identifier of the duty is used as lambda, and the duty slot as seq number.
+// errors are returned as *preConsensusError
+func (v *Validator) executePreConsensusRound(logger *zap.Logger, duty *beacon.Duty) (*bls.Sign, error) {
+	signature, err := v.runPreConsensusRound(logger, duty)
+	if err != nil {
+		return nil, &preConsensusError{err}
+	}
+	return signature, nil
+}
+
+func (v *Validator) runPreConsensusRound(logger *zap.Logger, duty *beacon.Duty) (*bls.Sign, error) {
+	round, ok := v.preConsensusRounds[duty.Type]
+	if !ok {
+		return nil, errors.Errorf("no pre consensus round for role [%s]", duty.Type.String())
+	}
+	ib, ok := v.ibfts[duty.Type]
+	if !ok {
+		return nil, errors.Errorf("no ibft for this role [%s]", duty.Type.String())
+	}
+	pk, err := v.Share.OperatorPubKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not find operator pk for pre consensus signing")
+	}
+	sig, root, err := round.SignPartial(v.signer, duty, pk.Serialize())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign pre consensus data")
+	}
+
+	identifier := ib.GetIdentifier()
+	seqNumber := uint64(duty.Slot)
+	if err := v.network.BroadcastPreConsensusSignature(v.Share.PublicKey.Serialize(),
+		v.partialSignatureMsg(identifier, seqNumber, root, sig)); err != nil {
+		return nil, errors.Wrap(err, "failed to broadcast pre consensus signature")
+	}
+	logger.Info("broadcasting partial signature pre consensus")
+
+	indexKey := msgqueue.PreConsensusSigIndexKey(identifier, seqNumber)
+	signatures, err := v.collectSignatures(logger, indexKey, root, v.Share.ThresholdSize(), v.Share.Committee,
+		v.preConsensusSignatureTimeout, "pre consensus")
+
+	// clean queue for messages, we don't need them anymore.
+	v.msgQueue.PurgeIndexedMessages(indexKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := threshold.ReconstructSignatures(signatures)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reconstruct signatures")
+	}
+	if !signature.VerifyByte(v.Share.PublicKey, ensureRoot(root)) {
+		return nil, errors.New("could not reconstruct a valid signature")
+	}
+	logger.Debug("pre consensus signatures successfully reconstructed", zap.Int("signature count", len(signatures)))
+	return signature, nil
+}
+
+func (v *Validator) listenToPreConsensusSignatureMessages() {
+	sigChan, done := v.network.ReceivedPreConsensusSignatureChan()
+	defer done()
+	for {
+		select {
+		case <-v.ctx.Done():
+			return
+		case sigMsg, ok := <-sigChan:
+			if !ok {
+				return
+			}
+			if sigMsg == nil {
+				v.logger.Debug("got nil message")
+				continue
+			}
+
+			if sigMsg.Message != nil && v.oneOfIBFTIdentifiers(sigMsg.Message.Lambda) {
+				v.logger.Debug("adding pre consensus sig message to msg queue", getFields(sigMsg)...)
+				v.msgQueue.AddMessage(&network.Message{
+					SignedMessage: sigMsg,
+					Type:          network.NetworkMsg_PreConsensusSignatureType,
+				})
+			}
+		}
+	}
+}
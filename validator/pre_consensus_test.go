@@ -0,0 +1,25 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutePreConsensusRound(t *testing.T) {
+	identifier := _byteArray("6139636633363061613135666231643164333065653262353738646335383834383233633139363631383836616538623839323737356363623362643936623764373334353536396132616130623134653464303135633534613661306335345f4154544553544552")
+	v := testingValidator(t, true, 4, identifier)
+
+	t.Run("role without pre consensus round", func(t *testing.T) {
+		_, err := v.executePreConsensusRound(v.logger, &beacon.Duty{Type: beacon.RoleTypeAttester, Slot: 320})
+		require.EqualError(t, err, "no pre consensus round for role [ATTESTER]")
+	})
+
+	t.Run("role without ibft", func(t *testing.T) {
+		v.preConsensusRounds[beacon.RoleTypeUnknown] = &selectionProofRound{}
+		defer delete(v.preConsensusRounds, beacon.RoleTypeUnknown)
+		_, err := v.executePreConsensusRound(v.logger, &beacon.Duty{Type: beacon.RoleTypeUnknown, Slot: 320})
+		require.EqualError(t, err, "no ibft for this role [UNKNOWN]")
+	})
+}
@@ -0,0 +1,33 @@
+package validator
+
+import (
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/eth2-key-manager/core"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// randaoRound is the pre consensus round of proposals, in which the randao reveal is signed
+type randaoRound struct {
+	ethNetwork *core.Network
+}
+
+// SignPartial signs the randao reveal of the duty's epoch
+func (r *randaoRound) SignPartial(signer beacon.Signer, duty *beacon.Duty, pk []byte) ([]byte, []byte, error) {
+	epoch := spec.Epoch(uint64(duty.Slot) / r.ethNetwork.SlotsPerEpoch())
+	return signer.SignRandaoReveal(epoch, pk)
+}
+
+// getRandaoReveal runs the pre consensus round of the given proposer duty and returns the validator's randao reveal
+func (v *Validator) getRandaoReveal(logger *zap.Logger, duty *beacon.Duty) (spec.BLSSignature, error) {
+	randao, err := v.executePreConsensusRound(logger, duty)
+	if err != nil {
+		return spec.BLSSignature{}, errors.Wrap(err, "could not reconstruct randao reveal")
+	}
+	logger.Debug("randao reveal successfully reconstructed")
+
+	ret := spec.BLSSignature{}
+	copy(ret[:], randao.Serialize())
+	return ret, nil
+}
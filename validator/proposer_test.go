@@ -0,0 +1,130 @@
+package validator
+
+import (
+	"testing"
+	"time"
+
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/ssv/beacon"
+	"github.com/bloxapp/ssv/ibft/proto"
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func testingBlock(slot spec.Slot, proposerIndex spec.ValidatorIndex) *eth2spec.VersionedBeaconBlock {
+	return &eth2spec.VersionedBeaconBlock{
+		Version: eth2spec.DataVersionPhase0,
+		Phase0: &spec.BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			Body: &spec.BeaconBlockBody{
+				RANDAOReveal: spec.BLSSignature{1, 2, 3},
+				ETH1Data: &spec.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				Graffiti: make([]byte, 32),
+			},
+		},
+	}
+}
+
+func partialSigs(t *testing.T, root []byte, ids ...uint64) map[uint64][]byte {
+	ret := make(map[uint64][]byte)
+	for _, id := range ids {
+		sk := &bls.SecretKey{}
+		require.NoError(t, sk.Deserialize(refSplitShares[id-1]))
+		ret[id] = sk.SignByte(root).Serialize()
+	}
+	return ret
+}
+
+func TestGetRandaoReveal(t *testing.T) {
+	identifier := _byteArray("6139636633363061613135666231643164333065653262353738646335383834383233633139363631383836616538623839323737356363623362643936623764373334353536396132616130623134653464303135633534613661306335345f4154544553544552")
+	duty := &beacon.Duty{
+		Type:           beacon.RoleTypeProposer,
+		Slot:           320,
+		ValidatorIndex: 1,
+	}
+
+	t.Run("valid randao", func(t *testing.T) {
+		v := testingValidator(t, true, 4, identifier)
+		// wait for for listeners to spin up
+		time.Sleep(time.Millisecond * 100)
+
+		lambda := v.ibfts[beacon.RoleTypeProposer].GetIdentifier()
+		for id, sig := range partialSigs(t, refSigRoot, 2, 3) {
+			require.NoError(t, v.network.BroadcastPreConsensusSignature(nil, &proto.SignedMessage{
+				Message:   &proto.Message{Lambda: lambda, SeqNumber: uint64(duty.Slot)},
+				Signature: sig,
+				SignerIds: []uint64{id},
+			}))
+		}
+
+		randao, err := v.getRandaoReveal(v.logger, duty)
+		require.NoError(t, err)
+
+		sig := &bls.Sign{}
+		require.NoError(t, sig.Deserialize(randao[:]))
+		require.True(t, sig.VerifyByte(v.Share.PublicKey, refSigRoot))
+	})
+
+	t.Run("ignores post consensus signatures", func(t *testing.T) {
+		v := testingValidator(t, true, 4, identifier)
+		v.preConsensusSignatureTimeout = time.Millisecond * 500
+		time.Sleep(time.Millisecond * 100)
+
+		for id, sig := range partialSigs(t, refSigRoot, 2, 3) {
+			require.NoError(t, v.network.BroadcastSignature(nil, &proto.SignedMessage{
+				Message:   &proto.Message{Lambda: v.ibfts[beacon.RoleTypeProposer].GetIdentifier(), SeqNumber: uint64(duty.Slot)},
+				Signature: sig,
+				SignerIds: []uint64{id},
+			}))
+		}
+
+		_, err := v.getRandaoReveal(v.logger, duty)
+		require.EqualError(t, err, "could not reconstruct randao reveal: timed out waiting for pre consensus signatures, received 1")
+		// reported as a pre consensus failure rather than a consensus failure
+		var preConsensusErr *preConsensusError
+		require.True(t, errors.As(err, &preConsensusErr))
+	})
+}
+
+func TestProposerDutyExecution(t *testing.T) {
+	identifier := _byteArray("6139636633363061613135666231643164333065653262353738646335383834383233633139363631383836616538623839323737356363623362643936623764373334353536396132616130623134653464303135633534613661306335345f4154544553544552")
+	duty := &beacon.Duty{
+		Type:           beacon.RoleTypeProposer,
+		Slot:           320,
+		ValidatorIndex: 1,
+	}
+	v := testingValidator(t, true, 3, identifier)
+	time.Sleep(time.Millisecond * 100)
+
+	block := testingBlock(duty.Slot, duty.ValidatorIndex)
+	blockByts, err := beacon.MarshalBeaconBlock(block)
+	require.NoError(t, err)
+
+	require.NoError(t, v.valueCheck.ProposalSlashingProtector(duty, refSplitSharesPubKeys[0]).Check(blockByts))
+	wrongDuty := *duty
+	wrongDuty.ValidatorIndex = 2
+	require.EqualError(t, v.valueCheck.ProposalSlashingProtector(&wrongDuty, refSplitSharesPubKeys[0]).Check(blockByts),
+		"block proposer index 1 is different than validator index 2")
+
+	sig, root, dutyData, err := v.signDuty(blockByts, duty)
+	require.NoError(t, err)
+	require.EqualValues(t, refSigRoot, root)
+	require.NotNil(t, dutyData.GetBlock())
+
+	sigs := partialSigs(t, root, 2, 3)
+	sigs[1] = sig
+	require.NoError(t, v.reconstructAndBroadcastSignature(v.logger, sigs, root, dutyData, duty))
+
+	submitted := v.beacon.(*testBeacon).LastSubmittedBlock
+	require.NotNil(t, submitted)
+	blockSig, err := beacon.SignedBlockSignature(submitted)
+	require.NoError(t, err)
+	reconstructed := &bls.Sign{}
+	require.NoError(t, reconstructed.Deserialize(blockSig[:]))
+	require.True(t, reconstructed.VerifyByte(v.Share.PublicKey, root))
+}
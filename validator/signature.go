@@ -62,36 +62,37 @@ func (v *Validator) signDuty(decidedValue []byte, duty *beacon.Duty) ([]byte, []
 		retValueStruct.GetAttestation().AggregationBits = signedAttestation.AggregationBits
 		sig = signedAttestation.Signature[:]
 		root = ensureRoot(r)
-	//case beacon.RoleTypeAggregator:
-	//	s := &proto.InputValue_Aggregation{}
-	//	if err := json.Unmarshal(decidedValue, s); err != nil {
-	//		return nil, nil, nil, errors.Wrap(err, "failed to marshal aggregator")
-	//	}
-	//	signedAggregation, e := v.beacon.SignAggregation(ctx, s.Aggregation.Message, v.Share.ShareKey)
-	//	if e != nil{
-	//		return nil, nil, nil, errors.Wrap(err, "failed to sign attestation")
-	//	}
-	//	retValueStruct.SignedData = s
-	//	retValueStruct.GetAggregation().Signature = signedAggregation.Signature
-	//	retValueStruct.GetAggregation().Message = signedAggregation.Message
-	//	err = e
-	//	sig = signedAggregation.GetSignature()
-	//case beacon.RoleTypeProposer:
-	//	s := &proto.InputValue_Block{}
-	//	if err := json.Unmarshal(decidedValue, s); err != nil {
-	//		return nil, nil, nil, errors.Wrap(err, "failed to marshal aggregator")
-	//	}
-	//
-	//	signedProposal, e := v.beacon.SignProposal(ctx, nil, s.Block.Block, v.Share.ShareKey)
-	//	if e != nil{
-	//		return nil, nil, nil, errors.Wrap(err, "failed to sign attestation")
-	//	}
-	//
-	//	retValueStruct.SignedData = s
-	//	retValueStruct.GetBlock().Signature = signedProposal.Signature
-	//	retValueStruct.GetBlock().Block = signedProposal.Block
-	//	err = e
-	//	sig = signedProposal.GetSignature()
+	case beacon.RoleTypeAggregator:
+		s := &spec.AggregateAndProof{}
+		if err := s.UnmarshalSSZ(decidedValue); err != nil {
+			return nil, nil, nil, errors.Wrap(err, "failed to unmarshal aggregate and proof")
+		}
+		signedAggregation, r, err := v.signer.SignAggregateAndProof(s, duty, pk.Serialize())
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "failed to sign aggregate and proof")
+		}
+
+		retValueStruct.SignedData = &beacon.InputValueAggregation{Aggregation: signedAggregation}
+		aggregationSig := signedAggregation.Signature
+		sig = aggregationSig[:]
+		root = ensureRoot(r)
+	case beacon.RoleTypeProposer:
+		block, err := beacon.UnmarshalBeaconBlock(decidedValue)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "failed to unmarshal beacon block")
+		}
+		signedBlock, r, err := v.signer.SignBeaconBlock(block, duty, pk.Serialize())
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "failed to sign beacon block")
+		}
+		blockSig, err := beacon.SignedBlockSignature(signedBlock)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "failed to get block signature")
+		}
+
+		retValueStruct.SignedData = &beacon.InputValueBlock{Block: signedBlock}
+		sig = blockSig[:]
+		root = ensureRoot(r)
 	default:
 		return nil, nil, nil, errors.New("unsupported role, can't sign")
 	}
@@ -123,22 +124,44 @@ func (v *Validator) reconstructAndBroadcastSignature(logger *zap.Logger, signatu
 		if err := v.beacon.SubmitAttestation(inputValue.GetAttestation()); err != nil {
 			return errors.Wrap(err, "failed to broadcast attestation")
 		}
-	//case beacon.RoleTypeAggregator:
-	//	inputValue.GetAggregation().Signature = signature.Serialize()
-	//	if err := v.beacon.SubmitAggregation(ctx, inputValue.GetAggregation()); err != nil {
-	//		return errors.Wrap(err, "failed to broadcast aggregation")
-	//	}
-	//case beacon.RoleTypeProposer:
-	//	inputValue.GetBlock().Signature = signature.Serialize()
-	//	if err := v.beacon.SubmitProposal(ctx, inputValue.GetBlock()); err != nil {
-	//		return errors.Wrap(err, "failed to broadcast proposal")
-	//	}
+	case beacon.RoleTypeAggregator:
+		logger.Debug("submitting aggregate and proof")
+		blsSig := spec.BLSSignature{}
+		copy(blsSig[:], signature.Serialize()[:])
+		inputValue.GetAggregation().Signature = blsSig
+		if err := v.beacon.SubmitSignedAggregateSelectionProof(inputValue.GetAggregation()); err != nil {
+			return errors.Wrap(err, "failed to broadcast aggregate and proof")
+		}
+	case beacon.RoleTypeProposer:
+		logger.Debug("submitting block")
+		blsSig := spec.BLSSignature{}
+		copy(blsSig[:], signature.Serialize()[:])
+		if err := beacon.SetSignedBlockSignature(inputValue.GetBlock(), blsSig); err != nil {
+			return errors.Wrap(err, "failed to set block signature")
+		}
+		if err := v.beacon.SubmitBeaconBlock(inputValue.GetBlock()); err != nil {
+			return errors.Wrap(err, "failed to broadcast block")
+		}
 	default:
 		return errors.New("role is undefined, can't reconstruct signature")
 	}
 	return nil
 }
 
+// partialSignatureMsg creates a partial signature message of this node,
+// the signed root is carried as the value so peers can verify the partial signature before propagating it
+func (v *Validator) partialSignatureMsg(identifier []byte, seqNumber uint64, root []byte, sig []byte) *proto.SignedMessage {
+	return &proto.SignedMessage{
+		Message: &proto.Message{
+			Lambda:    identifier,
+			SeqNumber: seqNumber,
+			Value:     ensureRoot(root),
+		},
+		Signature: sig,
+		SignerIds: []uint64{v.Share.NodeID},
+	}
+}
+
 // ensureRoot ensures that root will have sufficient allocated memory
 // otherwise we get panic from bls:
 // github.com/herumi/bls-eth-go-binary/bls.(*Sign).VerifyByte:738
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// AccountStatus is the status of an owner account in the contract
+type AccountStatus int32
+
+const (
+	// AccountActive is the status of an account that has sufficient balance
+	AccountActive AccountStatus = iota
+	// AccountLiquidatable is the status of an account that can be liquidated, duties are still performed
+	AccountLiquidatable
+	// AccountLiquidated is the status of an account that was liquidated, duties of its validators are not performed
+	AccountLiquidated
+)
+
+func (s AccountStatus) String() string {
+	switch s {
+	case AccountActive:
+		return "active"
+	case AccountLiquidatable:
+		return "liquidatable"
+	case AccountLiquidated:
+		return "liquidated"
+	}
+	return "unknown"
+}
+
+// MarshalJSON marshals the status as a string
+func (s AccountStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON unmarshals the status from a string
+func (s *AccountStatus) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	switch str {
+	case "active":
+		*s = AccountActive
+	case "liquidatable":
+		*s = AccountLiquidatable
+	case "liquidated":
+		*s = AccountLiquidated
+	default:
+		return errors.Errorf("unknown account status %s", str)
+	}
+	return nil
+}
+
+// OwnerAccount is the state of a validators owner account
+type OwnerAccount struct {
+	OwnerAddress string        `json:"ownerAddress"`
+	Status       AccountStatus `json:"status"`
+}
+
+func accountsPrefix() []byte {
+	return []byte("account-")
+}
+
+// accountKey returns the key of the given owner address, addresses are normalized to their checksum form
+func accountKey(ownerAddress string) []byte {
+	return []byte(common.HexToAddress(ownerAddress).Hex())
+}
+
+// SaveOwnerAccount saves the given owner account
+func (s *Collection) SaveOwnerAccount(account *OwnerAccount) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	raw, err := json.Marshal(account)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal owner account")
+	}
+	return s.db.Set(accountsPrefix(), accountKey(account.OwnerAddress), raw)
+}
+
+// GetOwnerAccount returns the account of the given owner address
+func (s *Collection) GetOwnerAccount(ownerAddress string) (*OwnerAccount, bool, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	obj, found, err := s.db.Get(accountsPrefix(), accountKey(ownerAddress))
+	if err != nil {
+		return nil, found, err
+	}
+	if !found {
+		return nil, false, nil
+	}
+	var account OwnerAccount
+	if err := json.Unmarshal(obj.Value, &account); err != nil {
+		return nil, true, errors.Wrap(err, "could not unmarshal owner account")
+	}
+	return &account, true, nil
+}
+
+// GetOwnerAccountStatus returns the status of the given owner account, accounts that were not saved are active
+func (s *Collection) GetOwnerAccountStatus(ownerAddress string) (AccountStatus, error) {
+	account, found, err := s.GetOwnerAccount(ownerAddress)
+	if err != nil {
+		return AccountActive, err
+	}
+	if !found {
+		return AccountActive, nil
+	}
+	return account.Status, nil
+}
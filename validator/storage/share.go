@@ -33,6 +33,8 @@ type Share struct {
 	Metadata     *beacon.ValidatorMetadata // pointer in order to support nil
 	OwnerAddress string
 	Operators    [][]byte
+	// Inactive is set once the validator was inactivated in the contract
+	Inactive bool
 }
 
 //  serializedShare struct
@@ -43,6 +45,7 @@ type serializedShare struct {
 	Metadata     *beacon.ValidatorMetadata // pointer in order to support nil
 	OwnerAddress string
 	Operators    [][]byte
+	Inactive     bool
 }
 
 // IsOperatorShare checks whether the share belongs to operator
@@ -128,6 +131,7 @@ func (s *Share) Serialize() ([]byte, error) {
 		Metadata:     s.Metadata,
 		OwnerAddress: s.OwnerAddress,
 		Operators:    s.Operators,
+		Inactive:     s.Inactive,
 	}
 	// copy committee by value
 	for k, n := range s.Committee {
@@ -169,6 +173,7 @@ func (s *Share) Deserialize(obj basedb.Obj) (*Share, error) {
 		Metadata:     value.Metadata,
 		OwnerAddress: value.OwnerAddress,
 		Operators:    value.Operators,
+		Inactive:     value.Inactive,
 	}, nil
 }
 
@@ -15,8 +15,12 @@ type ICollection interface {
 
 	SaveValidatorShare(share *Share) error
 	GetValidatorShare(key []byte) (*Share, bool, error)
+	DeleteValidatorShare(key []byte) error
 	GetAllValidatorShares() ([]*Share, error)
 	GetOperatorValidatorShares(operatorPubKey string) ([]*Share, error)
+	SaveOwnerAccount(account *OwnerAccount) error
+	GetOwnerAccount(ownerAddress string) (*OwnerAccount, bool, error)
+	GetOwnerAccountStatus(ownerAddress string) (AccountStatus, error)
 }
 
 func collectionPrefix() []byte {
@@ -89,9 +93,20 @@ func (s *Collection) getUnsafe(key []byte) (*Share, bool, error) {
 	return share, found, err
 }
 
+// DeleteValidatorShare removes the share of the given validator
+func (s *Collection) DeleteValidatorShare(key []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.db.Delete(collectionPrefix(), key)
+}
+
 // CleanRegistryData clears all registry data
 func (s *Collection) CleanRegistryData() error {
-	return s.cleanAllShares()
+	if err := s.cleanAllShares(); err != nil {
+		return err
+	}
+	return s.db.RemoveAllByCollection(accountsPrefix())
 }
 
 func (s *Collection) cleanAllShares() error {
@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/herumi/bls-eth-go-binary/bls"
@@ -74,6 +76,14 @@ func TestSaveAndGetValidatorStorage(t *testing.T) {
 	validators, err := collection.GetAllValidatorShares()
 	require.NoError(t, err)
 	require.EqualValues(t, 2, len(validators))
+
+	require.NoError(t, collection.DeleteValidatorShare(validatorShare.PublicKey.Serialize()))
+	_, found, err = collection.GetValidatorShare(validatorShare.PublicKey.Serialize())
+	require.NoError(t, err)
+	require.False(t, found)
+	validators, err = collection.GetAllValidatorShares()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, len(validators))
 }
 
 func generateRandomValidatorShare(splitKeys map[uint64]*bls.SecretKey) (*Share, *bls.SecretKey) {
@@ -107,3 +117,45 @@ func generateRandomValidatorShare(splitKeys map[uint64]*bls.SecretKey) (*Share,
 		OwnerAddress: "0xFeedB14D8b2C76FdF808C29818b06b830E8C2c0e",
 	}, &sk
 }
+
+func TestOwnerAccounts(t *testing.T) {
+	options := basedb.Options{
+		Type:   "badger-memory",
+		Logger: zap.L(),
+		Path:   "",
+	}
+	db, err := storage.GetStorageFactory(options)
+	require.NoError(t, err)
+	defer db.Close()
+
+	collection := NewCollection(CollectionOptions{
+		DB:     db,
+		Logger: options.Logger,
+	})
+	const owner = "0xa5cfD290965372553Efd5fDaeB91C335207b76E2"
+
+	// unknown accounts are active
+	_, found, err := collection.GetOwnerAccount(owner)
+	require.NoError(t, err)
+	require.False(t, found)
+	status, err := collection.GetOwnerAccountStatus(owner)
+	require.NoError(t, err)
+	require.Equal(t, AccountActive, status)
+
+	require.NoError(t, collection.SaveOwnerAccount(&OwnerAccount{OwnerAddress: owner, Status: AccountLiquidated}))
+	// addresses are not case sensitive
+	account, found, err := collection.GetOwnerAccount(strings.ToLower(owner))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, owner, account.OwnerAddress)
+	require.Equal(t, AccountLiquidated, account.Status)
+
+	raw, err := json.Marshal(account)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"ownerAddress":"0xa5cfD290965372553Efd5fDaeB91C335207b76E2","status":"liquidated"}`, string(raw))
+
+	require.NoError(t, collection.CleanRegistryData())
+	_, found, err = collection.GetOwnerAccount(owner)
+	require.NoError(t, err)
+	require.False(t, found)
+}
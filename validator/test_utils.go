@@ -1,13 +1,21 @@
 package validator
 
 import (
+	"context"
 	"encoding/hex"
 	"testing"
 	"time"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	eth2spec "github.com/attestantio/go-eth2-client/spec"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/bloxapp/eth2-key-manager/core"
+	slashingprotection "github.com/bloxapp/eth2-key-manager/slashing_protection"
+	"github.com/bloxapp/eth2-key-manager/stores/inmemory"
 	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
@@ -17,6 +25,7 @@ import (
 	"github.com/bloxapp/ssv/ibft/proto"
 	"github.com/bloxapp/ssv/network/local"
 	"github.com/bloxapp/ssv/network/msgqueue"
+	"github.com/bloxapp/ssv/utils/format"
 	"github.com/bloxapp/ssv/utils/threshold"
 	"github.com/bloxapp/ssv/validator/storage"
 )
@@ -114,12 +123,19 @@ func (t *testIBFT) NextSeqNumber() (uint64, error) {
 	return 0, nil
 }
 
+func (t *testIBFT) Close() {}
+
 /**
 testBeacon
 */
 type testBeacon struct {
 	refAttestationData       *spec.AttestationData
 	LastSubmittedAttestation *spec.Attestation
+	refBlock                 *eth2spec.VersionedBeaconBlock
+	LastSubmittedBlock       *eth2spec.VersionedSignedBeaconBlock
+	refAggregate             *spec.Attestation
+	LastSubmittedAggregation *spec.SignedAggregateAndProof
+	RemovedShares            []string
 }
 
 func newTestBeacon(t *testing.T) *testBeacon {
@@ -163,14 +179,84 @@ func (b *testBeacon) SubmitAttestation(attestation *spec.Attestation) error {
 	return nil
 }
 
+func (b *testBeacon) GetBeaconBlock(slot spec.Slot, randaoReveal spec.BLSSignature) (*eth2spec.VersionedBeaconBlock, error) {
+	if b.refBlock == nil {
+		return nil, errors.New("no block")
+	}
+	return b.refBlock, nil
+}
+
+func (b *testBeacon) SubmitBeaconBlock(block *eth2spec.VersionedSignedBeaconBlock) error {
+	b.LastSubmittedBlock = block
+	return nil
+}
+
+func (b *testBeacon) SignRandaoReveal(epoch spec.Epoch, pk []byte) ([]byte, []byte, error) {
+	sk := &bls.SecretKey{}
+	if err := sk.Deserialize(refSplitShares[0]); err != nil {
+		return nil, nil, err
+	}
+	return sk.SignByte(refSigRoot).Serialize(), refSigRoot, nil
+}
+
+func (b *testBeacon) SignBeaconBlock(block *eth2spec.VersionedBeaconBlock, duty *beacon.Duty, pk []byte) (*eth2spec.VersionedSignedBeaconBlock, []byte, error) {
+	sk := &bls.SecretKey{}
+	if err := sk.Deserialize(refSplitShares[0]); err != nil {
+		return nil, nil, err
+	}
+	sig := spec.BLSSignature{}
+	copy(sig[:], sk.SignByte(refSigRoot).Serialize())
+	signed, err := beacon.SignBlock(block, sig)
+	return signed, refSigRoot, err
+}
+
+func (b *testBeacon) GetAggregateAttestation(slot spec.Slot, committeeIndex spec.CommitteeIndex) (*spec.Attestation, error) {
+	if b.refAggregate == nil {
+		return nil, errors.New("no aggregate")
+	}
+	return b.refAggregate, nil
+}
+
+func (b *testBeacon) SubmitSignedAggregateSelectionProof(msg *spec.SignedAggregateAndProof) error {
+	b.LastSubmittedAggregation = msg
+	return nil
+}
+
+func (b *testBeacon) SignSlot(slot spec.Slot, pk []byte) ([]byte, []byte, error) {
+	sk := &bls.SecretKey{}
+	if err := sk.Deserialize(refSplitShares[0]); err != nil {
+		return nil, nil, err
+	}
+	return sk.SignByte(refSigRoot).Serialize(), refSigRoot, nil
+}
+
+func (b *testBeacon) SignAggregateAndProof(msg *spec.AggregateAndProof, duty *beacon.Duty, pk []byte) (*spec.SignedAggregateAndProof, []byte, error) {
+	sk := &bls.SecretKey{}
+	if err := sk.Deserialize(refSplitShares[0]); err != nil {
+		return nil, nil, err
+	}
+	sig := spec.BLSSignature{}
+	copy(sig[:], sk.SignByte(refSigRoot).Serialize())
+	return &spec.SignedAggregateAndProof{Message: msg, Signature: sig}, refSigRoot, nil
+}
+
 func (b *testBeacon) SubscribeToCommitteeSubnet(subscription []*api.BeaconCommitteeSubscription) error {
-	panic("implement me")
+	return nil
 }
 
 func (b *testBeacon) AddShare(shareKey *bls.SecretKey) error {
 	panic("implement me")
 }
 
+func (b *testBeacon) RemoveShare(pubKey string) error {
+	b.RemovedShares = append(b.RemovedShares, pubKey)
+	return nil
+}
+
+func (b *testBeacon) SlashingProtector() core.SlashingProtector {
+	panic("implement me")
+}
+
 func (b *testBeacon) SignIBFTMessage(message *proto.Message, pk []byte) ([]byte, error) {
 	panic("implement me")
 }
@@ -179,21 +265,47 @@ func (b *testBeacon) GetDomain(data *spec.AttestationData) ([]byte, error) {
 	panic("implement")
 }
 
+func (b *testBeacon) GetDomainForEpoch(domainType beacon.DomainType, epoch spec.Epoch) ([]byte, error) {
+	panic("implement")
+}
+
 func (b *testBeacon) ComputeSigningRoot(object interface{}, domain []byte) ([32]byte, error) {
 	panic("implement")
 }
 
+// newTestSlashingProtector returns an in memory slashing protector in which the share of node 1
+// has a zero slot highest proposal and the given attestation data as its highest attestation
+func newTestSlashingProtector(t *testing.T, highestAttestation *spec.AttestationData) core.SlashingProtector {
+	protector := slashingprotection.NewNormalProtection(inmemory.NewInMemStore(core.PraterNetwork))
+	require.NoError(t, protector.UpdateHighestProposal(refSplitSharesPubKeys[0], &eth.BeaconBlock{Slot: 0}))
+	require.NoError(t, protector.UpdateHighestAttestation(refSplitSharesPubKeys[0], &eth.AttestationData{
+		Slot:   types.Slot(highestAttestation.Slot),
+		Source: &eth.Checkpoint{Epoch: types.Epoch(highestAttestation.Source.Epoch), Root: highestAttestation.Source.Root[:]},
+		Target: &eth.Checkpoint{Epoch: types.Epoch(highestAttestation.Target.Epoch), Root: highestAttestation.Target.Root[:]},
+	}))
+	return protector
+}
+
 func testingValidator(t *testing.T, decided bool, signaturesCount int, identifier []byte) *Validator {
 	threshold.Init()
 
 	ret := &Validator{}
+	ret.ctx, ret.cancel = context.WithCancel(context.Background())
 	ret.beacon = newTestBeacon(t)
 	ret.logger = zap.L()
 	ret.ibfts = make(map[beacon.RoleType]ibft.Controller)
 	ret.ibfts[beacon.RoleTypeAttester] = &testIBFT{decided: decided, signaturesCount: signaturesCount}
 	ret.ibfts[beacon.RoleTypeAttester].(*testIBFT).identifier = identifier
 	require.NoError(t, ret.ibfts[beacon.RoleTypeAttester].Init())
-	ret.valueCheck = valcheck.New()
+	ret.ibfts[beacon.RoleTypeProposer] = &testIBFT{decided: decided, signaturesCount: signaturesCount}
+	ret.ibfts[beacon.RoleTypeProposer].(*testIBFT).identifier = []byte(format.IdentifierFormat(refPk, beacon.RoleTypeProposer.String()))
+	require.NoError(t, ret.ibfts[beacon.RoleTypeProposer].Init())
+	ret.ibfts[beacon.RoleTypeAggregator] = &testIBFT{decided: decided, signaturesCount: signaturesCount}
+	ret.ibfts[beacon.RoleTypeAggregator].(*testIBFT).identifier = []byte(format.IdentifierFormat(refPk, beacon.RoleTypeAggregator.String()))
+	require.NoError(t, ret.ibfts[beacon.RoleTypeAggregator].Init())
+	ethNetwork := core.PraterNetwork
+	ret.ethNetwork = &ethNetwork
+	ret.valueCheck = valcheck.New(newTestSlashingProtector(t, ret.beacon.(*testBeacon).refAttestationData))
 	ret.signer = ret.beacon
 
 	// nodes
@@ -231,8 +343,11 @@ func testingValidator(t *testing.T, decided bool, signaturesCount int, identifie
 
 	// timeout
 	ret.signatureCollectionTimeout = time.Second * 2
+	ret.preConsensusSignatureTimeout = time.Second * 2
+	ret.preConsensusRounds = newPreConsensusRounds(ret.ethNetwork)
 
 	go ret.listenToSignatureMessages()
+	go ret.listenToPreConsensusSignatureMessages()
 	return ret
 }
 
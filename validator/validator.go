@@ -28,17 +28,19 @@ import (
 
 // Options to add in validator struct creation
 type Options struct {
-	Context                    context.Context
-	Logger                     *zap.Logger
-	Share                      *storage.Share
-	SignatureCollectionTimeout time.Duration
-	Network                    network.Network
-	Beacon                     beacon.Beacon
-	ETHNetwork                 *core.Network
-	DB                         basedb.IDb
-	Fork                       forks.Fork
-	Signer                     beacon.Signer
-	SyncRateLimit              time.Duration
+	Context                      context.Context
+	Logger                       *zap.Logger
+	Share                        *storage.Share
+	SignatureCollectionTimeout   time.Duration
+	PreConsensusSignatureTimeout time.Duration
+	Network                      network.Network
+	Beacon                       beacon.Beacon
+	ETHNetwork                   *core.Network
+	DB                           basedb.IDb
+	Fork                         forks.Fork
+	Signer                       beacon.Signer
+	SlashingProtector            core.SlashingProtector
+	SyncRateLimit                time.Duration
 
 	notifyOperatorID func(string)
 }
@@ -46,19 +48,22 @@ type Options struct {
 // Validator represents a running validator,
 // it holds the corresponding ibft controllers to trigger consensus layer (see ExecuteDuty())
 type Validator struct {
-	ctx                        context.Context
-	logger                     *zap.Logger
-	Share                      *storage.Share
-	ethNetwork                 *core.Network
-	beacon                     beacon.Beacon
-	ibfts                      map[beacon.RoleType]ibft.Controller
-	msgQueue                   *msgqueue.MessageQueue
-	network                    network.Network
-	signatureCollectionTimeout time.Duration
-	valueCheck                 *valcheck.SlashingProtection
-	startOnce                  sync.Once
-	fork                       forks.Fork
-	signer                     beacon.Signer
+	ctx                          context.Context
+	cancel                       context.CancelFunc
+	logger                       *zap.Logger
+	Share                        *storage.Share
+	ethNetwork                   *core.Network
+	beacon                       beacon.Beacon
+	ibfts                        map[beacon.RoleType]ibft.Controller
+	msgQueue                     *msgqueue.MessageQueue
+	network                      network.Network
+	signatureCollectionTimeout   time.Duration
+	preConsensusSignatureTimeout time.Duration
+	preConsensusRounds           map[beacon.RoleType]PreConsensusRound
+	valueCheck                   *valcheck.SlashingProtection
+	startOnce                    sync.Once
+	fork                         forks.Fork
+	signer                       beacon.Signer
 }
 
 // New creates a new validator instance and the corresponding ibft controller
@@ -70,8 +75,8 @@ func New(opt Options) *Validator {
 	msgQueue := msgqueue.New()
 	ibfts := make(map[beacon.RoleType]ibft.Controller)
 	ibfts[beacon.RoleTypeAttester] = setupIbftController(beacon.RoleTypeAttester, logger, opt.DB, opt.Network, msgQueue, opt.Share, opt.Fork, opt.Signer, opt.SyncRateLimit)
-	//ibfts[beacon.RoleAggregator] = setupIbftController(beacon.RoleAggregator, logger, db, opt.Network, msgQueue, opt.Share) TODO not supported for now
-	//ibfts[beacon.RoleProposer] = setupIbftController(beacon.RoleProposer, logger, db, opt.Network, msgQueue, opt.Share) TODO not supported for now
+	ibfts[beacon.RoleTypeProposer] = setupIbftController(beacon.RoleTypeProposer, logger, opt.DB, opt.Network, msgQueue, opt.Share, opt.Fork, opt.Signer, opt.SyncRateLimit)
+	ibfts[beacon.RoleTypeAggregator] = setupIbftController(beacon.RoleTypeAggregator, logger, opt.DB, opt.Network, msgQueue, opt.Share, opt.Fork, opt.Signer, opt.SyncRateLimit)
 
 	// updating goclient map
 	if opt.Share.HasMetadata() && opt.Share.Metadata.Index > 0 {
@@ -88,25 +93,32 @@ func New(opt Options) *Validator {
 	}
 	logger.Debug("new validator instance was created", zap.Strings("operators ids", opsHashList))
 
+	ctx, cancel := context.WithCancel(opt.Context)
 	return &Validator{
-		ctx:                        opt.Context,
-		logger:                     logger,
-		msgQueue:                   msgQueue,
-		Share:                      opt.Share,
-		signatureCollectionTimeout: opt.SignatureCollectionTimeout,
-		network:                    opt.Network,
-		ibfts:                      ibfts,
-		ethNetwork:                 opt.ETHNetwork,
-		beacon:                     opt.Beacon,
-		valueCheck:                 valcheck.New(),
-		startOnce:                  sync.Once{},
-		fork:                       opt.Fork,
-		signer:                     opt.Signer,
+		ctx:                          ctx,
+		cancel:                       cancel,
+		logger:                       logger,
+		msgQueue:                     msgQueue,
+		Share:                        opt.Share,
+		signatureCollectionTimeout:   opt.SignatureCollectionTimeout,
+		preConsensusSignatureTimeout: opt.PreConsensusSignatureTimeout,
+		preConsensusRounds:           newPreConsensusRounds(opt.ETHNetwork),
+		network:                      opt.Network,
+		ibfts:                        ibfts,
+		ethNetwork:                   opt.ETHNetwork,
+		beacon:                       opt.Beacon,
+		valueCheck:                   valcheck.New(opt.SlashingProtector),
+		startOnce:                    sync.Once{},
+		fork:                         opt.Fork,
+		signer:                       opt.Signer,
 	}
 }
 
 // Start validator
 func (v *Validator) Start() error {
+	if v.ctx.Err() != nil {
+		return errors.New("validator was stopped")
+	}
 	if err := v.network.SubscribeToValidatorNetwork(v.Share.PublicKey); err != nil {
 		return errors.Wrap(err, "failed to subscribe topic")
 	}
@@ -126,27 +138,52 @@ func (v *Validator) Start() error {
 
 	v.startOnce.Do(func() {
 		go v.listenToSignatureMessages()
+		go v.listenToPreConsensusSignatureMessages()
 		v.logger.Debug("validator started")
 	})
 
 	return nil
 }
 
+// Stop stops the validator, a stopped validator can't be started again.
+// the network listeners are released, the ibft controllers are closed, the validator's topic is left
+// and pending messages are removed from the queue
+func (v *Validator) Stop() error {
+	v.cancel()
+	for _, ib := range v.ibfts {
+		ib.Close()
+	}
+	if err := v.network.UnsubscribeFromValidatorNetwork(v.Share.PublicKey); err != nil {
+		return errors.Wrap(err, "failed to unsubscribe topic")
+	}
+	v.msgQueue.Clean()
+	v.logger.Debug("validator stopped")
+	return nil
+}
+
 func (v *Validator) listenToSignatureMessages() {
 	sigChan, done := v.network.ReceivedSignatureChan()
 	defer done()
-	for sigMsg := range sigChan {
-		if sigMsg == nil {
-			v.logger.Debug("got nil message")
-			continue
-		}
+	for {
+		select {
+		case <-v.ctx.Done():
+			return
+		case sigMsg, ok := <-sigChan:
+			if !ok {
+				return
+			}
+			if sigMsg == nil {
+				v.logger.Debug("got nil message")
+				continue
+			}
 
-		if sigMsg.Message != nil && v.oneOfIBFTIdentifiers(sigMsg.Message.Lambda) {
-			v.logger.Debug("adding sig message to msg queue", getFields(sigMsg)...)
-			v.msgQueue.AddMessage(&network.Message{
-				SignedMessage: sigMsg,
-				Type:          network.NetworkMsg_SignatureType,
-			})
+			if sigMsg.Message != nil && v.oneOfIBFTIdentifiers(sigMsg.Message.Lambda) {
+				v.logger.Debug("adding sig message to msg queue", getFields(sigMsg)...)
+				v.msgQueue.AddMessage(&network.Message{
+					SignedMessage: sigMsg,
+					Type:          network.NetworkMsg_SignatureType,
+				})
+			}
 		}
 	}
 }
@@ -158,19 +195,31 @@ func (v *Validator) getSlotStartTime(slot uint64) time.Time {
 	return start
 }
 
-// GetMsgResolver returns proper handler for msg based on msg type
+// GetMsgResolver returns proper handler for msg based on msg type,
+// messages of other validators (e.g. in a shared subnet) are filtered by lambda
 func (v *Validator) GetMsgResolver(networkMsg network.NetworkMsg) func(msg *proto.SignedMessage) {
 	switch networkMsg {
 	case network.NetworkMsg_IBFTType:
-		return v.listenToNetworkMessages
+		return v.filterByLambda(v.listenToNetworkMessages)
 	case network.NetworkMsg_DecidedType:
-		return v.listenToNetworkDecidedMessages
+		return v.filterByLambda(v.listenToNetworkDecidedMessages)
 	}
 	return func(msg *proto.SignedMessage) {
 		v.logger.Warn(fmt.Sprintf("handler type (%s) is not supported", networkMsg))
 	}
 }
 
+// filterByLambda wraps the given handler so it is called only with messages of one of the validator's ibft instances
+func (v *Validator) filterByLambda(handler func(msg *proto.SignedMessage)) func(msg *proto.SignedMessage) {
+	return func(msg *proto.SignedMessage) {
+		if msg == nil || msg.Message == nil || !v.oneOfIBFTIdentifiers(msg.Message.Lambda) {
+			v.logger.Debug("skipping message of another validator", getFields(msg)...)
+			return
+		}
+		handler(msg)
+	}
+}
+
 func (v *Validator) listenToNetworkMessages(msg *proto.SignedMessage) {
 	v.logger.Debug("adding ibft message to msg queue", getFields(msg)...)
 	v.msgQueue.AddMessage(&network.Message{
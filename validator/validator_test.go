@@ -1,6 +1,9 @@
 package validator
 
 import (
+	"github.com/bloxapp/ssv/ibft/proto"
+	"github.com/bloxapp/ssv/network"
+	"github.com/bloxapp/ssv/network/msgqueue"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -10,3 +13,19 @@ func TestIdentifierTest(t *testing.T) {
 	require.True(t, node.oneOfIBFTIdentifiers([]byte{1, 2, 3, 4}))
 	require.False(t, node.oneOfIBFTIdentifiers([]byte{1, 2, 3, 3}))
 }
+
+func TestGetMsgResolver_FilterByLambda(t *testing.T) {
+	node := testingValidator(t, true, 4, []byte{1, 2, 3, 4})
+	msg := func(lambda []byte) *proto.SignedMessage {
+		return &proto.SignedMessage{Message: &proto.Message{Type: proto.RoundState_Prepare, Lambda: lambda, SeqNumber: 1}}
+	}
+
+	node.GetMsgResolver(network.NetworkMsg_IBFTType)(msg([]byte{1, 2, 3, 4}))
+	require.Equal(t, 1, node.msgQueue.MsgCount(msgqueue.IBFTMessageIndexKey([]byte{1, 2, 3, 4}, 1)))
+
+	// messages of other validators in the same subnet are dropped
+	node.GetMsgResolver(network.NetworkMsg_IBFTType)(msg([]byte{1, 2, 3, 3}))
+	require.Equal(t, 0, node.msgQueue.MsgCount(msgqueue.IBFTMessageIndexKey([]byte{1, 2, 3, 3}, 1)))
+	node.GetMsgResolver(network.NetworkMsg_DecidedType)(msg([]byte{1, 2, 3, 3}))
+	require.Equal(t, 0, node.msgQueue.MsgCount(msgqueue.DecidedIndexKey([]byte{1, 2, 3, 3})))
+}
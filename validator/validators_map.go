@@ -78,6 +78,19 @@ func (vm *validatorsMap) GetOrCreateValidator(share *storage.Share) *Validator {
 	return vm.validatorsMap[pubKey]
 }
 
+// RemoveValidator removes a validator from the map, returns the removed validator or nil if not exist
+func (vm *validatorsMap) RemoveValidator(pubKey string) *Validator {
+	vm.lock.Lock()
+	defer vm.lock.Unlock()
+
+	v, ok := vm.validatorsMap[pubKey]
+	if !ok {
+		return nil
+	}
+	delete(vm.validatorsMap, pubKey)
+	return v
+}
+
 // Size returns the number of validators in the map
 func (vm *validatorsMap) Size() int {
 	vm.lock.RLock()